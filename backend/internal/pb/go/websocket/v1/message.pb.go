@@ -87,6 +87,10 @@ type Message struct {
 	//	*Message_RerunPage
 	//	*Message_CloseSession
 	//	*Message_ScriptFinished
+	//	*Message_UploadFileChunk
+	//	*Message_DownloadFile
+	//	*Message_DownloadFileChunk
+	//	*Message_Toast
 	Type          isMessage_Type `protobuf_oneof:"type"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -217,6 +221,42 @@ func (x *Message) GetScriptFinished() *ScriptFinished {
 	return nil
 }
 
+func (x *Message) GetUploadFileChunk() *UploadFileChunk {
+	if x != nil {
+		if x, ok := x.Type.(*Message_UploadFileChunk); ok {
+			return x.UploadFileChunk
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetDownloadFile() *DownloadFile {
+	if x != nil {
+		if x, ok := x.Type.(*Message_DownloadFile); ok {
+			return x.DownloadFile
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetDownloadFileChunk() *DownloadFileChunk {
+	if x != nil {
+		if x, ok := x.Type.(*Message_DownloadFileChunk); ok {
+			return x.DownloadFileChunk
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetToast() *Toast {
+	if x != nil {
+		if x, ok := x.Type.(*Message_Toast); ok {
+			return x.Toast
+		}
+	}
+	return nil
+}
+
 type isMessage_Type interface {
 	isMessage_Type()
 }
@@ -257,6 +297,22 @@ type Message_ScriptFinished struct {
 	ScriptFinished *ScriptFinished `protobuf:"bytes,10,opt,name=script_finished,json=scriptFinished,proto3,oneof"`
 }
 
+type Message_UploadFileChunk struct {
+	UploadFileChunk *UploadFileChunk `protobuf:"bytes,11,opt,name=upload_file_chunk,json=uploadFileChunk,proto3,oneof"`
+}
+
+type Message_DownloadFile struct {
+	DownloadFile *DownloadFile `protobuf:"bytes,12,opt,name=download_file,json=downloadFile,proto3,oneof"`
+}
+
+type Message_DownloadFileChunk struct {
+	DownloadFileChunk *DownloadFileChunk `protobuf:"bytes,13,opt,name=download_file_chunk,json=downloadFileChunk,proto3,oneof"`
+}
+
+type Message_Toast struct {
+	Toast *Toast `protobuf:"bytes,14,opt,name=toast,proto3,oneof"`
+}
+
 func (*Message_Exception) isMessage_Type() {}
 
 func (*Message_InitializeHost) isMessage_Type() {}
@@ -275,6 +331,14 @@ func (*Message_CloseSession) isMessage_Type() {}
 
 func (*Message_ScriptFinished) isMessage_Type() {}
 
+func (*Message_UploadFileChunk) isMessage_Type() {}
+
+func (*Message_DownloadFile) isMessage_Type() {}
+
+func (*Message_DownloadFileChunk) isMessage_Type() {}
+
+func (*Message_Toast) isMessage_Type() {}
+
 type InitializeHost struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ApiKey        string                 `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
@@ -391,6 +455,7 @@ type InitializeClient struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionId     *string                `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"`
 	PageId        string                 `protobuf:"bytes,2,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	Query         string                 `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -439,6 +504,13 @@ func (x *InitializeClient) GetPageId() string {
 	return ""
 }
 
+func (x *InitializeClient) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
 type InitializeClientCompleted struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
@@ -556,6 +628,7 @@ type RerunPage struct {
 	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	PageId        string                 `protobuf:"bytes,2,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
 	States        []*v12.Widget          `protobuf:"bytes,3,rep,name=states,proto3" json:"states,omitempty"`
+	Query         string                 `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -611,6 +684,13 @@ func (x *RerunPage) GetStates() []*v12.Widget {
 	return nil
 }
 
+func (x *RerunPage) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
 type CloseSession struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
@@ -707,11 +787,355 @@ func (x *ScriptFinished) GetStatus() ScriptFinished_Status {
 	return ScriptFinished_STATUS_UNSPECIFIED
 }
 
+type UploadFileChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	PageId        string                 `protobuf:"bytes,2,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	WidgetId      string                 `protobuf:"bytes,3,opt,name=widget_id,json=widgetId,proto3" json:"widget_id,omitempty"`
+	FileId        string                 `protobuf:"bytes,4,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	MimeType      string                 `protobuf:"bytes,6,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Size          int64                  `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`
+	Offset        int64                  `protobuf:"varint,8,opt,name=offset,proto3" json:"offset,omitempty"`
+	Data          []byte                 `protobuf:"bytes,9,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadFileChunk) Reset() {
+	*x = UploadFileChunk{}
+	mi := &file_websocket_v1_message_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadFileChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFileChunk) ProtoMessage() {}
+
+func (x *UploadFileChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_websocket_v1_message_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadFileChunk.ProtoReflect.Descriptor instead.
+func (*UploadFileChunk) Descriptor() ([]byte, []int) {
+	return file_websocket_v1_message_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UploadFileChunk) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *UploadFileChunk) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *UploadFileChunk) GetWidgetId() string {
+	if x != nil {
+		return x.WidgetId
+	}
+	return ""
+}
+
+func (x *UploadFileChunk) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *UploadFileChunk) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UploadFileChunk) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *UploadFileChunk) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *UploadFileChunk) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *UploadFileChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DownloadFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	PageId        string                 `protobuf:"bytes,2,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	WidgetId      string                 `protobuf:"bytes,3,opt,name=widget_id,json=widgetId,proto3" json:"widget_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadFile) Reset() {
+	*x = DownloadFile{}
+	mi := &file_websocket_v1_message_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadFile) ProtoMessage() {}
+
+func (x *DownloadFile) ProtoReflect() protoreflect.Message {
+	mi := &file_websocket_v1_message_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadFile.ProtoReflect.Descriptor instead.
+func (*DownloadFile) Descriptor() ([]byte, []int) {
+	return file_websocket_v1_message_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DownloadFile) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *DownloadFile) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *DownloadFile) GetWidgetId() string {
+	if x != nil {
+		return x.WidgetId
+	}
+	return ""
+}
+
+type DownloadFileChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	PageId        string                 `protobuf:"bytes,2,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	WidgetId      string                 `protobuf:"bytes,3,opt,name=widget_id,json=widgetId,proto3" json:"widget_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,4,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	MimeType      string                 `protobuf:"bytes,5,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Size          int64                  `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	Offset        int64                  `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty"`
+	Data          []byte                 `protobuf:"bytes,8,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadFileChunk) Reset() {
+	*x = DownloadFileChunk{}
+	mi := &file_websocket_v1_message_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadFileChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadFileChunk) ProtoMessage() {}
+
+func (x *DownloadFileChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_websocket_v1_message_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadFileChunk.ProtoReflect.Descriptor instead.
+func (*DownloadFileChunk) Descriptor() ([]byte, []int) {
+	return file_websocket_v1_message_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DownloadFileChunk) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *DownloadFileChunk) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *DownloadFileChunk) GetWidgetId() string {
+	if x != nil {
+		return x.WidgetId
+	}
+	return ""
+}
+
+func (x *DownloadFileChunk) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *DownloadFileChunk) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *DownloadFileChunk) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *DownloadFileChunk) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *DownloadFileChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type Toast struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	PageId        string                 `protobuf:"bytes,2,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Level         string                 `protobuf:"bytes,4,opt,name=level,proto3" json:"level,omitempty"`
+	DurationMs    *int32                 `protobuf:"varint,5,opt,name=duration_ms,json=durationMs,proto3,oneof" json:"duration_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Toast) Reset() {
+	*x = Toast{}
+	mi := &file_websocket_v1_message_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Toast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Toast) ProtoMessage() {}
+
+func (x *Toast) ProtoReflect() protoreflect.Message {
+	mi := &file_websocket_v1_message_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Toast.ProtoReflect.Descriptor instead.
+func (*Toast) Descriptor() ([]byte, []int) {
+	return file_websocket_v1_message_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Toast) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *Toast) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *Toast) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Toast) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *Toast) GetDurationMs() int32 {
+	if x != nil && x.DurationMs != nil {
+		return *x.DurationMs
+	}
+	return 0
+}
+
 var File_websocket_v1_message_proto protoreflect.FileDescriptor
 
 const file_websocket_v1_message_proto_rawDesc = "" +
 	"\n" +
-	"\x1awebsocket/v1/message.proto\x12\fwebsocket.v1\x1a\x1cexception/v1/exception.proto\x1a\x12page/v1/page.proto\x1a\x16widget/v1/widget.proto\"\xcb\x05\n" +
+	"\x1awebsocket/v1/message.proto\x12\fwebsocket.v1\x1a\x1cexception/v1/exception.proto\x1a\x12page/v1/page.proto\x1a\x16widget/v1/widget.proto\"\xdb\a\n" +
 	"\aMessage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x127\n" +
 	"\texception\x18\x02 \x01(\v2\x17.exception.v1.ExceptionH\x00R\texception\x12G\n" +
@@ -724,7 +1148,11 @@ const file_websocket_v1_message_proto_rawDesc = "" +
 	"rerun_page\x18\b \x01(\v2\x17.websocket.v1.RerunPageH\x00R\trerunPage\x12A\n" +
 	"\rclose_session\x18\t \x01(\v2\x1a.websocket.v1.CloseSessionH\x00R\fcloseSession\x12G\n" +
 	"\x0fscript_finished\x18\n" +
-	" \x01(\v2\x1c.websocket.v1.ScriptFinishedH\x00R\x0escriptFinishedB\x06\n" +
+	" \x01(\v2\x1c.websocket.v1.ScriptFinishedH\x00R\x0escriptFinished\x12K\n" +
+	"\x11upload_file_chunk\x18\v \x01(\v2\x1d.websocket.v1.UploadFileChunkH\x00R\x0fuploadFileChunk\x12A\n" +
+	"\rdownload_file\x18\f \x01(\v2\x1a.websocket.v1.DownloadFileH\x00R\fdownloadFile\x12Q\n" +
+	"\x13download_file_chunk\x18\r \x01(\v2\x1f.websocket.v1.DownloadFileChunkH\x00R\x11downloadFileChunk\x12+\n" +
+	"\x05toast\x18\x0e \x01(\v2\x13.websocket.v1.ToastH\x00R\x05toastB\x06\n" +
 	"\x04type\"\x8a\x01\n" +
 	"\x0eInitializeHost\x12\x17\n" +
 	"\aapi_key\x18\x01 \x01(\tR\x06apiKey\x12\x19\n" +
@@ -733,11 +1161,12 @@ const file_websocket_v1_message_proto_rawDesc = "" +
 	"sdkVersion\x12#\n" +
 	"\x05pages\x18\x04 \x03(\v2\r.page.v1.PageR\x05pages\"C\n" +
 	"\x17InitializeHostCompleted\x12(\n" +
-	"\x10host_instance_id\x18\x01 \x01(\tR\x0ehostInstanceId\"^\n" +
+	"\x10host_instance_id\x18\x01 \x01(\tR\x0ehostInstanceId\"t\n" +
 	"\x10InitializeClient\x12\"\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tH\x00R\tsessionId\x88\x01\x01\x12\x17\n" +
-	"\apage_id\x18\x02 \x01(\tR\x06pageIdB\r\n" +
+	"\apage_id\x18\x02 \x01(\tR\x06pageId\x12\x14\n" +
+	"\x05query\x18\x03 \x01(\tR\x05queryB\r\n" +
 	"\v_session_id\":\n" +
 	"\x19InitializeClientCompleted\x12\x1d\n" +
 	"\n" +
@@ -747,12 +1176,13 @@ const file_websocket_v1_message_proto_rawDesc = "" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
 	"\apage_id\x18\x02 \x01(\tR\x06pageId\x12\x12\n" +
 	"\x04path\x18\x03 \x03(\x05R\x04path\x12)\n" +
-	"\x06widget\x18\x04 \x01(\v2\x11.widget.v1.WidgetR\x06widget\"n\n" +
+	"\x06widget\x18\x04 \x01(\v2\x11.widget.v1.WidgetR\x06widget\"\x84\x01\n" +
 	"\tRerunPage\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
 	"\apage_id\x18\x02 \x01(\tR\x06pageId\x12)\n" +
-	"\x06states\x18\x03 \x03(\v2\x11.widget.v1.WidgetR\x06states\"-\n" +
+	"\x06states\x18\x03 \x03(\v2\x11.widget.v1.WidgetR\x06states\x12\x14\n" +
+	"\x05query\x18\x04 \x01(\tR\x05query\"-\n" +
 	"\fCloseSession\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\"\xb6\x01\n" +
@@ -763,7 +1193,42 @@ const file_websocket_v1_message_proto_rawDesc = "" +
 	"\x06Status\x12\x16\n" +
 	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x12\n" +
 	"\x0eSTATUS_SUCCESS\x10\x01\x12\x12\n" +
-	"\x0eSTATUS_FAILURE\x10\x02B\xc6\x01\n" +
+	"\x0eSTATUS_FAILURE\x10\x02\"\xf0\x01\n" +
+	"\x0fUploadFileChunk\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
+	"\apage_id\x18\x02 \x01(\tR\x06pageId\x12\x1b\n" +
+	"\twidget_id\x18\x03 \x01(\tR\bwidgetId\x12\x17\n" +
+	"\afile_id\x18\x04 \x01(\tR\x06fileId\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12\x1b\n" +
+	"\tmime_type\x18\x06 \x01(\tR\bmimeType\x12\x12\n" +
+	"\x04size\x18\a \x01(\x03R\x04size\x12\x16\n" +
+	"\x06offset\x18\b \x01(\x03R\x06offset\x12\x12\n" +
+	"\x04data\x18\t \x01(\fR\x04data\"c\n" +
+	"\fDownloadFile\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
+	"\apage_id\x18\x02 \x01(\tR\x06pageId\x12\x1b\n" +
+	"\twidget_id\x18\x03 \x01(\tR\bwidgetId\"\xe2\x01\n" +
+	"\x11DownloadFileChunk\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
+	"\apage_id\x18\x02 \x01(\tR\x06pageId\x12\x1b\n" +
+	"\twidget_id\x18\x03 \x01(\tR\bwidgetId\x12\x1b\n" +
+	"\tfile_name\x18\x04 \x01(\tR\bfileName\x12\x1b\n" +
+	"\tmime_type\x18\x05 \x01(\tR\bmimeType\x12\x12\n" +
+	"\x04size\x18\x06 \x01(\x03R\x04size\x12\x16\n" +
+	"\x06offset\x18\a \x01(\x03R\x06offset\x12\x12\n" +
+	"\x04data\x18\b \x01(\fR\x04data\"\xa5\x01\n" +
+	"\x05Toast\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
+	"\apage_id\x18\x02 \x01(\tR\x06pageId\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x14\n" +
+	"\x05level\x18\x04 \x01(\tR\x05level\x12$\n" +
+	"\vduration_ms\x18\x05 \x01(\x05H\x00R\n" +
+	"durationMs\x88\x01\x01B\x0e\n" +
+	"\f_duration_msB\xc6\x01\n" +
 	"\x10com.websocket.v1B\fMessageProtoP\x01ZSgithub.com/trysourcetool/sourcetool/backend/internal/pb/go/websocket/v1;websocketv1\xa2\x02\x03WXX\xaa\x02\fWebsocket.V1\xca\x02\fWebsocket\\V1\xe2\x02\x18Websocket\\V1\\GPBMetadata\xea\x02\rWebsocket::V1b\x06proto3"
 
 var (
@@ -779,7 +1244,7 @@ func file_websocket_v1_message_proto_rawDescGZIP() []byte {
 }
 
 var file_websocket_v1_message_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_websocket_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_websocket_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_websocket_v1_message_proto_goTypes = []any{
 	(ScriptFinished_Status)(0),        // 0: websocket.v1.ScriptFinished.Status
 	(*Message)(nil),                   // 1: websocket.v1.Message
@@ -791,12 +1256,16 @@ var file_websocket_v1_message_proto_goTypes = []any{
 	(*RerunPage)(nil),                 // 7: websocket.v1.RerunPage
 	(*CloseSession)(nil),              // 8: websocket.v1.CloseSession
 	(*ScriptFinished)(nil),            // 9: websocket.v1.ScriptFinished
-	(*v1.Exception)(nil),              // 10: exception.v1.Exception
-	(*v11.Page)(nil),                  // 11: page.v1.Page
-	(*v12.Widget)(nil),                // 12: widget.v1.Widget
+	(*UploadFileChunk)(nil),           // 10: websocket.v1.UploadFileChunk
+	(*DownloadFile)(nil),              // 11: websocket.v1.DownloadFile
+	(*DownloadFileChunk)(nil),         // 12: websocket.v1.DownloadFileChunk
+	(*Toast)(nil),                     // 13: websocket.v1.Toast
+	(*v1.Exception)(nil),              // 14: exception.v1.Exception
+	(*v11.Page)(nil),                  // 15: page.v1.Page
+	(*v12.Widget)(nil),                // 16: widget.v1.Widget
 }
 var file_websocket_v1_message_proto_depIdxs = []int32{
-	10, // 0: websocket.v1.Message.exception:type_name -> exception.v1.Exception
+	14, // 0: websocket.v1.Message.exception:type_name -> exception.v1.Exception
 	2,  // 1: websocket.v1.Message.initialize_host:type_name -> websocket.v1.InitializeHost
 	3,  // 2: websocket.v1.Message.initialize_host_completed:type_name -> websocket.v1.InitializeHostCompleted
 	4,  // 3: websocket.v1.Message.initialize_client:type_name -> websocket.v1.InitializeClient
@@ -805,15 +1274,19 @@ var file_websocket_v1_message_proto_depIdxs = []int32{
 	7,  // 6: websocket.v1.Message.rerun_page:type_name -> websocket.v1.RerunPage
 	8,  // 7: websocket.v1.Message.close_session:type_name -> websocket.v1.CloseSession
 	9,  // 8: websocket.v1.Message.script_finished:type_name -> websocket.v1.ScriptFinished
-	11, // 9: websocket.v1.InitializeHost.pages:type_name -> page.v1.Page
-	12, // 10: websocket.v1.RenderWidget.widget:type_name -> widget.v1.Widget
-	12, // 11: websocket.v1.RerunPage.states:type_name -> widget.v1.Widget
-	0,  // 12: websocket.v1.ScriptFinished.status:type_name -> websocket.v1.ScriptFinished.Status
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	10, // 9: websocket.v1.Message.upload_file_chunk:type_name -> websocket.v1.UploadFileChunk
+	11, // 10: websocket.v1.Message.download_file:type_name -> websocket.v1.DownloadFile
+	12, // 11: websocket.v1.Message.download_file_chunk:type_name -> websocket.v1.DownloadFileChunk
+	13, // 12: websocket.v1.Message.toast:type_name -> websocket.v1.Toast
+	15, // 13: websocket.v1.InitializeHost.pages:type_name -> page.v1.Page
+	16, // 14: websocket.v1.RenderWidget.widget:type_name -> widget.v1.Widget
+	16, // 15: websocket.v1.RerunPage.states:type_name -> widget.v1.Widget
+	0,  // 16: websocket.v1.ScriptFinished.status:type_name -> websocket.v1.ScriptFinished.Status
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_websocket_v1_message_proto_init() }
@@ -831,15 +1304,20 @@ func file_websocket_v1_message_proto_init() {
 		(*Message_RerunPage)(nil),
 		(*Message_CloseSession)(nil),
 		(*Message_ScriptFinished)(nil),
+		(*Message_UploadFileChunk)(nil),
+		(*Message_DownloadFile)(nil),
+		(*Message_DownloadFileChunk)(nil),
+		(*Message_Toast)(nil),
 	}
 	file_websocket_v1_message_proto_msgTypes[3].OneofWrappers = []any{}
+	file_websocket_v1_message_proto_msgTypes[12].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_websocket_v1_message_proto_rawDesc), len(file_websocket_v1_message_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   9,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
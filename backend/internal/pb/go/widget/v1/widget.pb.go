@@ -21,6 +21,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Alert struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Level         string                 `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Alert) Reset() {
+	*x = Alert{}
+	mi := &file_widget_v1_widget_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alert) ProtoMessage() {}
+
+func (x *Alert) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alert.ProtoReflect.Descriptor instead.
+func (*Alert) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Alert) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *Alert) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
 type Button struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Value         bool                   `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
@@ -32,7 +84,7 @@ type Button struct {
 
 func (x *Button) Reset() {
 	*x = Button{}
-	mi := &file_widget_v1_widget_proto_msgTypes[0]
+	mi := &file_widget_v1_widget_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -44,7 +96,7 @@ func (x *Button) String() string {
 func (*Button) ProtoMessage() {}
 
 func (x *Button) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[0]
+	mi := &file_widget_v1_widget_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -57,7 +109,7 @@ func (x *Button) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Button.ProtoReflect.Descriptor instead.
 func (*Button) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{0}
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *Button) GetValue() bool {
@@ -81,6 +133,158 @@ func (x *Button) GetDisabled() bool {
 	return false
 }
 
+type Caption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Caption) Reset() {
+	*x = Caption{}
+	mi := &file_widget_v1_widget_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Caption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Caption) ProtoMessage() {}
+
+func (x *Caption) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Caption.ProtoReflect.Descriptor instead.
+func (*Caption) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Caption) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type Chart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	XField        string                 `protobuf:"bytes,5,opt,name=x_field,json=xField,proto3" json:"x_field,omitempty"`
+	YFields       []string               `protobuf:"bytes,6,rep,name=y_fields,json=yFields,proto3" json:"y_fields,omitempty"`
+	Height        *int32                 `protobuf:"varint,7,opt,name=height,proto3,oneof" json:"height,omitempty"`
+	Stacked       bool                   `protobuf:"varint,8,opt,name=stacked,proto3" json:"stacked,omitempty"`
+	Error         string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Chart) Reset() {
+	*x = Chart{}
+	mi := &file_widget_v1_widget_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Chart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Chart) ProtoMessage() {}
+
+func (x *Chart) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Chart.ProtoReflect.Descriptor instead.
+func (*Chart) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Chart) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Chart) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Chart) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Chart) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Chart) GetXField() string {
+	if x != nil {
+		return x.XField
+	}
+	return ""
+}
+
+func (x *Chart) GetYFields() []string {
+	if x != nil {
+		return x.YFields
+	}
+	return nil
+}
+
+func (x *Chart) GetHeight() int32 {
+	if x != nil && x.Height != nil {
+		return *x.Height
+	}
+	return 0
+}
+
+func (x *Chart) GetStacked() bool {
+	if x != nil {
+		return x.Stacked
+	}
+	return false
+}
+
+func (x *Chart) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 type Checkbox struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Value         bool                   `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
@@ -94,7 +298,7 @@ type Checkbox struct {
 
 func (x *Checkbox) Reset() {
 	*x = Checkbox{}
-	mi := &file_widget_v1_widget_proto_msgTypes[1]
+	mi := &file_widget_v1_widget_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -106,7 +310,7 @@ func (x *Checkbox) String() string {
 func (*Checkbox) ProtoMessage() {}
 
 func (x *Checkbox) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[1]
+	mi := &file_widget_v1_widget_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -119,7 +323,7 @@ func (x *Checkbox) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Checkbox.ProtoReflect.Descriptor instead.
 func (*Checkbox) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{1}
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Checkbox) GetValue() bool {
@@ -171,7 +375,7 @@ type CheckboxGroup struct {
 
 func (x *CheckboxGroup) Reset() {
 	*x = CheckboxGroup{}
-	mi := &file_widget_v1_widget_proto_msgTypes[2]
+	mi := &file_widget_v1_widget_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -183,7 +387,7 @@ func (x *CheckboxGroup) String() string {
 func (*CheckboxGroup) ProtoMessage() {}
 
 func (x *CheckboxGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[2]
+	mi := &file_widget_v1_widget_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -196,7 +400,7 @@ func (x *CheckboxGroup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckboxGroup.ProtoReflect.Descriptor instead.
 func (*CheckboxGroup) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{2}
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CheckboxGroup) GetValue() []int32 {
@@ -241,28 +445,37 @@ func (x *CheckboxGroup) GetDisabled() bool {
 	return false
 }
 
-type ColumnItem struct {
+type CodeEditor struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Weight        float64                `protobuf:"fixed64,1,opt,name=weight,proto3" json:"weight,omitempty"`
+	Value         *string                `protobuf:"bytes,1,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Placeholder   string                 `protobuf:"bytes,3,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
+	DefaultValue  *string                `protobuf:"bytes,4,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
+	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
+	Disabled      bool                   `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Language      string                 `protobuf:"bytes,7,opt,name=language,proto3" json:"language,omitempty"`
+	LineNumbers   bool                   `protobuf:"varint,8,opt,name=line_numbers,json=lineNumbers,proto3" json:"line_numbers,omitempty"`
+	ReadOnly      bool                   `protobuf:"varint,9,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	MaxHeight     *int32                 `protobuf:"varint,10,opt,name=max_height,json=maxHeight,proto3,oneof" json:"max_height,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ColumnItem) Reset() {
-	*x = ColumnItem{}
-	mi := &file_widget_v1_widget_proto_msgTypes[3]
+func (x *CodeEditor) Reset() {
+	*x = CodeEditor{}
+	mi := &file_widget_v1_widget_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ColumnItem) String() string {
+func (x *CodeEditor) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ColumnItem) ProtoMessage() {}
+func (*CodeEditor) ProtoMessage() {}
 
-func (x *ColumnItem) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[3]
+func (x *CodeEditor) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -273,92 +486,108 @@ func (x *ColumnItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ColumnItem.ProtoReflect.Descriptor instead.
-func (*ColumnItem) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use CodeEditor.ProtoReflect.Descriptor instead.
+func (*CodeEditor) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ColumnItem) GetWeight() float64 {
+func (x *CodeEditor) GetValue() string {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return ""
+}
+
+func (x *CodeEditor) GetLabel() string {
 	if x != nil {
-		return x.Weight
+		return x.Label
 	}
-	return 0
+	return ""
 }
 
-type Columns struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Columns       int32                  `protobuf:"varint,1,opt,name=columns,proto3" json:"columns,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CodeEditor) GetPlaceholder() string {
+	if x != nil {
+		return x.Placeholder
+	}
+	return ""
 }
 
-func (x *Columns) Reset() {
-	*x = Columns{}
-	mi := &file_widget_v1_widget_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CodeEditor) GetDefaultValue() string {
+	if x != nil && x.DefaultValue != nil {
+		return *x.DefaultValue
+	}
+	return ""
 }
 
-func (x *Columns) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CodeEditor) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
 }
 
-func (*Columns) ProtoMessage() {}
+func (x *CodeEditor) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
 
-func (x *Columns) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[4]
+func (x *CodeEditor) GetLanguage() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Language
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Columns.ProtoReflect.Descriptor instead.
-func (*Columns) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{4}
+func (x *CodeEditor) GetLineNumbers() bool {
+	if x != nil {
+		return x.LineNumbers
+	}
+	return false
 }
 
-func (x *Columns) GetColumns() int32 {
+func (x *CodeEditor) GetReadOnly() bool {
 	if x != nil {
-		return x.Columns
+		return x.ReadOnly
+	}
+	return false
+}
+
+func (x *CodeEditor) GetMaxHeight() int32 {
+	if x != nil && x.MaxHeight != nil {
+		return *x.MaxHeight
 	}
 	return 0
 }
 
-type DateInput struct {
+type ColorInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Value         *string                `protobuf:"bytes,1,opt,name=value,proto3,oneof" json:"value,omitempty"`
 	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	Placeholder   string                 `protobuf:"bytes,3,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
-	DefaultValue  *string                `protobuf:"bytes,4,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
-	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
-	Disabled      bool                   `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	Format        string                 `protobuf:"bytes,7,opt,name=format,proto3" json:"format,omitempty"`
-	MaxValue      string                 `protobuf:"bytes,8,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
-	MinValue      string                 `protobuf:"bytes,9,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
+	DefaultValue  *string                `protobuf:"bytes,3,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
+	Required      bool                   `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	Disabled      bool                   `protobuf:"varint,5,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Swatches      []string               `protobuf:"bytes,6,rep,name=swatches,proto3" json:"swatches,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DateInput) Reset() {
-	*x = DateInput{}
-	mi := &file_widget_v1_widget_proto_msgTypes[5]
+func (x *ColorInput) Reset() {
+	*x = ColorInput{}
+	mi := &file_widget_v1_widget_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DateInput) String() string {
+func (x *ColorInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DateInput) ProtoMessage() {}
+func (*ColorInput) ProtoMessage() {}
 
-func (x *DateInput) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[5]
+func (x *ColorInput) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -369,75 +598,142 @@ func (x *DateInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DateInput.ProtoReflect.Descriptor instead.
-func (*DateInput) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ColorInput.ProtoReflect.Descriptor instead.
+func (*ColorInput) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *DateInput) GetValue() string {
+func (x *ColorInput) GetValue() string {
 	if x != nil && x.Value != nil {
 		return *x.Value
 	}
 	return ""
 }
 
-func (x *DateInput) GetLabel() string {
+func (x *ColorInput) GetLabel() string {
 	if x != nil {
 		return x.Label
 	}
 	return ""
 }
 
-func (x *DateInput) GetPlaceholder() string {
-	if x != nil {
-		return x.Placeholder
-	}
-	return ""
-}
-
-func (x *DateInput) GetDefaultValue() string {
+func (x *ColorInput) GetDefaultValue() string {
 	if x != nil && x.DefaultValue != nil {
 		return *x.DefaultValue
 	}
 	return ""
 }
 
-func (x *DateInput) GetRequired() bool {
+func (x *ColorInput) GetRequired() bool {
 	if x != nil {
 		return x.Required
 	}
 	return false
 }
 
-func (x *DateInput) GetDisabled() bool {
+func (x *ColorInput) GetDisabled() bool {
 	if x != nil {
 		return x.Disabled
 	}
 	return false
 }
 
-func (x *DateInput) GetFormat() string {
+func (x *ColorInput) GetSwatches() []string {
 	if x != nil {
-		return x.Format
+		return x.Swatches
 	}
-	return ""
+	return nil
 }
 
-func (x *DateInput) GetMaxValue() string {
+type ColumnItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Weight        float64                `protobuf:"fixed64,1,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ColumnItem) Reset() {
+	*x = ColumnItem{}
+	mi := &file_widget_v1_widget_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ColumnItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColumnItem) ProtoMessage() {}
+
+func (x *ColumnItem) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[8]
 	if x != nil {
-		return x.MaxValue
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *DateInput) GetMinValue() string {
+// Deprecated: Use ColumnItem.ProtoReflect.Descriptor instead.
+func (*ColumnItem) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ColumnItem) GetWeight() float64 {
 	if x != nil {
-		return x.MinValue
+		return x.Weight
 	}
-	return ""
+	return 0
 }
 
-type DateTimeInput struct {
+type Columns struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Columns       int32                  `protobuf:"varint,1,opt,name=columns,proto3" json:"columns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Columns) Reset() {
+	*x = Columns{}
+	mi := &file_widget_v1_widget_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Columns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Columns) ProtoMessage() {}
+
+func (x *Columns) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Columns.ProtoReflect.Descriptor instead.
+func (*Columns) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Columns) GetColumns() int32 {
+	if x != nil {
+		return x.Columns
+	}
+	return 0
+}
+
+type DateInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Value         *string                `protobuf:"bytes,1,opt,name=value,proto3,oneof" json:"value,omitempty"`
 	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
@@ -452,21 +748,21 @@ type DateTimeInput struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DateTimeInput) Reset() {
-	*x = DateTimeInput{}
-	mi := &file_widget_v1_widget_proto_msgTypes[6]
+func (x *DateInput) Reset() {
+	*x = DateInput{}
+	mi := &file_widget_v1_widget_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DateTimeInput) String() string {
+func (x *DateInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DateTimeInput) ProtoMessage() {}
+func (*DateInput) ProtoMessage() {}
 
-func (x *DateTimeInput) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[6]
+func (x *DateInput) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -477,99 +773,106 @@ func (x *DateTimeInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DateTimeInput.ProtoReflect.Descriptor instead.
-func (*DateTimeInput) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use DateInput.ProtoReflect.Descriptor instead.
+func (*DateInput) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DateTimeInput) GetValue() string {
+func (x *DateInput) GetValue() string {
 	if x != nil && x.Value != nil {
 		return *x.Value
 	}
 	return ""
 }
 
-func (x *DateTimeInput) GetLabel() string {
+func (x *DateInput) GetLabel() string {
 	if x != nil {
 		return x.Label
 	}
 	return ""
 }
 
-func (x *DateTimeInput) GetPlaceholder() string {
+func (x *DateInput) GetPlaceholder() string {
 	if x != nil {
 		return x.Placeholder
 	}
 	return ""
 }
 
-func (x *DateTimeInput) GetDefaultValue() string {
+func (x *DateInput) GetDefaultValue() string {
 	if x != nil && x.DefaultValue != nil {
 		return *x.DefaultValue
 	}
 	return ""
 }
 
-func (x *DateTimeInput) GetRequired() bool {
+func (x *DateInput) GetRequired() bool {
 	if x != nil {
 		return x.Required
 	}
 	return false
 }
 
-func (x *DateTimeInput) GetDisabled() bool {
+func (x *DateInput) GetDisabled() bool {
 	if x != nil {
 		return x.Disabled
 	}
 	return false
 }
 
-func (x *DateTimeInput) GetFormat() string {
+func (x *DateInput) GetFormat() string {
 	if x != nil {
 		return x.Format
 	}
 	return ""
 }
 
-func (x *DateTimeInput) GetMaxValue() string {
+func (x *DateInput) GetMaxValue() string {
 	if x != nil {
 		return x.MaxValue
 	}
 	return ""
 }
 
-func (x *DateTimeInput) GetMinValue() string {
+func (x *DateInput) GetMinValue() string {
 	if x != nil {
 		return x.MinValue
 	}
 	return ""
 }
 
-type Form struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Value          bool                   `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
-	ButtonLabel    string                 `protobuf:"bytes,2,opt,name=button_label,json=buttonLabel,proto3" json:"button_label,omitempty"`
-	ButtonDisabled bool                   `protobuf:"varint,3,opt,name=button_disabled,json=buttonDisabled,proto3" json:"button_disabled,omitempty"`
-	ClearOnSubmit  bool                   `protobuf:"varint,4,opt,name=clear_on_submit,json=clearOnSubmit,proto3" json:"clear_on_submit,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *Form) Reset() {
-	*x = Form{}
-	mi := &file_widget_v1_widget_proto_msgTypes[7]
+type DateRangeInput struct {
+	state             protoimpl.MessageState  `protogen:"open.v1"`
+	StartValue        *string                 `protobuf:"bytes,1,opt,name=start_value,json=startValue,proto3,oneof" json:"start_value,omitempty"`
+	EndValue          *string                 `protobuf:"bytes,2,opt,name=end_value,json=endValue,proto3,oneof" json:"end_value,omitempty"`
+	Label             string                  `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	DefaultStartValue *string                 `protobuf:"bytes,4,opt,name=default_start_value,json=defaultStartValue,proto3,oneof" json:"default_start_value,omitempty"`
+	DefaultEndValue   *string                 `protobuf:"bytes,5,opt,name=default_end_value,json=defaultEndValue,proto3,oneof" json:"default_end_value,omitempty"`
+	Required          bool                    `protobuf:"varint,6,opt,name=required,proto3" json:"required,omitempty"`
+	Disabled          bool                    `protobuf:"varint,7,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Format            string                  `protobuf:"bytes,8,opt,name=format,proto3" json:"format,omitempty"`
+	MaxValue          string                  `protobuf:"bytes,9,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+	MinValue          string                  `protobuf:"bytes,10,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
+	Presets           []*DateRangeInputPreset `protobuf:"bytes,11,rep,name=presets,proto3" json:"presets,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DateRangeInput) Reset() {
+	*x = DateRangeInput{}
+	mi := &file_widget_v1_widget_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Form) String() string {
+func (x *DateRangeInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Form) ProtoMessage() {}
+func (*DateRangeInput) ProtoMessage() {}
 
-func (x *Form) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[7]
+func (x *DateRangeInput) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -580,61 +883,112 @@ func (x *Form) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Form.ProtoReflect.Descriptor instead.
-func (*Form) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use DateRangeInput.ProtoReflect.Descriptor instead.
+func (*DateRangeInput) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Form) GetValue() bool {
-	if x != nil {
-		return x.Value
+func (x *DateRangeInput) GetStartValue() string {
+	if x != nil && x.StartValue != nil {
+		return *x.StartValue
 	}
-	return false
+	return ""
 }
 
-func (x *Form) GetButtonLabel() string {
+func (x *DateRangeInput) GetEndValue() string {
+	if x != nil && x.EndValue != nil {
+		return *x.EndValue
+	}
+	return ""
+}
+
+func (x *DateRangeInput) GetLabel() string {
 	if x != nil {
-		return x.ButtonLabel
+		return x.Label
 	}
 	return ""
 }
 
-func (x *Form) GetButtonDisabled() bool {
+func (x *DateRangeInput) GetDefaultStartValue() string {
+	if x != nil && x.DefaultStartValue != nil {
+		return *x.DefaultStartValue
+	}
+	return ""
+}
+
+func (x *DateRangeInput) GetDefaultEndValue() string {
+	if x != nil && x.DefaultEndValue != nil {
+		return *x.DefaultEndValue
+	}
+	return ""
+}
+
+func (x *DateRangeInput) GetRequired() bool {
 	if x != nil {
-		return x.ButtonDisabled
+		return x.Required
 	}
 	return false
 }
 
-func (x *Form) GetClearOnSubmit() bool {
+func (x *DateRangeInput) GetDisabled() bool {
 	if x != nil {
-		return x.ClearOnSubmit
+		return x.Disabled
 	}
 	return false
 }
 
-type Markdown struct {
+func (x *DateRangeInput) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *DateRangeInput) GetMaxValue() string {
+	if x != nil {
+		return x.MaxValue
+	}
+	return ""
+}
+
+func (x *DateRangeInput) GetMinValue() string {
+	if x != nil {
+		return x.MinValue
+	}
+	return ""
+}
+
+func (x *DateRangeInput) GetPresets() []*DateRangeInputPreset {
+	if x != nil {
+		return x.Presets
+	}
+	return nil
+}
+
+type DateRangeInputPreset struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Body          string                 `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	StartValue    string                 `protobuf:"bytes,2,opt,name=start_value,json=startValue,proto3" json:"start_value,omitempty"`
+	EndValue      string                 `protobuf:"bytes,3,opt,name=end_value,json=endValue,proto3" json:"end_value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Markdown) Reset() {
-	*x = Markdown{}
-	mi := &file_widget_v1_widget_proto_msgTypes[8]
+func (x *DateRangeInputPreset) Reset() {
+	*x = DateRangeInputPreset{}
+	mi := &file_widget_v1_widget_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Markdown) String() string {
+func (x *DateRangeInputPreset) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Markdown) ProtoMessage() {}
+func (*DateRangeInputPreset) ProtoMessage() {}
 
-func (x *Markdown) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[8]
+func (x *DateRangeInputPreset) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -645,46 +999,62 @@ func (x *Markdown) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Markdown.ProtoReflect.Descriptor instead.
-func (*Markdown) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use DateRangeInputPreset.ProtoReflect.Descriptor instead.
+func (*DateRangeInputPreset) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Markdown) GetBody() string {
+func (x *DateRangeInputPreset) GetLabel() string {
 	if x != nil {
-		return x.Body
+		return x.Label
 	}
 	return ""
 }
 
-type MultiSelect struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Value         []int32                `protobuf:"varint,1,rep,packed,name=value,proto3" json:"value,omitempty"`
-	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	Options       []string               `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
-	Placeholder   string                 `protobuf:"bytes,4,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
-	DefaultValue  []int32                `protobuf:"varint,5,rep,packed,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
-	Required      bool                   `protobuf:"varint,6,opt,name=required,proto3" json:"required,omitempty"`
-	Disabled      bool                   `protobuf:"varint,7,opt,name=disabled,proto3" json:"disabled,omitempty"`
+func (x *DateRangeInputPreset) GetStartValue() string {
+	if x != nil {
+		return x.StartValue
+	}
+	return ""
+}
+
+func (x *DateRangeInputPreset) GetEndValue() string {
+	if x != nil {
+		return x.EndValue
+	}
+	return ""
+}
+
+type DateTimeInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         *string                `protobuf:"bytes,1,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Placeholder   string                 `protobuf:"bytes,3,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
+	DefaultValue  *string                `protobuf:"bytes,4,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
+	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
+	Disabled      bool                   `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Format        string                 `protobuf:"bytes,7,opt,name=format,proto3" json:"format,omitempty"`
+	MaxValue      string                 `protobuf:"bytes,8,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+	MinValue      string                 `protobuf:"bytes,9,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MultiSelect) Reset() {
-	*x = MultiSelect{}
-	mi := &file_widget_v1_widget_proto_msgTypes[9]
+func (x *DateTimeInput) Reset() {
+	*x = DateTimeInput{}
+	mi := &file_widget_v1_widget_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MultiSelect) String() string {
+func (x *DateTimeInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MultiSelect) ProtoMessage() {}
+func (*DateTimeInput) ProtoMessage() {}
 
-func (x *MultiSelect) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[9]
+func (x *DateTimeInput) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -695,89 +1065,99 @@ func (x *MultiSelect) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MultiSelect.ProtoReflect.Descriptor instead.
-func (*MultiSelect) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use DateTimeInput.ProtoReflect.Descriptor instead.
+func (*DateTimeInput) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *MultiSelect) GetValue() []int32 {
-	if x != nil {
-		return x.Value
+func (x *DateTimeInput) GetValue() string {
+	if x != nil && x.Value != nil {
+		return *x.Value
 	}
-	return nil
+	return ""
 }
 
-func (x *MultiSelect) GetLabel() string {
+func (x *DateTimeInput) GetLabel() string {
 	if x != nil {
 		return x.Label
 	}
 	return ""
 }
 
-func (x *MultiSelect) GetOptions() []string {
-	if x != nil {
-		return x.Options
-	}
-	return nil
-}
-
-func (x *MultiSelect) GetPlaceholder() string {
+func (x *DateTimeInput) GetPlaceholder() string {
 	if x != nil {
 		return x.Placeholder
 	}
 	return ""
 }
 
-func (x *MultiSelect) GetDefaultValue() []int32 {
-	if x != nil {
-		return x.DefaultValue
+func (x *DateTimeInput) GetDefaultValue() string {
+	if x != nil && x.DefaultValue != nil {
+		return *x.DefaultValue
 	}
-	return nil
+	return ""
 }
 
-func (x *MultiSelect) GetRequired() bool {
+func (x *DateTimeInput) GetRequired() bool {
 	if x != nil {
 		return x.Required
 	}
 	return false
 }
 
-func (x *MultiSelect) GetDisabled() bool {
+func (x *DateTimeInput) GetDisabled() bool {
 	if x != nil {
 		return x.Disabled
 	}
 	return false
 }
 
-type NumberInput struct {
+func (x *DateTimeInput) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *DateTimeInput) GetMaxValue() string {
+	if x != nil {
+		return x.MaxValue
+	}
+	return ""
+}
+
+func (x *DateTimeInput) GetMinValue() string {
+	if x != nil {
+		return x.MinValue
+	}
+	return ""
+}
+
+type Dialog struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Value         *float64               `protobuf:"fixed64,1,opt,name=value,proto3,oneof" json:"value,omitempty"`
-	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	Placeholder   string                 `protobuf:"bytes,3,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
-	DefaultValue  *float64               `protobuf:"fixed64,4,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
-	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
-	Disabled      bool                   `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	MaxValue      *float64               `protobuf:"fixed64,7,opt,name=max_value,json=maxValue,proto3,oneof" json:"max_value,omitempty"`
-	MinValue      *float64               `protobuf:"fixed64,8,opt,name=min_value,json=minValue,proto3,oneof" json:"min_value,omitempty"`
+	Value         bool                   `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Open          bool                   `protobuf:"varint,3,opt,name=open,proto3" json:"open,omitempty"`
+	CloseOnSubmit bool                   `protobuf:"varint,4,opt,name=close_on_submit,json=closeOnSubmit,proto3" json:"close_on_submit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NumberInput) Reset() {
-	*x = NumberInput{}
-	mi := &file_widget_v1_widget_proto_msgTypes[10]
+func (x *Dialog) Reset() {
+	*x = Dialog{}
+	mi := &file_widget_v1_widget_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NumberInput) String() string {
+func (x *Dialog) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NumberInput) ProtoMessage() {}
+func (*Dialog) ProtoMessage() {}
 
-func (x *NumberInput) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[10]
+func (x *Dialog) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -788,94 +1168,101 @@ func (x *NumberInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NumberInput.ProtoReflect.Descriptor instead.
-func (*NumberInput) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *NumberInput) GetValue() float64 {
-	if x != nil && x.Value != nil {
-		return *x.Value
-	}
-	return 0
+// Deprecated: Use Dialog.ProtoReflect.Descriptor instead.
+func (*Dialog) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *NumberInput) GetLabel() string {
+func (x *Dialog) GetValue() bool {
 	if x != nil {
-		return x.Label
+		return x.Value
 	}
-	return ""
+	return false
 }
 
-func (x *NumberInput) GetPlaceholder() string {
+func (x *Dialog) GetTitle() string {
 	if x != nil {
-		return x.Placeholder
+		return x.Title
 	}
 	return ""
 }
 
-func (x *NumberInput) GetDefaultValue() float64 {
-	if x != nil && x.DefaultValue != nil {
-		return *x.DefaultValue
-	}
-	return 0
-}
-
-func (x *NumberInput) GetRequired() bool {
+func (x *Dialog) GetOpen() bool {
 	if x != nil {
-		return x.Required
+		return x.Open
 	}
 	return false
 }
 
-func (x *NumberInput) GetDisabled() bool {
+func (x *Dialog) GetCloseOnSubmit() bool {
 	if x != nil {
-		return x.Disabled
+		return x.CloseOnSubmit
 	}
 	return false
 }
 
-func (x *NumberInput) GetMaxValue() float64 {
-	if x != nil && x.MaxValue != nil {
-		return *x.MaxValue
-	}
-	return 0
+type Divider struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NumberInput) GetMinValue() float64 {
-	if x != nil && x.MinValue != nil {
-		return *x.MinValue
+func (x *Divider) Reset() {
+	*x = Divider{}
+	mi := &file_widget_v1_widget_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Divider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Divider) ProtoMessage() {}
+
+func (x *Divider) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-type Radio struct {
+// Deprecated: Use Divider.ProtoReflect.Descriptor instead.
+func (*Divider) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{15}
+}
+
+type DownloadButton struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Value         *int32                 `protobuf:"varint,1,opt,name=value,proto3,oneof" json:"value,omitempty"`
-	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	Options       []string               `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
-	DefaultValue  *int32                 `protobuf:"varint,4,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
-	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
-	Disabled      bool                   `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	MimeType      string                 `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Size          int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Disabled      bool                   `protobuf:"varint,5,opt,name=disabled,proto3" json:"disabled,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Radio) Reset() {
-	*x = Radio{}
-	mi := &file_widget_v1_widget_proto_msgTypes[11]
+func (x *DownloadButton) Reset() {
+	*x = DownloadButton{}
+	mi := &file_widget_v1_widget_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Radio) String() string {
+func (x *DownloadButton) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Radio) ProtoMessage() {}
+func (*DownloadButton) ProtoMessage() {}
 
-func (x *Radio) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[11]
+func (x *DownloadButton) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -886,81 +1273,69 @@ func (x *Radio) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Radio.ProtoReflect.Descriptor instead.
-func (*Radio) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *Radio) GetValue() int32 {
-	if x != nil && x.Value != nil {
-		return *x.Value
-	}
-	return 0
+// Deprecated: Use DownloadButton.ProtoReflect.Descriptor instead.
+func (*DownloadButton) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Radio) GetLabel() string {
+func (x *DownloadButton) GetLabel() string {
 	if x != nil {
 		return x.Label
 	}
 	return ""
 }
 
-func (x *Radio) GetOptions() []string {
+func (x *DownloadButton) GetFileName() string {
 	if x != nil {
-		return x.Options
+		return x.FileName
 	}
-	return nil
+	return ""
 }
 
-func (x *Radio) GetDefaultValue() int32 {
-	if x != nil && x.DefaultValue != nil {
-		return *x.DefaultValue
+func (x *DownloadButton) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
 	}
-	return 0
+	return ""
 }
 
-func (x *Radio) GetRequired() bool {
+func (x *DownloadButton) GetSize() int64 {
 	if x != nil {
-		return x.Required
+		return x.Size
 	}
-	return false
+	return 0
 }
 
-func (x *Radio) GetDisabled() bool {
+func (x *DownloadButton) GetDisabled() bool {
 	if x != nil {
 		return x.Disabled
 	}
 	return false
 }
 
-type Selectbox struct {
+type Expander struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Value         *int32                 `protobuf:"varint,1,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	Value         bool                   `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
 	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	Options       []string               `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
-	Placeholder   string                 `protobuf:"bytes,4,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
-	DefaultValue  *int32                 `protobuf:"varint,5,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
-	Required      bool                   `protobuf:"varint,6,opt,name=required,proto3" json:"required,omitempty"`
-	Disabled      bool                   `protobuf:"varint,7,opt,name=disabled,proto3" json:"disabled,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Selectbox) Reset() {
-	*x = Selectbox{}
-	mi := &file_widget_v1_widget_proto_msgTypes[12]
+func (x *Expander) Reset() {
+	*x = Expander{}
+	mi := &file_widget_v1_widget_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Selectbox) String() string {
+func (x *Expander) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Selectbox) ProtoMessage() {}
+func (*Expander) ProtoMessage() {}
 
-func (x *Selectbox) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[12]
+func (x *Expander) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -971,89 +1346,142 @@ func (x *Selectbox) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Selectbox.ProtoReflect.Descriptor instead.
-func (*Selectbox) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use Expander.ProtoReflect.Descriptor instead.
+func (*Expander) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Selectbox) GetValue() int32 {
-	if x != nil && x.Value != nil {
-		return *x.Value
+func (x *Expander) GetValue() bool {
+	if x != nil {
+		return x.Value
 	}
-	return 0
+	return false
 }
 
-func (x *Selectbox) GetLabel() string {
+func (x *Expander) GetLabel() string {
 	if x != nil {
 		return x.Label
 	}
 	return ""
 }
 
-func (x *Selectbox) GetOptions() []string {
-	if x != nil {
-		return x.Options
-	}
-	return nil
-}
+type FileInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         []*FileInputFile       `protobuf:"bytes,1,rep,name=value,proto3" json:"value,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Accept        []string               `protobuf:"bytes,3,rep,name=accept,proto3" json:"accept,omitempty"`
+	MaxFileSize   *int64                 `protobuf:"varint,4,opt,name=max_file_size,json=maxFileSize,proto3,oneof" json:"max_file_size,omitempty"`
+	Multiple      bool                   `protobuf:"varint,5,opt,name=multiple,proto3" json:"multiple,omitempty"`
+	Required      bool                   `protobuf:"varint,6,opt,name=required,proto3" json:"required,omitempty"`
+	Disabled      bool                   `protobuf:"varint,7,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-func (x *Selectbox) GetPlaceholder() string {
+func (x *FileInput) Reset() {
+	*x = FileInput{}
+	mi := &file_widget_v1_widget_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileInput) ProtoMessage() {}
+
+func (x *FileInput) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[18]
 	if x != nil {
-		return x.Placeholder
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileInput.ProtoReflect.Descriptor instead.
+func (*FileInput) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *FileInput) GetValue() []*FileInputFile {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *FileInput) GetLabel() string {
+	if x != nil {
+		return x.Label
 	}
 	return ""
 }
 
-func (x *Selectbox) GetDefaultValue() int32 {
-	if x != nil && x.DefaultValue != nil {
-		return *x.DefaultValue
+func (x *FileInput) GetAccept() []string {
+	if x != nil {
+		return x.Accept
+	}
+	return nil
+}
+
+func (x *FileInput) GetMaxFileSize() int64 {
+	if x != nil && x.MaxFileSize != nil {
+		return *x.MaxFileSize
 	}
 	return 0
 }
 
-func (x *Selectbox) GetRequired() bool {
+func (x *FileInput) GetMultiple() bool {
+	if x != nil {
+		return x.Multiple
+	}
+	return false
+}
+
+func (x *FileInput) GetRequired() bool {
 	if x != nil {
 		return x.Required
 	}
 	return false
 }
 
-func (x *Selectbox) GetDisabled() bool {
+func (x *FileInput) GetDisabled() bool {
 	if x != nil {
 		return x.Disabled
 	}
 	return false
 }
 
-type Table struct {
+type FileInputFile struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Value         *TableValue            `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	Header        string                 `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Height        *int32                 `protobuf:"varint,5,opt,name=height,proto3,oneof" json:"height,omitempty"`
-	ColumnOrder   []string               `protobuf:"bytes,6,rep,name=column_order,json=columnOrder,proto3" json:"column_order,omitempty"`
-	OnSelect      string                 `protobuf:"bytes,7,opt,name=on_select,json=onSelect,proto3" json:"on_select,omitempty"`
-	RowSelection  string                 `protobuf:"bytes,8,opt,name=row_selection,json=rowSelection,proto3" json:"row_selection,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	MimeType      string                 `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Size          int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Table) Reset() {
-	*x = Table{}
-	mi := &file_widget_v1_widget_proto_msgTypes[13]
+func (x *FileInputFile) Reset() {
+	*x = FileInputFile{}
+	mi := &file_widget_v1_widget_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Table) String() string {
+func (x *FileInputFile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Table) ProtoMessage() {}
+func (*FileInputFile) ProtoMessage() {}
 
-func (x *Table) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[13]
+func (x *FileInputFile) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1064,89 +1492,129 @@ func (x *Table) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Table.ProtoReflect.Descriptor instead.
-func (*Table) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use FileInputFile.ProtoReflect.Descriptor instead.
+func (*FileInputFile) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *Table) GetData() []byte {
+func (x *FileInputFile) GetId() string {
 	if x != nil {
-		return x.Data
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *Table) GetValue() *TableValue {
+func (x *FileInputFile) GetName() string {
 	if x != nil {
-		return x.Value
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *Table) GetHeader() string {
+func (x *FileInputFile) GetMimeType() string {
 	if x != nil {
-		return x.Header
+		return x.MimeType
 	}
 	return ""
 }
 
-func (x *Table) GetDescription() string {
+func (x *FileInputFile) GetSize() int64 {
 	if x != nil {
-		return x.Description
+		return x.Size
 	}
-	return ""
+	return 0
 }
 
-func (x *Table) GetHeight() int32 {
-	if x != nil && x.Height != nil {
-		return *x.Height
+type Form struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Value          bool                   `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	ButtonLabel    string                 `protobuf:"bytes,2,opt,name=button_label,json=buttonLabel,proto3" json:"button_label,omitempty"`
+	ButtonDisabled bool                   `protobuf:"varint,3,opt,name=button_disabled,json=buttonDisabled,proto3" json:"button_disabled,omitempty"`
+	ClearOnSubmit  bool                   `protobuf:"varint,4,opt,name=clear_on_submit,json=clearOnSubmit,proto3" json:"clear_on_submit,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Form) Reset() {
+	*x = Form{}
+	mi := &file_widget_v1_widget_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Form) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Form) ProtoMessage() {}
+
+func (x *Form) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Table) GetColumnOrder() []string {
+// Deprecated: Use Form.ProtoReflect.Descriptor instead.
+func (*Form) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Form) GetValue() bool {
 	if x != nil {
-		return x.ColumnOrder
+		return x.Value
 	}
-	return nil
+	return false
 }
 
-func (x *Table) GetOnSelect() string {
+func (x *Form) GetButtonLabel() string {
 	if x != nil {
-		return x.OnSelect
+		return x.ButtonLabel
 	}
 	return ""
 }
 
-func (x *Table) GetRowSelection() string {
+func (x *Form) GetButtonDisabled() bool {
 	if x != nil {
-		return x.RowSelection
+		return x.ButtonDisabled
 	}
-	return ""
+	return false
 }
 
-type TableValue struct {
+func (x *Form) GetClearOnSubmit() bool {
+	if x != nil {
+		return x.ClearOnSubmit
+	}
+	return false
+}
+
+type Header struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Selection     *TableValueSelection   `protobuf:"bytes,1,opt,name=selection,proto3,oneof" json:"selection,omitempty"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TableValue) Reset() {
-	*x = TableValue{}
-	mi := &file_widget_v1_widget_proto_msgTypes[14]
+func (x *Header) Reset() {
+	*x = Header{}
+	mi := &file_widget_v1_widget_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TableValue) String() string {
+func (x *Header) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TableValue) ProtoMessage() {}
+func (*Header) ProtoMessage() {}
 
-func (x *TableValue) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[14]
+func (x *Header) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1157,41 +1625,44 @@ func (x *TableValue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TableValue.ProtoReflect.Descriptor instead.
-func (*TableValue) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use Header.ProtoReflect.Descriptor instead.
+func (*Header) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *TableValue) GetSelection() *TableValueSelection {
+func (x *Header) GetText() string {
 	if x != nil {
-		return x.Selection
+		return x.Text
 	}
-	return nil
+	return ""
 }
 
-type TableValueSelection struct {
+type Image struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
-	Rows          []int32                `protobuf:"varint,2,rep,packed,name=rows,proto3" json:"rows,omitempty"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	MimeType      string                 `protobuf:"bytes,2,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Width         *int32                 `protobuf:"varint,4,opt,name=width,proto3,oneof" json:"width,omitempty"`
+	Caption       string                 `protobuf:"bytes,5,opt,name=caption,proto3" json:"caption,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TableValueSelection) Reset() {
-	*x = TableValueSelection{}
-	mi := &file_widget_v1_widget_proto_msgTypes[15]
+func (x *Image) Reset() {
+	*x = Image{}
+	mi := &file_widget_v1_widget_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TableValueSelection) String() string {
+func (x *Image) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TableValueSelection) ProtoMessage() {}
+func (*Image) ProtoMessage() {}
 
-func (x *TableValueSelection) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[15]
+func (x *Image) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1202,57 +1673,70 @@ func (x *TableValueSelection) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TableValueSelection.ProtoReflect.Descriptor instead.
-func (*TableValueSelection) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use Image.ProtoReflect.Descriptor instead.
+func (*Image) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *TableValueSelection) GetRow() int32 {
+func (x *Image) GetUrl() string {
 	if x != nil {
-		return x.Row
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Image) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *Image) GetSize() int64 {
+	if x != nil {
+		return x.Size
 	}
 	return 0
 }
 
-func (x *TableValueSelection) GetRows() []int32 {
+func (x *Image) GetWidth() int32 {
+	if x != nil && x.Width != nil {
+		return *x.Width
+	}
+	return 0
+}
+
+func (x *Image) GetCaption() string {
 	if x != nil {
-		return x.Rows
+		return x.Caption
 	}
-	return nil
+	return ""
 }
 
-type TextArea struct {
+type Json struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Value         *string                `protobuf:"bytes,1,opt,name=value,proto3,oneof" json:"value,omitempty"`
-	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	Placeholder   string                 `protobuf:"bytes,3,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
-	DefaultValue  *string                `protobuf:"bytes,4,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
-	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
-	Disabled      bool                   `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	MaxLength     *int32                 `protobuf:"varint,7,opt,name=max_length,json=maxLength,proto3,oneof" json:"max_length,omitempty"`
-	MinLength     *int32                 `protobuf:"varint,8,opt,name=min_length,json=minLength,proto3,oneof" json:"min_length,omitempty"`
-	MaxLines      *int32                 `protobuf:"varint,9,opt,name=max_lines,json=maxLines,proto3,oneof" json:"max_lines,omitempty"`
-	MinLines      *int32                 `protobuf:"varint,10,opt,name=min_lines,json=minLines,proto3,oneof" json:"min_lines,omitempty"`
-	AutoResize    bool                   `protobuf:"varint,11,opt,name=auto_resize,json=autoResize,proto3" json:"auto_resize,omitempty"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	ExpandedDepth int32                  `protobuf:"varint,2,opt,name=expanded_depth,json=expandedDepth,proto3" json:"expanded_depth,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TextArea) Reset() {
-	*x = TextArea{}
-	mi := &file_widget_v1_widget_proto_msgTypes[16]
+func (x *Json) Reset() {
+	*x = Json{}
+	mi := &file_widget_v1_widget_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TextArea) String() string {
+func (x *Json) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TextArea) ProtoMessage() {}
+func (*Json) ProtoMessage() {}
 
-func (x *TextArea) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[16]
+func (x *Json) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1263,117 +1747,106 @@ func (x *TextArea) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TextArea.ProtoReflect.Descriptor instead.
-func (*TextArea) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use Json.ProtoReflect.Descriptor instead.
+func (*Json) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *TextArea) GetValue() string {
-	if x != nil && x.Value != nil {
-		return *x.Value
+func (x *Json) GetData() []byte {
+	if x != nil {
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *TextArea) GetLabel() string {
+func (x *Json) GetExpandedDepth() int32 {
 	if x != nil {
-		return x.Label
+		return x.ExpandedDepth
 	}
-	return ""
+	return 0
 }
 
-func (x *TextArea) GetPlaceholder() string {
+func (x *Json) GetError() string {
 	if x != nil {
-		return x.Placeholder
+		return x.Error
 	}
 	return ""
 }
 
-func (x *TextArea) GetDefaultValue() string {
-	if x != nil && x.DefaultValue != nil {
-		return *x.DefaultValue
-	}
-	return ""
+type Link struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TextArea) GetRequired() bool {
-	if x != nil {
-		return x.Required
-	}
-	return false
+func (x *Link) Reset() {
+	*x = Link{}
+	mi := &file_widget_v1_widget_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *TextArea) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
+func (x *Link) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *TextArea) GetMaxLength() int32 {
-	if x != nil && x.MaxLength != nil {
-		return *x.MaxLength
-	}
-	return 0
-}
+func (*Link) ProtoMessage() {}
 
-func (x *TextArea) GetMinLength() int32 {
-	if x != nil && x.MinLength != nil {
-		return *x.MinLength
+func (x *Link) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *TextArea) GetMaxLines() int32 {
-	if x != nil && x.MaxLines != nil {
-		return *x.MaxLines
-	}
-	return 0
+// Deprecated: Use Link.ProtoReflect.Descriptor instead.
+func (*Link) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *TextArea) GetMinLines() int32 {
-	if x != nil && x.MinLines != nil {
-		return *x.MinLines
+func (x *Link) GetLabel() string {
+	if x != nil {
+		return x.Label
 	}
-	return 0
+	return ""
 }
 
-func (x *TextArea) GetAutoResize() bool {
+func (x *Link) GetUrl() string {
 	if x != nil {
-		return x.AutoResize
+		return x.Url
 	}
-	return false
+	return ""
 }
 
-type TextInput struct {
+type Markdown struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Value         *string                `protobuf:"bytes,1,opt,name=value,proto3,oneof" json:"value,omitempty"`
-	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	Placeholder   string                 `protobuf:"bytes,3,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
-	DefaultValue  *string                `protobuf:"bytes,4,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
-	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
-	Disabled      bool                   `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	MaxLength     *int32                 `protobuf:"varint,7,opt,name=max_length,json=maxLength,proto3,oneof" json:"max_length,omitempty"`
-	MinLength     *int32                 `protobuf:"varint,8,opt,name=min_length,json=minLength,proto3,oneof" json:"min_length,omitempty"`
+	Body          string                 `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TextInput) Reset() {
-	*x = TextInput{}
-	mi := &file_widget_v1_widget_proto_msgTypes[17]
+func (x *Markdown) Reset() {
+	*x = Markdown{}
+	mi := &file_widget_v1_widget_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TextInput) String() string {
+func (x *Markdown) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TextInput) ProtoMessage() {}
+func (*Markdown) ProtoMessage() {}
 
-func (x *TextInput) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[17]
+func (x *Markdown) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1384,94 +1857,215 @@ func (x *TextInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TextInput.ProtoReflect.Descriptor instead.
-func (*TextInput) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use Markdown.ProtoReflect.Descriptor instead.
+func (*Markdown) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *TextInput) GetValue() string {
-	if x != nil && x.Value != nil {
-		return *x.Value
+func (x *Markdown) GetBody() string {
+	if x != nil {
+		return x.Body
 	}
 	return ""
 }
 
-func (x *TextInput) GetLabel() string {
+type Metric struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Label          string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Value          string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Delta          *string                `protobuf:"bytes,3,opt,name=delta,proto3,oneof" json:"delta,omitempty"`
+	DeltaDirection string                 `protobuf:"bytes,4,opt,name=delta_direction,json=deltaDirection,proto3" json:"delta_direction,omitempty"`
+	DeltaColor     string                 `protobuf:"bytes,5,opt,name=delta_color,json=deltaColor,proto3" json:"delta_color,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Metric) Reset() {
+	*x = Metric{}
+	mi := &file_widget_v1_widget_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Metric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Metric) ProtoMessage() {}
+
+func (x *Metric) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Metric.ProtoReflect.Descriptor instead.
+func (*Metric) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Metric) GetLabel() string {
 	if x != nil {
 		return x.Label
 	}
 	return ""
 }
 
-func (x *TextInput) GetPlaceholder() string {
+func (x *Metric) GetValue() string {
 	if x != nil {
-		return x.Placeholder
+		return x.Value
 	}
 	return ""
 }
 
-func (x *TextInput) GetDefaultValue() string {
-	if x != nil && x.DefaultValue != nil {
-		return *x.DefaultValue
+func (x *Metric) GetDelta() string {
+	if x != nil && x.Delta != nil {
+		return *x.Delta
 	}
 	return ""
 }
 
-func (x *TextInput) GetRequired() bool {
+func (x *Metric) GetDeltaDirection() string {
 	if x != nil {
-		return x.Required
+		return x.DeltaDirection
 	}
-	return false
+	return ""
 }
 
-func (x *TextInput) GetDisabled() bool {
+func (x *Metric) GetDeltaColor() string {
 	if x != nil {
-		return x.Disabled
+		return x.DeltaColor
 	}
-	return false
+	return ""
 }
 
-func (x *TextInput) GetMaxLength() int32 {
-	if x != nil && x.MaxLength != nil {
-		return *x.MaxLength
+type MultiSelect struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         []int32                `protobuf:"varint,1,rep,packed,name=value,proto3" json:"value,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Options       []string               `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+	Placeholder   string                 `protobuf:"bytes,4,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
+	DefaultValue  []int32                `protobuf:"varint,5,rep,packed,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	Required      bool                   `protobuf:"varint,6,opt,name=required,proto3" json:"required,omitempty"`
+	Disabled      bool                   `protobuf:"varint,7,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiSelect) Reset() {
+	*x = MultiSelect{}
+	mi := &file_widget_v1_widget_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiSelect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiSelect) ProtoMessage() {}
+
+func (x *MultiSelect) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *TextInput) GetMinLength() int32 {
-	if x != nil && x.MinLength != nil {
-		return *x.MinLength
+// Deprecated: Use MultiSelect.ProtoReflect.Descriptor instead.
+func (*MultiSelect) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *MultiSelect) GetValue() []int32 {
+	if x != nil {
+		return x.Value
 	}
-	return 0
+	return nil
 }
 
-type TimeInput struct {
+func (x *MultiSelect) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *MultiSelect) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *MultiSelect) GetPlaceholder() string {
+	if x != nil {
+		return x.Placeholder
+	}
+	return ""
+}
+
+func (x *MultiSelect) GetDefaultValue() []int32 {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return nil
+}
+
+func (x *MultiSelect) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *MultiSelect) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+type NumberInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Value         *string                `protobuf:"bytes,1,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	Value         *float64               `protobuf:"fixed64,1,opt,name=value,proto3,oneof" json:"value,omitempty"`
 	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
 	Placeholder   string                 `protobuf:"bytes,3,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
-	DefaultValue  *string                `protobuf:"bytes,4,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
+	DefaultValue  *float64               `protobuf:"fixed64,4,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
 	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
 	Disabled      bool                   `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	MaxValue      *float64               `protobuf:"fixed64,7,opt,name=max_value,json=maxValue,proto3,oneof" json:"max_value,omitempty"`
+	MinValue      *float64               `protobuf:"fixed64,8,opt,name=min_value,json=minValue,proto3,oneof" json:"min_value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TimeInput) Reset() {
-	*x = TimeInput{}
-	mi := &file_widget_v1_widget_proto_msgTypes[18]
+func (x *NumberInput) Reset() {
+	*x = NumberInput{}
+	mi := &file_widget_v1_widget_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TimeInput) String() string {
+func (x *NumberInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TimeInput) ProtoMessage() {}
+func (*NumberInput) ProtoMessage() {}
 
-func (x *TimeInput) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[18]
+func (x *NumberInput) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1482,272 +2076,2416 @@ func (x *TimeInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TimeInput.ProtoReflect.Descriptor instead.
-func (*TimeInput) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use NumberInput.ProtoReflect.Descriptor instead.
+func (*NumberInput) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *TimeInput) GetValue() string {
+func (x *NumberInput) GetValue() float64 {
 	if x != nil && x.Value != nil {
 		return *x.Value
 	}
-	return ""
+	return 0
+}
+
+func (x *NumberInput) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *NumberInput) GetPlaceholder() string {
+	if x != nil {
+		return x.Placeholder
+	}
+	return ""
+}
+
+func (x *NumberInput) GetDefaultValue() float64 {
+	if x != nil && x.DefaultValue != nil {
+		return *x.DefaultValue
+	}
+	return 0
+}
+
+func (x *NumberInput) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *NumberInput) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *NumberInput) GetMaxValue() float64 {
+	if x != nil && x.MaxValue != nil {
+		return *x.MaxValue
+	}
+	return 0
+}
+
+func (x *NumberInput) GetMinValue() float64 {
+	if x != nil && x.MinValue != nil {
+		return *x.MinValue
+	}
+	return 0
+}
+
+type PageLink struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	PageId        string                 `protobuf:"bytes,2,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	Route         string                 `protobuf:"bytes,3,opt,name=route,proto3" json:"route,omitempty"`
+	Query         string                 `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	Disabled      bool                   `protobuf:"varint,5,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PageLink) Reset() {
+	*x = PageLink{}
+	mi := &file_widget_v1_widget_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageLink) ProtoMessage() {}
+
+func (x *PageLink) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PageLink.ProtoReflect.Descriptor instead.
+func (*PageLink) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PageLink) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *PageLink) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *PageLink) GetRoute() string {
+	if x != nil {
+		return x.Route
+	}
+	return ""
+}
+
+func (x *PageLink) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *PageLink) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+type Progress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Progress) Reset() {
+	*x = Progress{}
+	mi := &file_widget_v1_widget_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Progress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Progress) ProtoMessage() {}
+
+func (x *Progress) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Progress.ProtoReflect.Descriptor instead.
+func (*Progress) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Progress) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Progress) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Progress) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type Radio struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         *int32                 `protobuf:"varint,1,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Options       []string               `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+	DefaultValue  *int32                 `protobuf:"varint,4,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
+	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
+	Disabled      bool                   `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Radio) Reset() {
+	*x = Radio{}
+	mi := &file_widget_v1_widget_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Radio) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Radio) ProtoMessage() {}
+
+func (x *Radio) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Radio.ProtoReflect.Descriptor instead.
+func (*Radio) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Radio) GetValue() int32 {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return 0
+}
+
+func (x *Radio) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Radio) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Radio) GetDefaultValue() int32 {
+	if x != nil && x.DefaultValue != nil {
+		return *x.DefaultValue
+	}
+	return 0
+}
+
+func (x *Radio) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *Radio) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+type RangeSlider struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Low           float64                `protobuf:"fixed64,1,opt,name=low,proto3" json:"low,omitempty"`
+	High          float64                `protobuf:"fixed64,2,opt,name=high,proto3" json:"high,omitempty"`
+	Label         string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	DefaultLow    float64                `protobuf:"fixed64,4,opt,name=default_low,json=defaultLow,proto3" json:"default_low,omitempty"`
+	DefaultHigh   float64                `protobuf:"fixed64,5,opt,name=default_high,json=defaultHigh,proto3" json:"default_high,omitempty"`
+	MinValue      float64                `protobuf:"fixed64,6,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
+	MaxValue      float64                `protobuf:"fixed64,7,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+	Step          float64                `protobuf:"fixed64,8,opt,name=step,proto3" json:"step,omitempty"`
+	Disabled      bool                   `protobuf:"varint,9,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RangeSlider) Reset() {
+	*x = RangeSlider{}
+	mi := &file_widget_v1_widget_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RangeSlider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RangeSlider) ProtoMessage() {}
+
+func (x *RangeSlider) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RangeSlider.ProtoReflect.Descriptor instead.
+func (*RangeSlider) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RangeSlider) GetLow() float64 {
+	if x != nil {
+		return x.Low
+	}
+	return 0
+}
+
+func (x *RangeSlider) GetHigh() float64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+func (x *RangeSlider) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *RangeSlider) GetDefaultLow() float64 {
+	if x != nil {
+		return x.DefaultLow
+	}
+	return 0
+}
+
+func (x *RangeSlider) GetDefaultHigh() float64 {
+	if x != nil {
+		return x.DefaultHigh
+	}
+	return 0
+}
+
+func (x *RangeSlider) GetMinValue() float64 {
+	if x != nil {
+		return x.MinValue
+	}
+	return 0
+}
+
+func (x *RangeSlider) GetMaxValue() float64 {
+	if x != nil {
+		return x.MaxValue
+	}
+	return 0
+}
+
+func (x *RangeSlider) GetStep() float64 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *RangeSlider) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+type Selectbox struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         *int32                 `protobuf:"varint,1,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Options       []string               `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+	Placeholder   string                 `protobuf:"bytes,4,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
+	DefaultValue  *int32                 `protobuf:"varint,5,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
+	Required      bool                   `protobuf:"varint,6,opt,name=required,proto3" json:"required,omitempty"`
+	Disabled      bool                   `protobuf:"varint,7,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Selectbox) Reset() {
+	*x = Selectbox{}
+	mi := &file_widget_v1_widget_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Selectbox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Selectbox) ProtoMessage() {}
+
+func (x *Selectbox) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Selectbox.ProtoReflect.Descriptor instead.
+func (*Selectbox) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Selectbox) GetValue() int32 {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return 0
+}
+
+func (x *Selectbox) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Selectbox) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Selectbox) GetPlaceholder() string {
+	if x != nil {
+		return x.Placeholder
+	}
+	return ""
+}
+
+func (x *Selectbox) GetDefaultValue() int32 {
+	if x != nil && x.DefaultValue != nil {
+		return *x.DefaultValue
+	}
+	return 0
+}
+
+func (x *Selectbox) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *Selectbox) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+type Slider struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	DefaultValue  float64                `protobuf:"fixed64,3,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	MinValue      float64                `protobuf:"fixed64,4,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
+	MaxValue      float64                `protobuf:"fixed64,5,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+	Step          float64                `protobuf:"fixed64,6,opt,name=step,proto3" json:"step,omitempty"`
+	Disabled      bool                   `protobuf:"varint,7,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Slider) Reset() {
+	*x = Slider{}
+	mi := &file_widget_v1_widget_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Slider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Slider) ProtoMessage() {}
+
+func (x *Slider) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Slider.ProtoReflect.Descriptor instead.
+func (*Slider) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Slider) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Slider) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Slider) GetDefaultValue() float64 {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return 0
+}
+
+func (x *Slider) GetMinValue() float64 {
+	if x != nil {
+		return x.MinValue
+	}
+	return 0
+}
+
+func (x *Slider) GetMaxValue() float64 {
+	if x != nil {
+		return x.MaxValue
+	}
+	return 0
+}
+
+func (x *Slider) GetStep() float64 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *Slider) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+type Spacer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Spacer) Reset() {
+	*x = Spacer{}
+	mi := &file_widget_v1_widget_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Spacer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Spacer) ProtoMessage() {}
+
+func (x *Spacer) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Spacer.ProtoReflect.Descriptor instead.
+func (*Spacer) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{35}
+}
+
+type Spinner struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Active        bool                   `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Spinner) Reset() {
+	*x = Spinner{}
+	mi := &file_widget_v1_widget_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Spinner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Spinner) ProtoMessage() {}
+
+func (x *Spinner) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Spinner.ProtoReflect.Descriptor instead.
+func (*Spinner) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Spinner) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Spinner) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type Subheader struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Subheader) Reset() {
+	*x = Subheader{}
+	mi := &file_widget_v1_widget_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subheader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subheader) ProtoMessage() {}
+
+func (x *Subheader) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subheader.ProtoReflect.Descriptor instead.
+func (*Subheader) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Subheader) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type TabItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TabItem) Reset() {
+	*x = TabItem{}
+	mi := &file_widget_v1_widget_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TabItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TabItem) ProtoMessage() {}
+
+func (x *TabItem) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TabItem.ProtoReflect.Descriptor instead.
+func (*TabItem) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TabItem) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type Table struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Data            []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Value           *TableValue            `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Header          string                 `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
+	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Height          *int32                 `protobuf:"varint,5,opt,name=height,proto3,oneof" json:"height,omitempty"`
+	ColumnOrder     []string               `protobuf:"bytes,6,rep,name=column_order,json=columnOrder,proto3" json:"column_order,omitempty"`
+	OnSelect        string                 `protobuf:"bytes,7,opt,name=on_select,json=onSelect,proto3" json:"on_select,omitempty"`
+	RowSelection    string                 `protobuf:"bytes,8,opt,name=row_selection,json=rowSelection,proto3" json:"row_selection,omitempty"`
+	Paginated       bool                   `protobuf:"varint,9,opt,name=paginated,proto3" json:"paginated,omitempty"`
+	TotalRows       int64                  `protobuf:"varint,10,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	EditableColumns []string               `protobuf:"bytes,11,rep,name=editable_columns,json=editableColumns,proto3" json:"editable_columns,omitempty"`
+	RowActions      []string               `protobuf:"bytes,12,rep,name=row_actions,json=rowActions,proto3" json:"row_actions,omitempty"`
+	Columns         []*TableColumn         `protobuf:"bytes,13,rep,name=columns,proto3" json:"columns,omitempty"`
+	Error           string                 `protobuf:"bytes,14,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Table) Reset() {
+	*x = Table{}
+	mi := &file_widget_v1_widget_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Table) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Table) ProtoMessage() {}
+
+func (x *Table) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Table.ProtoReflect.Descriptor instead.
+func (*Table) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Table) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Table) GetValue() *TableValue {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *Table) GetHeader() string {
+	if x != nil {
+		return x.Header
+	}
+	return ""
+}
+
+func (x *Table) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Table) GetHeight() int32 {
+	if x != nil && x.Height != nil {
+		return *x.Height
+	}
+	return 0
+}
+
+func (x *Table) GetColumnOrder() []string {
+	if x != nil {
+		return x.ColumnOrder
+	}
+	return nil
+}
+
+func (x *Table) GetOnSelect() string {
+	if x != nil {
+		return x.OnSelect
+	}
+	return ""
+}
+
+func (x *Table) GetRowSelection() string {
+	if x != nil {
+		return x.RowSelection
+	}
+	return ""
+}
+
+func (x *Table) GetPaginated() bool {
+	if x != nil {
+		return x.Paginated
+	}
+	return false
+}
+
+func (x *Table) GetTotalRows() int64 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *Table) GetEditableColumns() []string {
+	if x != nil {
+		return x.EditableColumns
+	}
+	return nil
+}
+
+func (x *Table) GetRowActions() []string {
+	if x != nil {
+		return x.RowActions
+	}
+	return nil
+}
+
+func (x *Table) GetColumns() []*TableColumn {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *Table) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type TableColumn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Format        *TableColumnFormat     `protobuf:"bytes,3,opt,name=format,proto3,oneof" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableColumn) Reset() {
+	*x = TableColumn{}
+	mi := &file_widget_v1_widget_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableColumn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableColumn) ProtoMessage() {}
+
+func (x *TableColumn) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableColumn.ProtoReflect.Descriptor instead.
+func (*TableColumn) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TableColumn) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TableColumn) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *TableColumn) GetFormat() *TableColumnFormat {
+	if x != nil {
+		return x.Format
+	}
+	return nil
+}
+
+type TableColumnFormat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Decimals      *int32                 `protobuf:"varint,2,opt,name=decimals,proto3,oneof" json:"decimals,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	DateFormat    string                 `protobuf:"bytes,4,opt,name=date_format,json=dateFormat,proto3" json:"date_format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableColumnFormat) Reset() {
+	*x = TableColumnFormat{}
+	mi := &file_widget_v1_widget_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableColumnFormat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableColumnFormat) ProtoMessage() {}
+
+func (x *TableColumnFormat) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableColumnFormat.ProtoReflect.Descriptor instead.
+func (*TableColumnFormat) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *TableColumnFormat) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TableColumnFormat) GetDecimals() int32 {
+	if x != nil && x.Decimals != nil {
+		return *x.Decimals
+	}
+	return 0
+}
+
+func (x *TableColumnFormat) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TableColumnFormat) GetDateFormat() string {
+	if x != nil {
+		return x.DateFormat
+	}
+	return ""
+}
+
+type TableValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Selection     *TableValueSelection   `protobuf:"bytes,1,opt,name=selection,proto3,oneof" json:"selection,omitempty"`
+	Pagination    *TableValuePagination  `protobuf:"bytes,2,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+	Sort          *TableValueSort        `protobuf:"bytes,3,opt,name=sort,proto3,oneof" json:"sort,omitempty"`
+	Filters       map[string]string      `protobuf:"bytes,4,rep,name=filters,proto3" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Edits         []*TableValueEdit      `protobuf:"bytes,5,rep,name=edits,proto3" json:"edits,omitempty"`
+	Action        *TableValueAction      `protobuf:"bytes,6,opt,name=action,proto3,oneof" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableValue) Reset() {
+	*x = TableValue{}
+	mi := &file_widget_v1_widget_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableValue) ProtoMessage() {}
+
+func (x *TableValue) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableValue.ProtoReflect.Descriptor instead.
+func (*TableValue) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *TableValue) GetSelection() *TableValueSelection {
+	if x != nil {
+		return x.Selection
+	}
+	return nil
+}
+
+func (x *TableValue) GetPagination() *TableValuePagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *TableValue) GetSort() *TableValueSort {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *TableValue) GetFilters() map[string]string {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *TableValue) GetEdits() []*TableValueEdit {
+	if x != nil {
+		return x.Edits
+	}
+	return nil
+}
+
+func (x *TableValue) GetAction() *TableValueAction {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+type TableValueAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableValueAction) Reset() {
+	*x = TableValueAction{}
+	mi := &file_widget_v1_widget_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableValueAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableValueAction) ProtoMessage() {}
+
+func (x *TableValueAction) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableValueAction.ProtoReflect.Descriptor instead.
+func (*TableValueAction) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *TableValueAction) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *TableValueAction) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type TableValueEdit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Column        string                 `protobuf:"bytes,2,opt,name=column,proto3" json:"column,omitempty"`
+	OldValue      string                 `protobuf:"bytes,3,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue      string                 `protobuf:"bytes,4,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableValueEdit) Reset() {
+	*x = TableValueEdit{}
+	mi := &file_widget_v1_widget_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableValueEdit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableValueEdit) ProtoMessage() {}
+
+func (x *TableValueEdit) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableValueEdit.ProtoReflect.Descriptor instead.
+func (*TableValueEdit) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *TableValueEdit) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *TableValueEdit) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *TableValueEdit) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *TableValueEdit) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+type TableValuePagination struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableValuePagination) Reset() {
+	*x = TableValuePagination{}
+	mi := &file_widget_v1_widget_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableValuePagination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableValuePagination) ProtoMessage() {}
+
+func (x *TableValuePagination) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableValuePagination.ProtoReflect.Descriptor instead.
+func (*TableValuePagination) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *TableValuePagination) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *TableValuePagination) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type TableValueSelection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Rows          []int32                `protobuf:"varint,2,rep,packed,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableValueSelection) Reset() {
+	*x = TableValueSelection{}
+	mi := &file_widget_v1_widget_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableValueSelection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableValueSelection) ProtoMessage() {}
+
+func (x *TableValueSelection) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableValueSelection.ProtoReflect.Descriptor instead.
+func (*TableValueSelection) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *TableValueSelection) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *TableValueSelection) GetRows() []int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type TableValueSort struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Column        string                 `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
+	Direction     string                 `protobuf:"bytes,2,opt,name=direction,proto3" json:"direction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableValueSort) Reset() {
+	*x = TableValueSort{}
+	mi := &file_widget_v1_widget_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableValueSort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableValueSort) ProtoMessage() {}
+
+func (x *TableValueSort) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableValueSort.ProtoReflect.Descriptor instead.
+func (*TableValueSort) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *TableValueSort) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *TableValueSort) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+type Tabs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         int32                  `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Tabs          int32                  `protobuf:"varint,2,opt,name=tabs,proto3" json:"tabs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tabs) Reset() {
+	*x = Tabs{}
+	mi := &file_widget_v1_widget_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tabs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tabs) ProtoMessage() {}
+
+func (x *Tabs) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tabs.ProtoReflect.Descriptor instead.
+func (*Tabs) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Tabs) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Tabs) GetTabs() int32 {
+	if x != nil {
+		return x.Tabs
+	}
+	return 0
+}
+
+type TagInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         []string               `protobuf:"bytes,1,rep,name=value,proto3" json:"value,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Placeholder   string                 `protobuf:"bytes,3,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
+	DefaultValue  []string               `protobuf:"bytes,4,rep,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
+	Disabled      bool                   `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Suggestions   []string               `protobuf:"bytes,7,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	MaxTags       *int32                 `protobuf:"varint,8,opt,name=max_tags,json=maxTags,proto3,oneof" json:"max_tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagInput) Reset() {
+	*x = TagInput{}
+	mi := &file_widget_v1_widget_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagInput) ProtoMessage() {}
+
+func (x *TagInput) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagInput.ProtoReflect.Descriptor instead.
+func (*TagInput) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *TagInput) GetValue() []string {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *TagInput) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *TagInput) GetPlaceholder() string {
+	if x != nil {
+		return x.Placeholder
+	}
+	return ""
+}
+
+func (x *TagInput) GetDefaultValue() []string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return nil
+}
+
+func (x *TagInput) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *TagInput) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *TagInput) GetSuggestions() []string {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+func (x *TagInput) GetMaxTags() int32 {
+	if x != nil && x.MaxTags != nil {
+		return *x.MaxTags
+	}
+	return 0
+}
+
+type TextArea struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         *string                `protobuf:"bytes,1,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Placeholder   string                 `protobuf:"bytes,3,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
+	DefaultValue  *string                `protobuf:"bytes,4,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
+	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
+	Disabled      bool                   `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	MaxLength     *int32                 `protobuf:"varint,7,opt,name=max_length,json=maxLength,proto3,oneof" json:"max_length,omitempty"`
+	MinLength     *int32                 `protobuf:"varint,8,opt,name=min_length,json=minLength,proto3,oneof" json:"min_length,omitempty"`
+	MaxLines      *int32                 `protobuf:"varint,9,opt,name=max_lines,json=maxLines,proto3,oneof" json:"max_lines,omitempty"`
+	MinLines      *int32                 `protobuf:"varint,10,opt,name=min_lines,json=minLines,proto3,oneof" json:"min_lines,omitempty"`
+	AutoResize    bool                   `protobuf:"varint,11,opt,name=auto_resize,json=autoResize,proto3" json:"auto_resize,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TextArea) Reset() {
+	*x = TextArea{}
+	mi := &file_widget_v1_widget_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TextArea) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextArea) ProtoMessage() {}
+
+func (x *TextArea) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextArea.ProtoReflect.Descriptor instead.
+func (*TextArea) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *TextArea) GetValue() string {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return ""
+}
+
+func (x *TextArea) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *TextArea) GetPlaceholder() string {
+	if x != nil {
+		return x.Placeholder
+	}
+	return ""
+}
+
+func (x *TextArea) GetDefaultValue() string {
+	if x != nil && x.DefaultValue != nil {
+		return *x.DefaultValue
+	}
+	return ""
+}
+
+func (x *TextArea) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *TextArea) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *TextArea) GetMaxLength() int32 {
+	if x != nil && x.MaxLength != nil {
+		return *x.MaxLength
+	}
+	return 0
+}
+
+func (x *TextArea) GetMinLength() int32 {
+	if x != nil && x.MinLength != nil {
+		return *x.MinLength
+	}
+	return 0
+}
+
+func (x *TextArea) GetMaxLines() int32 {
+	if x != nil && x.MaxLines != nil {
+		return *x.MaxLines
+	}
+	return 0
+}
+
+func (x *TextArea) GetMinLines() int32 {
+	if x != nil && x.MinLines != nil {
+		return *x.MinLines
+	}
+	return 0
+}
+
+func (x *TextArea) GetAutoResize() bool {
+	if x != nil {
+		return x.AutoResize
+	}
+	return false
+}
+
+type TextInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         *string                `protobuf:"bytes,1,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Placeholder   string                 `protobuf:"bytes,3,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
+	DefaultValue  *string                `protobuf:"bytes,4,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
+	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
+	Disabled      bool                   `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	MaxLength     *int32                 `protobuf:"varint,7,opt,name=max_length,json=maxLength,proto3,oneof" json:"max_length,omitempty"`
+	MinLength     *int32                 `protobuf:"varint,8,opt,name=min_length,json=minLength,proto3,oneof" json:"min_length,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TextInput) Reset() {
+	*x = TextInput{}
+	mi := &file_widget_v1_widget_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TextInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextInput) ProtoMessage() {}
+
+func (x *TextInput) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextInput.ProtoReflect.Descriptor instead.
+func (*TextInput) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *TextInput) GetValue() string {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return ""
+}
+
+func (x *TextInput) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *TextInput) GetPlaceholder() string {
+	if x != nil {
+		return x.Placeholder
+	}
+	return ""
+}
+
+func (x *TextInput) GetDefaultValue() string {
+	if x != nil && x.DefaultValue != nil {
+		return *x.DefaultValue
+	}
+	return ""
+}
+
+func (x *TextInput) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *TextInput) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *TextInput) GetMaxLength() int32 {
+	if x != nil && x.MaxLength != nil {
+		return *x.MaxLength
+	}
+	return 0
+}
+
+func (x *TextInput) GetMinLength() int32 {
+	if x != nil && x.MinLength != nil {
+		return *x.MinLength
+	}
+	return 0
+}
+
+type TimeInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         *string                `protobuf:"bytes,1,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Placeholder   string                 `protobuf:"bytes,3,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
+	DefaultValue  *string                `protobuf:"bytes,4,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
+	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
+	Disabled      bool                   `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeInput) Reset() {
+	*x = TimeInput{}
+	mi := &file_widget_v1_widget_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeInput) ProtoMessage() {}
+
+func (x *TimeInput) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeInput.ProtoReflect.Descriptor instead.
+func (*TimeInput) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *TimeInput) GetValue() string {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return ""
+}
+
+func (x *TimeInput) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *TimeInput) GetPlaceholder() string {
+	if x != nil {
+		return x.Placeholder
+	}
+	return ""
+}
+
+func (x *TimeInput) GetDefaultValue() string {
+	if x != nil && x.DefaultValue != nil {
+		return *x.DefaultValue
+	}
+	return ""
+}
+
+func (x *TimeInput) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *TimeInput) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+type Toggle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         bool                   `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	DefaultValue  bool                   `protobuf:"varint,3,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	Disabled      bool                   `protobuf:"varint,4,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	RerunOnChange bool                   `protobuf:"varint,5,opt,name=rerun_on_change,json=rerunOnChange,proto3" json:"rerun_on_change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Toggle) Reset() {
+	*x = Toggle{}
+	mi := &file_widget_v1_widget_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Toggle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Toggle) ProtoMessage() {}
+
+func (x *Toggle) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Toggle.ProtoReflect.Descriptor instead.
+func (*Toggle) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Toggle) GetValue() bool {
+	if x != nil {
+		return x.Value
+	}
+	return false
+}
+
+func (x *Toggle) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Toggle) GetDefaultValue() bool {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return false
+}
+
+func (x *Toggle) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *Toggle) GetRerunOnChange() bool {
+	if x != nil {
+		return x.RerunOnChange
+	}
+	return false
+}
+
+type Widget struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Types that are valid to be assigned to Type:
+	//
+	//	*Widget_Button
+	//	*Widget_Checkbox
+	//	*Widget_CheckboxGroup
+	//	*Widget_ColumnItem
+	//	*Widget_Columns
+	//	*Widget_DateInput
+	//	*Widget_DateTimeInput
+	//	*Widget_Form
+	//	*Widget_Markdown
+	//	*Widget_MultiSelect
+	//	*Widget_NumberInput
+	//	*Widget_Radio
+	//	*Widget_Selectbox
+	//	*Widget_Table
+	//	*Widget_TextArea
+	//	*Widget_TextInput
+	//	*Widget_TimeInput
+	//	*Widget_FileInput
+	//	*Widget_DownloadButton
+	//	*Widget_Chart
+	//	*Widget_Tabs
+	//	*Widget_TabItem
+	//	*Widget_Expander
+	//	*Widget_Dialog
+	//	*Widget_Alert
+	//	*Widget_Metric
+	//	*Widget_Progress
+	//	*Widget_Spinner
+	//	*Widget_Slider
+	//	*Widget_RangeSlider
+	//	*Widget_Toggle
+	//	*Widget_DateRangeInput
+	//	*Widget_Json
+	//	*Widget_CodeEditor
+	//	*Widget_Image
+	//	*Widget_Link
+	//	*Widget_PageLink
+	//	*Widget_Header
+	//	*Widget_Subheader
+	//	*Widget_Caption
+	//	*Widget_Divider
+	//	*Widget_Spacer
+	//	*Widget_TagInput
+	//	*Widget_ColorInput
+	Type          isWidget_Type `protobuf_oneof:"type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Widget) Reset() {
+	*x = Widget{}
+	mi := &file_widget_v1_widget_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Widget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Widget) ProtoMessage() {}
+
+func (x *Widget) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Widget.ProtoReflect.Descriptor instead.
+func (*Widget) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *Widget) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Widget) GetType() isWidget_Type {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *Widget) GetButton() *Button {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_Button); ok {
+			return x.Button
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetCheckbox() *Checkbox {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_Checkbox); ok {
+			return x.Checkbox
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetCheckboxGroup() *CheckboxGroup {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_CheckboxGroup); ok {
+			return x.CheckboxGroup
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetColumnItem() *ColumnItem {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_ColumnItem); ok {
+			return x.ColumnItem
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetColumns() *Columns {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_Columns); ok {
+			return x.Columns
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetDateInput() *DateInput {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_DateInput); ok {
+			return x.DateInput
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetDateTimeInput() *DateTimeInput {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_DateTimeInput); ok {
+			return x.DateTimeInput
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetForm() *Form {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_Form); ok {
+			return x.Form
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetMarkdown() *Markdown {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_Markdown); ok {
+			return x.Markdown
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetMultiSelect() *MultiSelect {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_MultiSelect); ok {
+			return x.MultiSelect
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetNumberInput() *NumberInput {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_NumberInput); ok {
+			return x.NumberInput
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetRadio() *Radio {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_Radio); ok {
+			return x.Radio
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetSelectbox() *Selectbox {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_Selectbox); ok {
+			return x.Selectbox
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetTable() *Table {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_Table); ok {
+			return x.Table
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetTextArea() *TextArea {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_TextArea); ok {
+			return x.TextArea
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetTextInput() *TextInput {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_TextInput); ok {
+			return x.TextInput
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetTimeInput() *TimeInput {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_TimeInput); ok {
+			return x.TimeInput
+		}
+	}
+	return nil
 }
 
-func (x *TimeInput) GetLabel() string {
+func (x *Widget) GetFileInput() *FileInput {
 	if x != nil {
-		return x.Label
+		if x, ok := x.Type.(*Widget_FileInput); ok {
+			return x.FileInput
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *TimeInput) GetPlaceholder() string {
+func (x *Widget) GetDownloadButton() *DownloadButton {
 	if x != nil {
-		return x.Placeholder
+		if x, ok := x.Type.(*Widget_DownloadButton); ok {
+			return x.DownloadButton
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *TimeInput) GetDefaultValue() string {
-	if x != nil && x.DefaultValue != nil {
-		return *x.DefaultValue
+func (x *Widget) GetChart() *Chart {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_Chart); ok {
+			return x.Chart
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *TimeInput) GetRequired() bool {
+func (x *Widget) GetTabs() *Tabs {
 	if x != nil {
-		return x.Required
+		if x, ok := x.Type.(*Widget_Tabs); ok {
+			return x.Tabs
+		}
 	}
-	return false
+	return nil
 }
 
-func (x *TimeInput) GetDisabled() bool {
+func (x *Widget) GetTabItem() *TabItem {
 	if x != nil {
-		return x.Disabled
+		if x, ok := x.Type.(*Widget_TabItem); ok {
+			return x.TabItem
+		}
 	}
-	return false
-}
-
-type Widget struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Types that are valid to be assigned to Type:
-	//
-	//	*Widget_Button
-	//	*Widget_Checkbox
-	//	*Widget_CheckboxGroup
-	//	*Widget_ColumnItem
-	//	*Widget_Columns
-	//	*Widget_DateInput
-	//	*Widget_DateTimeInput
-	//	*Widget_Form
-	//	*Widget_Markdown
-	//	*Widget_MultiSelect
-	//	*Widget_NumberInput
-	//	*Widget_Radio
-	//	*Widget_Selectbox
-	//	*Widget_Table
-	//	*Widget_TextArea
-	//	*Widget_TextInput
-	//	*Widget_TimeInput
-	Type          isWidget_Type `protobuf_oneof:"type"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *Widget) Reset() {
-	*x = Widget{}
-	mi := &file_widget_v1_widget_proto_msgTypes[19]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return nil
 }
 
-func (x *Widget) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Widget) GetExpander() *Expander {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_Expander); ok {
+			return x.Expander
+		}
+	}
+	return nil
 }
 
-func (*Widget) ProtoMessage() {}
-
-func (x *Widget) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[19]
+func (x *Widget) GetDialog() *Dialog {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		if x, ok := x.Type.(*Widget_Dialog); ok {
+			return x.Dialog
 		}
-		return ms
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use Widget.ProtoReflect.Descriptor instead.
-func (*Widget) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{19}
+func (x *Widget) GetAlert() *Alert {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_Alert); ok {
+			return x.Alert
+		}
+	}
+	return nil
 }
 
-func (x *Widget) GetId() string {
+func (x *Widget) GetMetric() *Metric {
 	if x != nil {
-		return x.Id
+		if x, ok := x.Type.(*Widget_Metric); ok {
+			return x.Metric
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *Widget) GetType() isWidget_Type {
+func (x *Widget) GetProgress() *Progress {
 	if x != nil {
-		return x.Type
+		if x, ok := x.Type.(*Widget_Progress); ok {
+			return x.Progress
+		}
 	}
 	return nil
 }
 
-func (x *Widget) GetButton() *Button {
+func (x *Widget) GetSpinner() *Spinner {
 	if x != nil {
-		if x, ok := x.Type.(*Widget_Button); ok {
-			return x.Button
+		if x, ok := x.Type.(*Widget_Spinner); ok {
+			return x.Spinner
 		}
 	}
 	return nil
 }
 
-func (x *Widget) GetCheckbox() *Checkbox {
+func (x *Widget) GetSlider() *Slider {
 	if x != nil {
-		if x, ok := x.Type.(*Widget_Checkbox); ok {
-			return x.Checkbox
+		if x, ok := x.Type.(*Widget_Slider); ok {
+			return x.Slider
 		}
 	}
 	return nil
 }
 
-func (x *Widget) GetCheckboxGroup() *CheckboxGroup {
+func (x *Widget) GetRangeSlider() *RangeSlider {
 	if x != nil {
-		if x, ok := x.Type.(*Widget_CheckboxGroup); ok {
-			return x.CheckboxGroup
+		if x, ok := x.Type.(*Widget_RangeSlider); ok {
+			return x.RangeSlider
 		}
 	}
 	return nil
 }
 
-func (x *Widget) GetColumnItem() *ColumnItem {
+func (x *Widget) GetToggle() *Toggle {
 	if x != nil {
-		if x, ok := x.Type.(*Widget_ColumnItem); ok {
-			return x.ColumnItem
+		if x, ok := x.Type.(*Widget_Toggle); ok {
+			return x.Toggle
 		}
 	}
 	return nil
 }
 
-func (x *Widget) GetColumns() *Columns {
+func (x *Widget) GetDateRangeInput() *DateRangeInput {
 	if x != nil {
-		if x, ok := x.Type.(*Widget_Columns); ok {
-			return x.Columns
+		if x, ok := x.Type.(*Widget_DateRangeInput); ok {
+			return x.DateRangeInput
 		}
 	}
 	return nil
 }
 
-func (x *Widget) GetDateInput() *DateInput {
+func (x *Widget) GetJson() *Json {
 	if x != nil {
-		if x, ok := x.Type.(*Widget_DateInput); ok {
-			return x.DateInput
+		if x, ok := x.Type.(*Widget_Json); ok {
+			return x.Json
 		}
 	}
 	return nil
 }
 
-func (x *Widget) GetDateTimeInput() *DateTimeInput {
+func (x *Widget) GetCodeEditor() *CodeEditor {
 	if x != nil {
-		if x, ok := x.Type.(*Widget_DateTimeInput); ok {
-			return x.DateTimeInput
+		if x, ok := x.Type.(*Widget_CodeEditor); ok {
+			return x.CodeEditor
 		}
 	}
 	return nil
 }
 
-func (x *Widget) GetForm() *Form {
+func (x *Widget) GetImage() *Image {
 	if x != nil {
-		if x, ok := x.Type.(*Widget_Form); ok {
-			return x.Form
+		if x, ok := x.Type.(*Widget_Image); ok {
+			return x.Image
 		}
 	}
 	return nil
 }
 
-func (x *Widget) GetMarkdown() *Markdown {
+func (x *Widget) GetLink() *Link {
 	if x != nil {
-		if x, ok := x.Type.(*Widget_Markdown); ok {
-			return x.Markdown
+		if x, ok := x.Type.(*Widget_Link); ok {
+			return x.Link
 		}
 	}
 	return nil
 }
 
-func (x *Widget) GetMultiSelect() *MultiSelect {
+func (x *Widget) GetPageLink() *PageLink {
 	if x != nil {
-		if x, ok := x.Type.(*Widget_MultiSelect); ok {
-			return x.MultiSelect
+		if x, ok := x.Type.(*Widget_PageLink); ok {
+			return x.PageLink
 		}
 	}
 	return nil
 }
 
-func (x *Widget) GetNumberInput() *NumberInput {
+func (x *Widget) GetHeader() *Header {
 	if x != nil {
-		if x, ok := x.Type.(*Widget_NumberInput); ok {
-			return x.NumberInput
+		if x, ok := x.Type.(*Widget_Header); ok {
+			return x.Header
 		}
 	}
 	return nil
 }
 
-func (x *Widget) GetRadio() *Radio {
+func (x *Widget) GetSubheader() *Subheader {
 	if x != nil {
-		if x, ok := x.Type.(*Widget_Radio); ok {
-			return x.Radio
+		if x, ok := x.Type.(*Widget_Subheader); ok {
+			return x.Subheader
 		}
 	}
 	return nil
 }
 
-func (x *Widget) GetSelectbox() *Selectbox {
+func (x *Widget) GetCaption() *Caption {
 	if x != nil {
-		if x, ok := x.Type.(*Widget_Selectbox); ok {
-			return x.Selectbox
+		if x, ok := x.Type.(*Widget_Caption); ok {
+			return x.Caption
 		}
 	}
 	return nil
 }
 
-func (x *Widget) GetTable() *Table {
+func (x *Widget) GetDivider() *Divider {
 	if x != nil {
-		if x, ok := x.Type.(*Widget_Table); ok {
-			return x.Table
+		if x, ok := x.Type.(*Widget_Divider); ok {
+			return x.Divider
 		}
 	}
 	return nil
 }
 
-func (x *Widget) GetTextArea() *TextArea {
+func (x *Widget) GetSpacer() *Spacer {
 	if x != nil {
-		if x, ok := x.Type.(*Widget_TextArea); ok {
-			return x.TextArea
+		if x, ok := x.Type.(*Widget_Spacer); ok {
+			return x.Spacer
 		}
 	}
 	return nil
 }
 
-func (x *Widget) GetTextInput() *TextInput {
+func (x *Widget) GetTagInput() *TagInput {
 	if x != nil {
-		if x, ok := x.Type.(*Widget_TextInput); ok {
-			return x.TextInput
+		if x, ok := x.Type.(*Widget_TagInput); ok {
+			return x.TagInput
 		}
 	}
 	return nil
 }
 
-func (x *Widget) GetTimeInput() *TimeInput {
+func (x *Widget) GetColorInput() *ColorInput {
 	if x != nil {
-		if x, ok := x.Type.(*Widget_TimeInput); ok {
-			return x.TimeInput
+		if x, ok := x.Type.(*Widget_ColorInput); ok {
+			return x.ColorInput
 		}
 	}
 	return nil
@@ -1825,6 +4563,114 @@ type Widget_TimeInput struct {
 	TimeInput *TimeInput `protobuf:"bytes,18,opt,name=time_input,json=timeInput,proto3,oneof"`
 }
 
+type Widget_FileInput struct {
+	FileInput *FileInput `protobuf:"bytes,19,opt,name=file_input,json=fileInput,proto3,oneof"`
+}
+
+type Widget_DownloadButton struct {
+	DownloadButton *DownloadButton `protobuf:"bytes,20,opt,name=download_button,json=downloadButton,proto3,oneof"`
+}
+
+type Widget_Chart struct {
+	Chart *Chart `protobuf:"bytes,21,opt,name=chart,proto3,oneof"`
+}
+
+type Widget_Tabs struct {
+	Tabs *Tabs `protobuf:"bytes,22,opt,name=tabs,proto3,oneof"`
+}
+
+type Widget_TabItem struct {
+	TabItem *TabItem `protobuf:"bytes,23,opt,name=tab_item,json=tabItem,proto3,oneof"`
+}
+
+type Widget_Expander struct {
+	Expander *Expander `protobuf:"bytes,24,opt,name=expander,proto3,oneof"`
+}
+
+type Widget_Dialog struct {
+	Dialog *Dialog `protobuf:"bytes,25,opt,name=dialog,proto3,oneof"`
+}
+
+type Widget_Alert struct {
+	Alert *Alert `protobuf:"bytes,26,opt,name=alert,proto3,oneof"`
+}
+
+type Widget_Metric struct {
+	Metric *Metric `protobuf:"bytes,27,opt,name=metric,proto3,oneof"`
+}
+
+type Widget_Progress struct {
+	Progress *Progress `protobuf:"bytes,28,opt,name=progress,proto3,oneof"`
+}
+
+type Widget_Spinner struct {
+	Spinner *Spinner `protobuf:"bytes,29,opt,name=spinner,proto3,oneof"`
+}
+
+type Widget_Slider struct {
+	Slider *Slider `protobuf:"bytes,30,opt,name=slider,proto3,oneof"`
+}
+
+type Widget_RangeSlider struct {
+	RangeSlider *RangeSlider `protobuf:"bytes,31,opt,name=range_slider,json=rangeSlider,proto3,oneof"`
+}
+
+type Widget_Toggle struct {
+	Toggle *Toggle `protobuf:"bytes,32,opt,name=toggle,proto3,oneof"`
+}
+
+type Widget_DateRangeInput struct {
+	DateRangeInput *DateRangeInput `protobuf:"bytes,33,opt,name=date_range_input,json=dateRangeInput,proto3,oneof"`
+}
+
+type Widget_Json struct {
+	Json *Json `protobuf:"bytes,34,opt,name=json,proto3,oneof"`
+}
+
+type Widget_CodeEditor struct {
+	CodeEditor *CodeEditor `protobuf:"bytes,35,opt,name=code_editor,json=codeEditor,proto3,oneof"`
+}
+
+type Widget_Image struct {
+	Image *Image `protobuf:"bytes,36,opt,name=image,proto3,oneof"`
+}
+
+type Widget_Link struct {
+	Link *Link `protobuf:"bytes,37,opt,name=link,proto3,oneof"`
+}
+
+type Widget_PageLink struct {
+	PageLink *PageLink `protobuf:"bytes,38,opt,name=page_link,json=pageLink,proto3,oneof"`
+}
+
+type Widget_Header struct {
+	Header *Header `protobuf:"bytes,39,opt,name=header,proto3,oneof"`
+}
+
+type Widget_Subheader struct {
+	Subheader *Subheader `protobuf:"bytes,40,opt,name=subheader,proto3,oneof"`
+}
+
+type Widget_Caption struct {
+	Caption *Caption `protobuf:"bytes,41,opt,name=caption,proto3,oneof"`
+}
+
+type Widget_Divider struct {
+	Divider *Divider `protobuf:"bytes,42,opt,name=divider,proto3,oneof"`
+}
+
+type Widget_Spacer struct {
+	Spacer *Spacer `protobuf:"bytes,43,opt,name=spacer,proto3,oneof"`
+}
+
+type Widget_TagInput struct {
+	TagInput *TagInput `protobuf:"bytes,44,opt,name=tag_input,json=tagInput,proto3,oneof"`
+}
+
+type Widget_ColorInput struct {
+	ColorInput *ColorInput `protobuf:"bytes,45,opt,name=color_input,json=colorInput,proto3,oneof"`
+}
+
 func (*Widget_Button) isWidget_Type() {}
 
 func (*Widget_Checkbox) isWidget_Type() {}
@@ -1859,15 +4705,85 @@ func (*Widget_TextInput) isWidget_Type() {}
 
 func (*Widget_TimeInput) isWidget_Type() {}
 
+func (*Widget_FileInput) isWidget_Type() {}
+
+func (*Widget_DownloadButton) isWidget_Type() {}
+
+func (*Widget_Chart) isWidget_Type() {}
+
+func (*Widget_Tabs) isWidget_Type() {}
+
+func (*Widget_TabItem) isWidget_Type() {}
+
+func (*Widget_Expander) isWidget_Type() {}
+
+func (*Widget_Dialog) isWidget_Type() {}
+
+func (*Widget_Alert) isWidget_Type() {}
+
+func (*Widget_Metric) isWidget_Type() {}
+
+func (*Widget_Progress) isWidget_Type() {}
+
+func (*Widget_Spinner) isWidget_Type() {}
+
+func (*Widget_Slider) isWidget_Type() {}
+
+func (*Widget_RangeSlider) isWidget_Type() {}
+
+func (*Widget_Toggle) isWidget_Type() {}
+
+func (*Widget_DateRangeInput) isWidget_Type() {}
+
+func (*Widget_Json) isWidget_Type() {}
+
+func (*Widget_CodeEditor) isWidget_Type() {}
+
+func (*Widget_Image) isWidget_Type() {}
+
+func (*Widget_Link) isWidget_Type() {}
+
+func (*Widget_PageLink) isWidget_Type() {}
+
+func (*Widget_Header) isWidget_Type() {}
+
+func (*Widget_Subheader) isWidget_Type() {}
+
+func (*Widget_Caption) isWidget_Type() {}
+
+func (*Widget_Divider) isWidget_Type() {}
+
+func (*Widget_Spacer) isWidget_Type() {}
+
+func (*Widget_TagInput) isWidget_Type() {}
+
+func (*Widget_ColorInput) isWidget_Type() {}
+
 var File_widget_v1_widget_proto protoreflect.FileDescriptor
 
 const file_widget_v1_widget_proto_rawDesc = "" +
 	"\n" +
-	"\x16widget/v1/widget.proto\x12\twidget.v1\"P\n" +
+	"\x16widget/v1/widget.proto\x12\twidget.v1\"1\n" +
+	"\x05Alert\x12\x14\n" +
+	"\x05level\x18\x01 \x01(\tR\x05level\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\"P\n" +
 	"\x06Button\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\bR\x05value\x12\x14\n" +
 	"\x05label\x18\x02 \x01(\tR\x05label\x12\x1a\n" +
-	"\bdisabled\x18\x03 \x01(\bR\bdisabled\"\x93\x01\n" +
+	"\bdisabled\x18\x03 \x01(\bR\bdisabled\"\x1d\n" +
+	"\aCaption\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\"\xf3\x01\n" +
+	"\x05Chart\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x17\n" +
+	"\ax_field\x18\x05 \x01(\tR\x06xField\x12\x19\n" +
+	"\by_fields\x18\x06 \x03(\tR\ayFields\x12\x1b\n" +
+	"\x06height\x18\a \x01(\x05H\x00R\x06height\x88\x01\x01\x12\x18\n" +
+	"\astacked\x18\b \x01(\bR\astacked\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05errorB\t\n" +
+	"\a_height\"\x93\x01\n" +
 	"\bCheckbox\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\bR\x05value\x12\x14\n" +
 	"\x05label\x18\x02 \x01(\tR\x05label\x12#\n" +
@@ -1880,7 +4796,34 @@ const file_widget_v1_widget_proto_rawDesc = "" +
 	"\aoptions\x18\x03 \x03(\tR\aoptions\x12#\n" +
 	"\rdefault_value\x18\x04 \x03(\x05R\fdefaultValue\x12\x1a\n" +
 	"\brequired\x18\x05 \x01(\bR\brequired\x12\x1a\n" +
-	"\bdisabled\x18\x06 \x01(\bR\bdisabled\"$\n" +
+	"\bdisabled\x18\x06 \x01(\bR\bdisabled\"\xec\x02\n" +
+	"\n" +
+	"CodeEditor\x12\x19\n" +
+	"\x05value\x18\x01 \x01(\tH\x00R\x05value\x88\x01\x01\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12 \n" +
+	"\vplaceholder\x18\x03 \x01(\tR\vplaceholder\x12(\n" +
+	"\rdefault_value\x18\x04 \x01(\tH\x01R\fdefaultValue\x88\x01\x01\x12\x1a\n" +
+	"\brequired\x18\x05 \x01(\bR\brequired\x12\x1a\n" +
+	"\bdisabled\x18\x06 \x01(\bR\bdisabled\x12\x1a\n" +
+	"\blanguage\x18\a \x01(\tR\blanguage\x12!\n" +
+	"\fline_numbers\x18\b \x01(\bR\vlineNumbers\x12\x1b\n" +
+	"\tread_only\x18\t \x01(\bR\breadOnly\x12\"\n" +
+	"\n" +
+	"max_height\x18\n" +
+	" \x01(\x05H\x02R\tmaxHeight\x88\x01\x01B\b\n" +
+	"\x06_valueB\x10\n" +
+	"\x0e_default_valueB\r\n" +
+	"\v_max_height\"\xd7\x01\n" +
+	"\n" +
+	"ColorInput\x12\x19\n" +
+	"\x05value\x18\x01 \x01(\tH\x00R\x05value\x88\x01\x01\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12(\n" +
+	"\rdefault_value\x18\x03 \x01(\tH\x01R\fdefaultValue\x88\x01\x01\x12\x1a\n" +
+	"\brequired\x18\x04 \x01(\bR\brequired\x12\x1a\n" +
+	"\bdisabled\x18\x05 \x01(\bR\bdisabled\x12\x1a\n" +
+	"\bswatches\x18\x06 \x03(\tR\bswatchesB\b\n" +
+	"\x06_valueB\x10\n" +
+	"\x0e_default_value\"$\n" +
 	"\n" +
 	"ColumnItem\x12\x16\n" +
 	"\x06weight\x18\x01 \x01(\x01R\x06weight\"#\n" +
@@ -1897,7 +4840,31 @@ const file_widget_v1_widget_proto_rawDesc = "" +
 	"\tmax_value\x18\b \x01(\tR\bmaxValue\x12\x1b\n" +
 	"\tmin_value\x18\t \x01(\tR\bminValueB\b\n" +
 	"\x06_valueB\x10\n" +
-	"\x0e_default_value\"\xb2\x02\n" +
+	"\x0e_default_value\"\xe5\x03\n" +
+	"\x0eDateRangeInput\x12$\n" +
+	"\vstart_value\x18\x01 \x01(\tH\x00R\n" +
+	"startValue\x88\x01\x01\x12 \n" +
+	"\tend_value\x18\x02 \x01(\tH\x01R\bendValue\x88\x01\x01\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x123\n" +
+	"\x13default_start_value\x18\x04 \x01(\tH\x02R\x11defaultStartValue\x88\x01\x01\x12/\n" +
+	"\x11default_end_value\x18\x05 \x01(\tH\x03R\x0fdefaultEndValue\x88\x01\x01\x12\x1a\n" +
+	"\brequired\x18\x06 \x01(\bR\brequired\x12\x1a\n" +
+	"\bdisabled\x18\a \x01(\bR\bdisabled\x12\x16\n" +
+	"\x06format\x18\b \x01(\tR\x06format\x12\x1b\n" +
+	"\tmax_value\x18\t \x01(\tR\bmaxValue\x12\x1b\n" +
+	"\tmin_value\x18\n" +
+	" \x01(\tR\bminValue\x129\n" +
+	"\apresets\x18\v \x03(\v2\x1f.widget.v1.DateRangeInputPresetR\apresetsB\x0e\n" +
+	"\f_start_valueB\f\n" +
+	"\n" +
+	"_end_valueB\x16\n" +
+	"\x14_default_start_valueB\x14\n" +
+	"\x12_default_end_value\"j\n" +
+	"\x14DateRangeInputPreset\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12\x1f\n" +
+	"\vstart_value\x18\x02 \x01(\tR\n" +
+	"startValue\x12\x1b\n" +
+	"\tend_value\x18\x03 \x01(\tR\bendValue\"\xb2\x02\n" +
 	"\rDateTimeInput\x12\x19\n" +
 	"\x05value\x18\x01 \x01(\tH\x00R\x05value\x88\x01\x01\x12\x14\n" +
 	"\x05label\x18\x02 \x01(\tR\x05label\x12 \n" +
@@ -1909,14 +4876,67 @@ const file_widget_v1_widget_proto_rawDesc = "" +
 	"\tmax_value\x18\b \x01(\tR\bmaxValue\x12\x1b\n" +
 	"\tmin_value\x18\t \x01(\tR\bminValueB\b\n" +
 	"\x06_valueB\x10\n" +
-	"\x0e_default_value\"\x90\x01\n" +
+	"\x0e_default_value\"p\n" +
+	"\x06Dialog\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\bR\x05value\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
+	"\x04open\x18\x03 \x01(\bR\x04open\x12&\n" +
+	"\x0fclose_on_submit\x18\x04 \x01(\bR\rcloseOnSubmit\"\t\n" +
+	"\aDivider\"\x90\x01\n" +
+	"\x0eDownloadButton\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12\x1b\n" +
+	"\tmime_type\x18\x03 \x01(\tR\bmimeType\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\x12\x1a\n" +
+	"\bdisabled\x18\x05 \x01(\bR\bdisabled\"6\n" +
+	"\bExpander\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\bR\x05value\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\"\xf8\x01\n" +
+	"\tFileInput\x12.\n" +
+	"\x05value\x18\x01 \x03(\v2\x18.widget.v1.FileInputFileR\x05value\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x16\n" +
+	"\x06accept\x18\x03 \x03(\tR\x06accept\x12'\n" +
+	"\rmax_file_size\x18\x04 \x01(\x03H\x00R\vmaxFileSize\x88\x01\x01\x12\x1a\n" +
+	"\bmultiple\x18\x05 \x01(\bR\bmultiple\x12\x1a\n" +
+	"\brequired\x18\x06 \x01(\bR\brequired\x12\x1a\n" +
+	"\bdisabled\x18\a \x01(\bR\bdisabledB\x10\n" +
+	"\x0e_max_file_size\"d\n" +
+	"\rFileInputFile\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tmime_type\x18\x03 \x01(\tR\bmimeType\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\"\x90\x01\n" +
 	"\x04Form\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\bR\x05value\x12!\n" +
 	"\fbutton_label\x18\x02 \x01(\tR\vbuttonLabel\x12'\n" +
 	"\x0fbutton_disabled\x18\x03 \x01(\bR\x0ebuttonDisabled\x12&\n" +
-	"\x0fclear_on_submit\x18\x04 \x01(\bR\rclearOnSubmit\"\x1e\n" +
+	"\x0fclear_on_submit\x18\x04 \x01(\bR\rclearOnSubmit\"\x1c\n" +
+	"\x06Header\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\"\x89\x01\n" +
+	"\x05Image\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1b\n" +
+	"\tmime_type\x18\x02 \x01(\tR\bmimeType\x12\x12\n" +
+	"\x04size\x18\x03 \x01(\x03R\x04size\x12\x19\n" +
+	"\x05width\x18\x04 \x01(\x05H\x00R\x05width\x88\x01\x01\x12\x18\n" +
+	"\acaption\x18\x05 \x01(\tR\acaptionB\b\n" +
+	"\x06_width\"W\n" +
+	"\x04Json\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12%\n" +
+	"\x0eexpanded_depth\x18\x02 \x01(\x05R\rexpandedDepth\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\".\n" +
+	"\x04Link\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\"\x1e\n" +
 	"\bMarkdown\x12\x12\n" +
-	"\x04body\x18\x01 \x01(\tR\x04body\"\xd2\x01\n" +
+	"\x04body\x18\x01 \x01(\tR\x04body\"\xa3\x01\n" +
+	"\x06Metric\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x19\n" +
+	"\x05delta\x18\x03 \x01(\tH\x00R\x05delta\x88\x01\x01\x12'\n" +
+	"\x0fdelta_direction\x18\x04 \x01(\tR\x0edeltaDirection\x12\x1f\n" +
+	"\vdelta_color\x18\x05 \x01(\tR\n" +
+	"deltaColorB\b\n" +
+	"\x06_delta\"\xd2\x01\n" +
 	"\vMultiSelect\x12\x14\n" +
 	"\x05value\x18\x01 \x03(\x05R\x05value\x12\x14\n" +
 	"\x05label\x18\x02 \x01(\tR\x05label\x12\x18\n" +
@@ -1939,7 +4959,17 @@ const file_widget_v1_widget_proto_rawDesc = "" +
 	"\n" +
 	"_max_valueB\f\n" +
 	"\n" +
-	"_min_value\"\xd0\x01\n" +
+	"_min_value\"\x81\x01\n" +
+	"\bPageLink\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12\x17\n" +
+	"\apage_id\x18\x02 \x01(\tR\x06pageId\x12\x14\n" +
+	"\x05route\x18\x03 \x01(\tR\x05route\x12\x14\n" +
+	"\x05query\x18\x04 \x01(\tR\x05query\x12\x1a\n" +
+	"\bdisabled\x18\x05 \x01(\bR\bdisabled\"J\n" +
+	"\bProgress\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\"\xd0\x01\n" +
 	"\x05Radio\x12\x19\n" +
 	"\x05value\x18\x01 \x01(\x05H\x00R\x05value\x88\x01\x01\x12\x14\n" +
 	"\x05label\x18\x02 \x01(\tR\x05label\x12\x18\n" +
@@ -1948,7 +4978,18 @@ const file_widget_v1_widget_proto_rawDesc = "" +
 	"\brequired\x18\x05 \x01(\bR\brequired\x12\x1a\n" +
 	"\bdisabled\x18\x06 \x01(\bR\bdisabledB\b\n" +
 	"\x06_valueB\x10\n" +
-	"\x0e_default_value\"\xf6\x01\n" +
+	"\x0e_default_value\"\xf7\x01\n" +
+	"\vRangeSlider\x12\x10\n" +
+	"\x03low\x18\x01 \x01(\x01R\x03low\x12\x12\n" +
+	"\x04high\x18\x02 \x01(\x01R\x04high\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12\x1f\n" +
+	"\vdefault_low\x18\x04 \x01(\x01R\n" +
+	"defaultLow\x12!\n" +
+	"\fdefault_high\x18\x05 \x01(\x01R\vdefaultHigh\x12\x1b\n" +
+	"\tmin_value\x18\x06 \x01(\x01R\bminValue\x12\x1b\n" +
+	"\tmax_value\x18\a \x01(\x01R\bmaxValue\x12\x12\n" +
+	"\x04step\x18\b \x01(\x01R\x04step\x12\x1a\n" +
+	"\bdisabled\x18\t \x01(\bR\bdisabled\"\xf6\x01\n" +
 	"\tSelectbox\x12\x19\n" +
 	"\x05value\x18\x01 \x01(\x05H\x00R\x05value\x88\x01\x01\x12\x14\n" +
 	"\x05label\x18\x02 \x01(\tR\x05label\x12\x18\n" +
@@ -1958,7 +4999,23 @@ const file_widget_v1_widget_proto_rawDesc = "" +
 	"\brequired\x18\x06 \x01(\bR\brequired\x12\x1a\n" +
 	"\bdisabled\x18\a \x01(\bR\bdisabledB\b\n" +
 	"\x06_valueB\x10\n" +
-	"\x0e_default_value\"\x8f\x02\n" +
+	"\x0e_default_value\"\xc3\x01\n" +
+	"\x06Slider\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x01R\x05value\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12#\n" +
+	"\rdefault_value\x18\x03 \x01(\x01R\fdefaultValue\x12\x1b\n" +
+	"\tmin_value\x18\x04 \x01(\x01R\bminValue\x12\x1b\n" +
+	"\tmax_value\x18\x05 \x01(\x01R\bmaxValue\x12\x12\n" +
+	"\x04step\x18\x06 \x01(\x01R\x04step\x12\x1a\n" +
+	"\bdisabled\x18\a \x01(\bR\bdisabled\"\b\n" +
+	"\x06Spacer\"5\n" +
+	"\aSpinner\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x16\n" +
+	"\x06active\x18\x02 \x01(\bR\x06active\"\x1f\n" +
+	"\tSubheader\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\"\x1f\n" +
+	"\aTabItem\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\"\xe0\x03\n" +
 	"\x05Table\x12\x12\n" +
 	"\x04data\x18\x01 \x01(\fR\x04data\x12+\n" +
 	"\x05value\x18\x02 \x01(\v2\x15.widget.v1.TableValueR\x05value\x12\x16\n" +
@@ -1967,16 +5024,77 @@ const file_widget_v1_widget_proto_rawDesc = "" +
 	"\x06height\x18\x05 \x01(\x05H\x00R\x06height\x88\x01\x01\x12!\n" +
 	"\fcolumn_order\x18\x06 \x03(\tR\vcolumnOrder\x12\x1b\n" +
 	"\ton_select\x18\a \x01(\tR\bonSelect\x12#\n" +
-	"\rrow_selection\x18\b \x01(\tR\frowSelectionB\t\n" +
-	"\a_height\"]\n" +
+	"\rrow_selection\x18\b \x01(\tR\frowSelection\x12\x1c\n" +
+	"\tpaginated\x18\t \x01(\bR\tpaginated\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\n" +
+	" \x01(\x03R\ttotalRows\x12)\n" +
+	"\x10editable_columns\x18\v \x03(\tR\x0feditableColumns\x12\x1f\n" +
+	"\vrow_actions\x18\f \x03(\tR\n" +
+	"rowActions\x120\n" +
+	"\acolumns\x18\r \x03(\v2\x16.widget.v1.TableColumnR\acolumns\x12\x14\n" +
+	"\x05error\x18\x0e \x01(\tR\x05errorB\t\n" +
+	"\a_height\"}\n" +
+	"\vTableColumn\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x129\n" +
+	"\x06format\x18\x03 \x01(\v2\x1c.widget.v1.TableColumnFormatH\x00R\x06format\x88\x01\x01B\t\n" +
+	"\a_format\"\x92\x01\n" +
+	"\x11TableColumnFormat\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1f\n" +
+	"\bdecimals\x18\x02 \x01(\x05H\x00R\bdecimals\x88\x01\x01\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x1f\n" +
+	"\vdate_format\x18\x04 \x01(\tR\n" +
+	"dateFormatB\v\n" +
+	"\t_decimals\"\xdf\x03\n" +
 	"\n" +
 	"TableValue\x12A\n" +
-	"\tselection\x18\x01 \x01(\v2\x1e.widget.v1.TableValueSelectionH\x00R\tselection\x88\x01\x01B\f\n" +
+	"\tselection\x18\x01 \x01(\v2\x1e.widget.v1.TableValueSelectionH\x00R\tselection\x88\x01\x01\x12D\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x1f.widget.v1.TableValuePaginationH\x01R\n" +
+	"pagination\x88\x01\x01\x122\n" +
+	"\x04sort\x18\x03 \x01(\v2\x19.widget.v1.TableValueSortH\x02R\x04sort\x88\x01\x01\x12<\n" +
+	"\afilters\x18\x04 \x03(\v2\".widget.v1.TableValue.FiltersEntryR\afilters\x12/\n" +
+	"\x05edits\x18\x05 \x03(\v2\x19.widget.v1.TableValueEditR\x05edits\x128\n" +
+	"\x06action\x18\x06 \x01(\v2\x1b.widget.v1.TableValueActionH\x03R\x06action\x88\x01\x01\x1a:\n" +
+	"\fFiltersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\f\n" +
 	"\n" +
-	"_selection\";\n" +
+	"_selectionB\r\n" +
+	"\v_paginationB\a\n" +
+	"\x05_sortB\t\n" +
+	"\a_action\"8\n" +
+	"\x10TableValueAction\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"t\n" +
+	"\x0eTableValueEdit\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x16\n" +
+	"\x06column\x18\x02 \x01(\tR\x06column\x12\x1b\n" +
+	"\told_value\x18\x03 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x04 \x01(\tR\bnewValue\"G\n" +
+	"\x14TableValuePagination\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\";\n" +
 	"\x13TableValueSelection\x12\x10\n" +
 	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x12\n" +
-	"\x04rows\x18\x02 \x03(\x05R\x04rows\"\xc2\x03\n" +
+	"\x04rows\x18\x02 \x03(\x05R\x04rows\"F\n" +
+	"\x0eTableValueSort\x12\x16\n" +
+	"\x06column\x18\x01 \x01(\tR\x06column\x12\x1c\n" +
+	"\tdirection\x18\x02 \x01(\tR\tdirection\"0\n" +
+	"\x04Tabs\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x05R\x05value\x12\x12\n" +
+	"\x04tabs\x18\x02 \x01(\x05R\x04tabs\"\x84\x02\n" +
+	"\bTagInput\x12\x14\n" +
+	"\x05value\x18\x01 \x03(\tR\x05value\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12 \n" +
+	"\vplaceholder\x18\x03 \x01(\tR\vplaceholder\x12#\n" +
+	"\rdefault_value\x18\x04 \x03(\tR\fdefaultValue\x12\x1a\n" +
+	"\brequired\x18\x05 \x01(\bR\brequired\x12\x1a\n" +
+	"\bdisabled\x18\x06 \x01(\bR\bdisabled\x12 \n" +
+	"\vsuggestions\x18\a \x03(\tR\vsuggestions\x12\x1e\n" +
+	"\bmax_tags\x18\b \x01(\x05H\x00R\amaxTags\x88\x01\x01B\v\n" +
+	"\t_max_tags\"\xc2\x03\n" +
 	"\bTextArea\x12\x19\n" +
 	"\x05value\x18\x01 \x01(\tH\x00R\x05value\x88\x01\x01\x12\x14\n" +
 	"\x05label\x18\x02 \x01(\tR\x05label\x12 \n" +
@@ -2024,7 +5142,13 @@ const file_widget_v1_widget_proto_rawDesc = "" +
 	"\brequired\x18\x05 \x01(\bR\brequired\x12\x1a\n" +
 	"\bdisabled\x18\x06 \x01(\bR\bdisabledB\b\n" +
 	"\x06_valueB\x10\n" +
-	"\x0e_default_value\"\xa8\a\n" +
+	"\x0e_default_value\"\x9d\x01\n" +
+	"\x06Toggle\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\bR\x05value\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12#\n" +
+	"\rdefault_value\x18\x03 \x01(\bR\fdefaultValue\x12\x1a\n" +
+	"\bdisabled\x18\x04 \x01(\bR\bdisabled\x12&\n" +
+	"\x0frerun_on_change\x18\x05 \x01(\bR\rrerunOnChange\"\xe3\x11\n" +
 	"\x06Widget\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12+\n" +
 	"\x06button\x18\x02 \x01(\v2\x11.widget.v1.ButtonH\x00R\x06button\x121\n" +
@@ -2048,7 +5172,37 @@ const file_widget_v1_widget_proto_rawDesc = "" +
 	"\n" +
 	"text_input\x18\x11 \x01(\v2\x14.widget.v1.TextInputH\x00R\ttextInput\x125\n" +
 	"\n" +
-	"time_input\x18\x12 \x01(\v2\x14.widget.v1.TimeInputH\x00R\ttimeInputB\x06\n" +
+	"time_input\x18\x12 \x01(\v2\x14.widget.v1.TimeInputH\x00R\ttimeInput\x125\n" +
+	"\n" +
+	"file_input\x18\x13 \x01(\v2\x14.widget.v1.FileInputH\x00R\tfileInput\x12D\n" +
+	"\x0fdownload_button\x18\x14 \x01(\v2\x19.widget.v1.DownloadButtonH\x00R\x0edownloadButton\x12(\n" +
+	"\x05chart\x18\x15 \x01(\v2\x10.widget.v1.ChartH\x00R\x05chart\x12%\n" +
+	"\x04tabs\x18\x16 \x01(\v2\x0f.widget.v1.TabsH\x00R\x04tabs\x12/\n" +
+	"\btab_item\x18\x17 \x01(\v2\x12.widget.v1.TabItemH\x00R\atabItem\x121\n" +
+	"\bexpander\x18\x18 \x01(\v2\x13.widget.v1.ExpanderH\x00R\bexpander\x12+\n" +
+	"\x06dialog\x18\x19 \x01(\v2\x11.widget.v1.DialogH\x00R\x06dialog\x12(\n" +
+	"\x05alert\x18\x1a \x01(\v2\x10.widget.v1.AlertH\x00R\x05alert\x12+\n" +
+	"\x06metric\x18\x1b \x01(\v2\x11.widget.v1.MetricH\x00R\x06metric\x121\n" +
+	"\bprogress\x18\x1c \x01(\v2\x13.widget.v1.ProgressH\x00R\bprogress\x12.\n" +
+	"\aspinner\x18\x1d \x01(\v2\x12.widget.v1.SpinnerH\x00R\aspinner\x12+\n" +
+	"\x06slider\x18\x1e \x01(\v2\x11.widget.v1.SliderH\x00R\x06slider\x12;\n" +
+	"\frange_slider\x18\x1f \x01(\v2\x16.widget.v1.RangeSliderH\x00R\vrangeSlider\x12+\n" +
+	"\x06toggle\x18  \x01(\v2\x11.widget.v1.ToggleH\x00R\x06toggle\x12E\n" +
+	"\x10date_range_input\x18! \x01(\v2\x19.widget.v1.DateRangeInputH\x00R\x0edateRangeInput\x12%\n" +
+	"\x04json\x18\" \x01(\v2\x0f.widget.v1.JsonH\x00R\x04json\x128\n" +
+	"\vcode_editor\x18# \x01(\v2\x15.widget.v1.CodeEditorH\x00R\n" +
+	"codeEditor\x12(\n" +
+	"\x05image\x18$ \x01(\v2\x10.widget.v1.ImageH\x00R\x05image\x12%\n" +
+	"\x04link\x18% \x01(\v2\x0f.widget.v1.LinkH\x00R\x04link\x122\n" +
+	"\tpage_link\x18& \x01(\v2\x13.widget.v1.PageLinkH\x00R\bpageLink\x12+\n" +
+	"\x06header\x18' \x01(\v2\x11.widget.v1.HeaderH\x00R\x06header\x124\n" +
+	"\tsubheader\x18( \x01(\v2\x14.widget.v1.SubheaderH\x00R\tsubheader\x12.\n" +
+	"\acaption\x18) \x01(\v2\x12.widget.v1.CaptionH\x00R\acaption\x12.\n" +
+	"\adivider\x18* \x01(\v2\x12.widget.v1.DividerH\x00R\adivider\x12+\n" +
+	"\x06spacer\x18+ \x01(\v2\x11.widget.v1.SpacerH\x00R\x06spacer\x122\n" +
+	"\ttag_input\x18, \x01(\v2\x13.widget.v1.TagInputH\x00R\btagInput\x128\n" +
+	"\vcolor_input\x18- \x01(\v2\x15.widget.v1.ColorInputH\x00R\n" +
+	"colorInputB\x06\n" +
 	"\x04typeB\xb0\x01\n" +
 	"\rcom.widget.v1B\vWidgetProtoP\x01ZMgithub.com/trysourcetool/sourcetool/backend/internal/pb/go/widget/v1;widgetv1\xa2\x02\x03WXX\xaa\x02\tWidget.V1\xca\x02\tWidget\\V1\xe2\x02\x15Widget\\V1\\GPBMetadata\xea\x02\n" +
 	"Widget::V1b\x06proto3"
@@ -2065,54 +5219,126 @@ func file_widget_v1_widget_proto_rawDescGZIP() []byte {
 	return file_widget_v1_widget_proto_rawDescData
 }
 
-var file_widget_v1_widget_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_widget_v1_widget_proto_msgTypes = make([]protoimpl.MessageInfo, 56)
 var file_widget_v1_widget_proto_goTypes = []any{
-	(*Button)(nil),              // 0: widget.v1.Button
-	(*Checkbox)(nil),            // 1: widget.v1.Checkbox
-	(*CheckboxGroup)(nil),       // 2: widget.v1.CheckboxGroup
-	(*ColumnItem)(nil),          // 3: widget.v1.ColumnItem
-	(*Columns)(nil),             // 4: widget.v1.Columns
-	(*DateInput)(nil),           // 5: widget.v1.DateInput
-	(*DateTimeInput)(nil),       // 6: widget.v1.DateTimeInput
-	(*Form)(nil),                // 7: widget.v1.Form
-	(*Markdown)(nil),            // 8: widget.v1.Markdown
-	(*MultiSelect)(nil),         // 9: widget.v1.MultiSelect
-	(*NumberInput)(nil),         // 10: widget.v1.NumberInput
-	(*Radio)(nil),               // 11: widget.v1.Radio
-	(*Selectbox)(nil),           // 12: widget.v1.Selectbox
-	(*Table)(nil),               // 13: widget.v1.Table
-	(*TableValue)(nil),          // 14: widget.v1.TableValue
-	(*TableValueSelection)(nil), // 15: widget.v1.TableValueSelection
-	(*TextArea)(nil),            // 16: widget.v1.TextArea
-	(*TextInput)(nil),           // 17: widget.v1.TextInput
-	(*TimeInput)(nil),           // 18: widget.v1.TimeInput
-	(*Widget)(nil),              // 19: widget.v1.Widget
+	(*Alert)(nil),                // 0: widget.v1.Alert
+	(*Button)(nil),               // 1: widget.v1.Button
+	(*Caption)(nil),              // 2: widget.v1.Caption
+	(*Chart)(nil),                // 3: widget.v1.Chart
+	(*Checkbox)(nil),             // 4: widget.v1.Checkbox
+	(*CheckboxGroup)(nil),        // 5: widget.v1.CheckboxGroup
+	(*CodeEditor)(nil),           // 6: widget.v1.CodeEditor
+	(*ColorInput)(nil),           // 7: widget.v1.ColorInput
+	(*ColumnItem)(nil),           // 8: widget.v1.ColumnItem
+	(*Columns)(nil),              // 9: widget.v1.Columns
+	(*DateInput)(nil),            // 10: widget.v1.DateInput
+	(*DateRangeInput)(nil),       // 11: widget.v1.DateRangeInput
+	(*DateRangeInputPreset)(nil), // 12: widget.v1.DateRangeInputPreset
+	(*DateTimeInput)(nil),        // 13: widget.v1.DateTimeInput
+	(*Dialog)(nil),               // 14: widget.v1.Dialog
+	(*Divider)(nil),              // 15: widget.v1.Divider
+	(*DownloadButton)(nil),       // 16: widget.v1.DownloadButton
+	(*Expander)(nil),             // 17: widget.v1.Expander
+	(*FileInput)(nil),            // 18: widget.v1.FileInput
+	(*FileInputFile)(nil),        // 19: widget.v1.FileInputFile
+	(*Form)(nil),                 // 20: widget.v1.Form
+	(*Header)(nil),               // 21: widget.v1.Header
+	(*Image)(nil),                // 22: widget.v1.Image
+	(*Json)(nil),                 // 23: widget.v1.Json
+	(*Link)(nil),                 // 24: widget.v1.Link
+	(*Markdown)(nil),             // 25: widget.v1.Markdown
+	(*Metric)(nil),               // 26: widget.v1.Metric
+	(*MultiSelect)(nil),          // 27: widget.v1.MultiSelect
+	(*NumberInput)(nil),          // 28: widget.v1.NumberInput
+	(*PageLink)(nil),             // 29: widget.v1.PageLink
+	(*Progress)(nil),             // 30: widget.v1.Progress
+	(*Radio)(nil),                // 31: widget.v1.Radio
+	(*RangeSlider)(nil),          // 32: widget.v1.RangeSlider
+	(*Selectbox)(nil),            // 33: widget.v1.Selectbox
+	(*Slider)(nil),               // 34: widget.v1.Slider
+	(*Spacer)(nil),               // 35: widget.v1.Spacer
+	(*Spinner)(nil),              // 36: widget.v1.Spinner
+	(*Subheader)(nil),            // 37: widget.v1.Subheader
+	(*TabItem)(nil),              // 38: widget.v1.TabItem
+	(*Table)(nil),                // 39: widget.v1.Table
+	(*TableColumn)(nil),          // 40: widget.v1.TableColumn
+	(*TableColumnFormat)(nil),    // 41: widget.v1.TableColumnFormat
+	(*TableValue)(nil),           // 42: widget.v1.TableValue
+	(*TableValueAction)(nil),     // 43: widget.v1.TableValueAction
+	(*TableValueEdit)(nil),       // 44: widget.v1.TableValueEdit
+	(*TableValuePagination)(nil), // 45: widget.v1.TableValuePagination
+	(*TableValueSelection)(nil),  // 46: widget.v1.TableValueSelection
+	(*TableValueSort)(nil),       // 47: widget.v1.TableValueSort
+	(*Tabs)(nil),                 // 48: widget.v1.Tabs
+	(*TagInput)(nil),             // 49: widget.v1.TagInput
+	(*TextArea)(nil),             // 50: widget.v1.TextArea
+	(*TextInput)(nil),            // 51: widget.v1.TextInput
+	(*TimeInput)(nil),            // 52: widget.v1.TimeInput
+	(*Toggle)(nil),               // 53: widget.v1.Toggle
+	(*Widget)(nil),               // 54: widget.v1.Widget
+	nil,                          // 55: widget.v1.TableValue.FiltersEntry
 }
 var file_widget_v1_widget_proto_depIdxs = []int32{
-	14, // 0: widget.v1.Table.value:type_name -> widget.v1.TableValue
-	15, // 1: widget.v1.TableValue.selection:type_name -> widget.v1.TableValueSelection
-	0,  // 2: widget.v1.Widget.button:type_name -> widget.v1.Button
-	1,  // 3: widget.v1.Widget.checkbox:type_name -> widget.v1.Checkbox
-	2,  // 4: widget.v1.Widget.checkbox_group:type_name -> widget.v1.CheckboxGroup
-	3,  // 5: widget.v1.Widget.column_item:type_name -> widget.v1.ColumnItem
-	4,  // 6: widget.v1.Widget.columns:type_name -> widget.v1.Columns
-	5,  // 7: widget.v1.Widget.date_input:type_name -> widget.v1.DateInput
-	6,  // 8: widget.v1.Widget.date_time_input:type_name -> widget.v1.DateTimeInput
-	7,  // 9: widget.v1.Widget.form:type_name -> widget.v1.Form
-	8,  // 10: widget.v1.Widget.markdown:type_name -> widget.v1.Markdown
-	9,  // 11: widget.v1.Widget.multi_select:type_name -> widget.v1.MultiSelect
-	10, // 12: widget.v1.Widget.number_input:type_name -> widget.v1.NumberInput
-	11, // 13: widget.v1.Widget.radio:type_name -> widget.v1.Radio
-	12, // 14: widget.v1.Widget.selectbox:type_name -> widget.v1.Selectbox
-	13, // 15: widget.v1.Widget.table:type_name -> widget.v1.Table
-	16, // 16: widget.v1.Widget.text_area:type_name -> widget.v1.TextArea
-	17, // 17: widget.v1.Widget.text_input:type_name -> widget.v1.TextInput
-	18, // 18: widget.v1.Widget.time_input:type_name -> widget.v1.TimeInput
-	19, // [19:19] is the sub-list for method output_type
-	19, // [19:19] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	12, // 0: widget.v1.DateRangeInput.presets:type_name -> widget.v1.DateRangeInputPreset
+	19, // 1: widget.v1.FileInput.value:type_name -> widget.v1.FileInputFile
+	42, // 2: widget.v1.Table.value:type_name -> widget.v1.TableValue
+	40, // 3: widget.v1.Table.columns:type_name -> widget.v1.TableColumn
+	41, // 4: widget.v1.TableColumn.format:type_name -> widget.v1.TableColumnFormat
+	46, // 5: widget.v1.TableValue.selection:type_name -> widget.v1.TableValueSelection
+	45, // 6: widget.v1.TableValue.pagination:type_name -> widget.v1.TableValuePagination
+	47, // 7: widget.v1.TableValue.sort:type_name -> widget.v1.TableValueSort
+	55, // 8: widget.v1.TableValue.filters:type_name -> widget.v1.TableValue.FiltersEntry
+	44, // 9: widget.v1.TableValue.edits:type_name -> widget.v1.TableValueEdit
+	43, // 10: widget.v1.TableValue.action:type_name -> widget.v1.TableValueAction
+	1,  // 11: widget.v1.Widget.button:type_name -> widget.v1.Button
+	4,  // 12: widget.v1.Widget.checkbox:type_name -> widget.v1.Checkbox
+	5,  // 13: widget.v1.Widget.checkbox_group:type_name -> widget.v1.CheckboxGroup
+	8,  // 14: widget.v1.Widget.column_item:type_name -> widget.v1.ColumnItem
+	9,  // 15: widget.v1.Widget.columns:type_name -> widget.v1.Columns
+	10, // 16: widget.v1.Widget.date_input:type_name -> widget.v1.DateInput
+	13, // 17: widget.v1.Widget.date_time_input:type_name -> widget.v1.DateTimeInput
+	20, // 18: widget.v1.Widget.form:type_name -> widget.v1.Form
+	25, // 19: widget.v1.Widget.markdown:type_name -> widget.v1.Markdown
+	27, // 20: widget.v1.Widget.multi_select:type_name -> widget.v1.MultiSelect
+	28, // 21: widget.v1.Widget.number_input:type_name -> widget.v1.NumberInput
+	31, // 22: widget.v1.Widget.radio:type_name -> widget.v1.Radio
+	33, // 23: widget.v1.Widget.selectbox:type_name -> widget.v1.Selectbox
+	39, // 24: widget.v1.Widget.table:type_name -> widget.v1.Table
+	50, // 25: widget.v1.Widget.text_area:type_name -> widget.v1.TextArea
+	51, // 26: widget.v1.Widget.text_input:type_name -> widget.v1.TextInput
+	52, // 27: widget.v1.Widget.time_input:type_name -> widget.v1.TimeInput
+	18, // 28: widget.v1.Widget.file_input:type_name -> widget.v1.FileInput
+	16, // 29: widget.v1.Widget.download_button:type_name -> widget.v1.DownloadButton
+	3,  // 30: widget.v1.Widget.chart:type_name -> widget.v1.Chart
+	48, // 31: widget.v1.Widget.tabs:type_name -> widget.v1.Tabs
+	38, // 32: widget.v1.Widget.tab_item:type_name -> widget.v1.TabItem
+	17, // 33: widget.v1.Widget.expander:type_name -> widget.v1.Expander
+	14, // 34: widget.v1.Widget.dialog:type_name -> widget.v1.Dialog
+	0,  // 35: widget.v1.Widget.alert:type_name -> widget.v1.Alert
+	26, // 36: widget.v1.Widget.metric:type_name -> widget.v1.Metric
+	30, // 37: widget.v1.Widget.progress:type_name -> widget.v1.Progress
+	36, // 38: widget.v1.Widget.spinner:type_name -> widget.v1.Spinner
+	34, // 39: widget.v1.Widget.slider:type_name -> widget.v1.Slider
+	32, // 40: widget.v1.Widget.range_slider:type_name -> widget.v1.RangeSlider
+	53, // 41: widget.v1.Widget.toggle:type_name -> widget.v1.Toggle
+	11, // 42: widget.v1.Widget.date_range_input:type_name -> widget.v1.DateRangeInput
+	23, // 43: widget.v1.Widget.json:type_name -> widget.v1.Json
+	6,  // 44: widget.v1.Widget.code_editor:type_name -> widget.v1.CodeEditor
+	22, // 45: widget.v1.Widget.image:type_name -> widget.v1.Image
+	24, // 46: widget.v1.Widget.link:type_name -> widget.v1.Link
+	29, // 47: widget.v1.Widget.page_link:type_name -> widget.v1.PageLink
+	21, // 48: widget.v1.Widget.header:type_name -> widget.v1.Header
+	37, // 49: widget.v1.Widget.subheader:type_name -> widget.v1.Subheader
+	2,  // 50: widget.v1.Widget.caption:type_name -> widget.v1.Caption
+	15, // 51: widget.v1.Widget.divider:type_name -> widget.v1.Divider
+	35, // 52: widget.v1.Widget.spacer:type_name -> widget.v1.Spacer
+	49, // 53: widget.v1.Widget.tag_input:type_name -> widget.v1.TagInput
+	7,  // 54: widget.v1.Widget.color_input:type_name -> widget.v1.ColorInput
+	55, // [55:55] is the sub-list for method output_type
+	55, // [55:55] is the sub-list for method input_type
+	55, // [55:55] is the sub-list for extension type_name
+	55, // [55:55] is the sub-list for extension extendee
+	0,  // [0:55] is the sub-list for field type_name
 }
 
 func init() { file_widget_v1_widget_proto_init() }
@@ -2120,17 +5346,27 @@ func file_widget_v1_widget_proto_init() {
 	if File_widget_v1_widget_proto != nil {
 		return
 	}
-	file_widget_v1_widget_proto_msgTypes[5].OneofWrappers = []any{}
+	file_widget_v1_widget_proto_msgTypes[3].OneofWrappers = []any{}
 	file_widget_v1_widget_proto_msgTypes[6].OneofWrappers = []any{}
+	file_widget_v1_widget_proto_msgTypes[7].OneofWrappers = []any{}
 	file_widget_v1_widget_proto_msgTypes[10].OneofWrappers = []any{}
 	file_widget_v1_widget_proto_msgTypes[11].OneofWrappers = []any{}
-	file_widget_v1_widget_proto_msgTypes[12].OneofWrappers = []any{}
 	file_widget_v1_widget_proto_msgTypes[13].OneofWrappers = []any{}
-	file_widget_v1_widget_proto_msgTypes[14].OneofWrappers = []any{}
-	file_widget_v1_widget_proto_msgTypes[16].OneofWrappers = []any{}
-	file_widget_v1_widget_proto_msgTypes[17].OneofWrappers = []any{}
 	file_widget_v1_widget_proto_msgTypes[18].OneofWrappers = []any{}
-	file_widget_v1_widget_proto_msgTypes[19].OneofWrappers = []any{
+	file_widget_v1_widget_proto_msgTypes[22].OneofWrappers = []any{}
+	file_widget_v1_widget_proto_msgTypes[26].OneofWrappers = []any{}
+	file_widget_v1_widget_proto_msgTypes[28].OneofWrappers = []any{}
+	file_widget_v1_widget_proto_msgTypes[31].OneofWrappers = []any{}
+	file_widget_v1_widget_proto_msgTypes[33].OneofWrappers = []any{}
+	file_widget_v1_widget_proto_msgTypes[39].OneofWrappers = []any{}
+	file_widget_v1_widget_proto_msgTypes[40].OneofWrappers = []any{}
+	file_widget_v1_widget_proto_msgTypes[41].OneofWrappers = []any{}
+	file_widget_v1_widget_proto_msgTypes[42].OneofWrappers = []any{}
+	file_widget_v1_widget_proto_msgTypes[49].OneofWrappers = []any{}
+	file_widget_v1_widget_proto_msgTypes[50].OneofWrappers = []any{}
+	file_widget_v1_widget_proto_msgTypes[51].OneofWrappers = []any{}
+	file_widget_v1_widget_proto_msgTypes[52].OneofWrappers = []any{}
+	file_widget_v1_widget_proto_msgTypes[54].OneofWrappers = []any{
 		(*Widget_Button)(nil),
 		(*Widget_Checkbox)(nil),
 		(*Widget_CheckboxGroup)(nil),
@@ -2148,6 +5384,33 @@ func file_widget_v1_widget_proto_init() {
 		(*Widget_TextArea)(nil),
 		(*Widget_TextInput)(nil),
 		(*Widget_TimeInput)(nil),
+		(*Widget_FileInput)(nil),
+		(*Widget_DownloadButton)(nil),
+		(*Widget_Chart)(nil),
+		(*Widget_Tabs)(nil),
+		(*Widget_TabItem)(nil),
+		(*Widget_Expander)(nil),
+		(*Widget_Dialog)(nil),
+		(*Widget_Alert)(nil),
+		(*Widget_Metric)(nil),
+		(*Widget_Progress)(nil),
+		(*Widget_Spinner)(nil),
+		(*Widget_Slider)(nil),
+		(*Widget_RangeSlider)(nil),
+		(*Widget_Toggle)(nil),
+		(*Widget_DateRangeInput)(nil),
+		(*Widget_Json)(nil),
+		(*Widget_CodeEditor)(nil),
+		(*Widget_Image)(nil),
+		(*Widget_Link)(nil),
+		(*Widget_PageLink)(nil),
+		(*Widget_Header)(nil),
+		(*Widget_Subheader)(nil),
+		(*Widget_Caption)(nil),
+		(*Widget_Divider)(nil),
+		(*Widget_Spacer)(nil),
+		(*Widget_TagInput)(nil),
+		(*Widget_ColorInput)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2155,7 +5418,7 @@ func file_widget_v1_widget_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_widget_v1_widget_proto_rawDesc), len(file_widget_v1_widget_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   56,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -157,6 +157,7 @@ func (s *Server) handleInitializeClient(ctx context.Context, conn *websocket.Con
 			InitializeClient: &websocketv1.InitializeClient{
 				SessionId: internal.StringPtr(sess.ID.String()),
 				PageId:    page.ID.String(),
+				Query:     in.Query,
 			},
 		},
 	}); err != nil {
@@ -231,6 +232,7 @@ func (s *Server) handleRerunPage(ctx context.Context, conn *websocket.Conn, msg
 				SessionId: sess.ID.String(),
 				PageId:    page.ID.String(),
 				States:    in.States,
+				Query:     in.Query,
 			},
 		},
 	}); err != nil {
@@ -293,6 +295,154 @@ func (s *Server) handleCloseSession(ctx context.Context, conn *websocket.Conn, m
 	return nil
 }
 
+func (s *Server) handleUploadFileChunk(ctx context.Context, conn *websocket.Conn, msg *websocketv1.Message) error {
+	in := msg.GetUploadFileChunk()
+	if in == nil {
+		return errors.New("invalid message")
+	}
+
+	sessionID, err := uuid.FromString(in.SessionId)
+	if err != nil {
+		return err
+	}
+
+	sess, err := s.db.Session().Get(ctx, database.SessionByID(sessionID))
+	if err != nil {
+		return err
+	}
+
+	pageID, err := uuid.FromString(in.PageId)
+	if err != nil {
+		return err
+	}
+
+	page, err := s.db.Page().Get(ctx, database.PageByID(pageID), database.PageBySessionID(sess.ID))
+	if err != nil {
+		return err
+	}
+
+	hostInstance, err := s.db.HostInstance().Get(ctx, database.HostInstanceBySessionID(sess.ID), database.HostInstanceByStatus(core.HostInstanceStatusOnline))
+	if err != nil {
+		return err
+	}
+
+	if err := s.wsManager.SendToHost(ctx, hostInstance.ID, &websocketv1.Message{
+		Id: msg.Id,
+		Type: &websocketv1.Message_UploadFileChunk{
+			UploadFileChunk: &websocketv1.UploadFileChunk{
+				SessionId: sess.ID.String(),
+				PageId:    page.ID.String(),
+				WidgetId:  in.WidgetId,
+				FileId:    in.FileId,
+				Name:      in.Name,
+				MimeType:  in.MimeType,
+				Size:      in.Size,
+				Offset:    in.Offset,
+				Data:      in.Data,
+			},
+		},
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Server) handleDownloadFile(ctx context.Context, conn *websocket.Conn, msg *websocketv1.Message) error {
+	in := msg.GetDownloadFile()
+	if in == nil {
+		return errors.New("invalid message")
+	}
+
+	sessionID, err := uuid.FromString(in.SessionId)
+	if err != nil {
+		return err
+	}
+
+	sess, err := s.db.Session().Get(ctx, database.SessionByID(sessionID))
+	if err != nil {
+		return err
+	}
+
+	pageID, err := uuid.FromString(in.PageId)
+	if err != nil {
+		return err
+	}
+
+	page, err := s.db.Page().Get(ctx, database.PageByID(pageID), database.PageBySessionID(sess.ID))
+	if err != nil {
+		return err
+	}
+
+	hostInstance, err := s.db.HostInstance().Get(ctx, database.HostInstanceBySessionID(sess.ID), database.HostInstanceByStatus(core.HostInstanceStatusOnline))
+	if err != nil {
+		return err
+	}
+
+	if err := s.wsManager.SendToHost(ctx, hostInstance.ID, &websocketv1.Message{
+		Id: msg.Id,
+		Type: &websocketv1.Message_DownloadFile{
+			DownloadFile: &websocketv1.DownloadFile{
+				SessionId: sess.ID.String(),
+				PageId:    page.ID.String(),
+				WidgetId:  in.WidgetId,
+			},
+		},
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Server) handleDownloadFileChunk(ctx context.Context, conn *websocket.Conn, msg *websocketv1.Message) error {
+	in := msg.GetDownloadFileChunk()
+	if in == nil {
+		return errors.New("invalid message")
+	}
+
+	sessionID, err := uuid.FromString(in.SessionId)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.Session().Get(ctx, database.SessionByID(sessionID))
+	if err != nil {
+		return err
+	}
+
+	if err := s.wsManager.SendToClient(ctx, sessionID, msg); err != nil {
+		logger.Logger.Sugar().Errorf("Failed to send download file chunk message to client: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (s *Server) handleToast(ctx context.Context, conn *websocket.Conn, msg *websocketv1.Message) error {
+	in := msg.GetToast()
+	if in == nil {
+		return errors.New("invalid message")
+	}
+
+	sessionID, err := uuid.FromString(in.SessionId)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.Session().Get(ctx, database.SessionByID(sessionID))
+	if err != nil {
+		return err
+	}
+
+	if err := s.wsManager.SendToClient(ctx, sessionID, msg); err != nil {
+		logger.Logger.Sugar().Errorf("Failed to send toast message to client: %v", err)
+		return err
+	}
+
+	return nil
+}
+
 func (s *Server) handleScriptFinished(ctx context.Context, conn *websocket.Conn, msg *websocketv1.Message) error {
 	in := msg.GetScriptFinished()
 	if in == nil {
@@ -398,6 +548,26 @@ func (s *Server) handleWebSocket(w http.ResponseWriter, r *http.Request) {
 				s.sendErrWebSocketMessage(ctx, conn, msg.Id, err)
 				continue
 			}
+		case *websocketv1.Message_UploadFileChunk:
+			if err := s.handleUploadFileChunk(ctx, conn, &msg); err != nil {
+				s.sendErrWebSocketMessage(ctx, conn, msg.Id, err)
+				continue
+			}
+		case *websocketv1.Message_DownloadFile:
+			if err := s.handleDownloadFile(ctx, conn, &msg); err != nil {
+				s.sendErrWebSocketMessage(ctx, conn, msg.Id, err)
+				continue
+			}
+		case *websocketv1.Message_DownloadFileChunk:
+			if err := s.handleDownloadFileChunk(ctx, conn, &msg); err != nil {
+				s.sendErrWebSocketMessage(ctx, conn, msg.Id, err)
+				continue
+			}
+		case *websocketv1.Message_Toast:
+			if err := s.handleToast(ctx, conn, &msg); err != nil {
+				s.sendErrWebSocketMessage(ctx, conn, msg.Id, err)
+				continue
+			}
 		case *websocketv1.Message_ScriptFinished:
 			if err := s.handleScriptFinished(ctx, conn, &msg); err != nil {
 				s.sendErrWebSocketMessage(ctx, conn, msg.Id, err)
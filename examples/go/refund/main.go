@@ -5,6 +5,7 @@ import (
 	"log"
 
 	"github.com/trysourcetool/sourcetool-go"
+	"github.com/trysourcetool/sourcetool-go/dialog"
 	"github.com/trysourcetool/sourcetool-go/form"
 	"github.com/trysourcetool/sourcetool-go/numberinput"
 	"github.com/trysourcetool/sourcetool-go/table"
@@ -34,11 +35,23 @@ func refundPage(ui sourcetool.UIBuilder) error {
 	}
 
 	if selectedUser != nil {
-		formWidget, submitted := ui.Form("Refund", form.WithClearOnSubmit(true))
+		refund := ui.Button("Refund")
+
+		// The form lives in the dialog, so submitting it confirms the refund.
+		// Its fields are cleared once the refund has gone through.
+		dialogWidget, dismissed := ui.Dialog(
+			fmt.Sprintf("Refund %s (%s)", selectedUser.Name, selectedUser.Email),
+			dialog.WithOpen(refund),
+			dialog.WithCloseOnSubmit(true),
+		)
+		formWidget, submitted := dialogWidget.Form("Confirm refund", form.WithClearOnSubmit(true))
 		amount := formWidget.NumberInput("Amount", numberinput.WithMinValue(1), numberinput.WithRequired(true))
 		reason := formWidget.TextInput("Reason", textinput.WithPlaceholder("Enter refund reason"), textinput.WithRequired(true))
 
-		if submitted {
+		if dismissed {
+			ui.Markdown("Refund cancelled")
+		}
+		if submitted && amount != nil {
 			refundReq := RefundRequest{
 				UserID: selectedUser.ID,
 				Amount: int(*amount),
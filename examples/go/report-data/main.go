@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/trysourcetool/sourcetool-go"
+	"github.com/trysourcetool/sourcetool-go/daterangeinput"
 	"github.com/trysourcetool/sourcetool-go/form"
 	"github.com/trysourcetool/sourcetool-go/multiselect"
 	"github.com/trysourcetool/sourcetool-go/selectbox"
@@ -52,9 +53,13 @@ func createReportPage(ui sourcetool.UIBuilder) error {
 
 	// Analysis Parameters
 	form.Markdown("### 3. Analysis parameters")
-	paramCols := form.Columns(2)
-	startDate := paramCols[0].DateInput("Start Date")
-	endDate := paramCols[1].DateInput("End Date")
+	startDate, endDate := form.DateRangeInput("Period",
+		daterangeinput.WithPresets(
+			daterangeinput.LastDays(7),
+			daterangeinput.ThisMonth(),
+			daterangeinput.LastMonth(),
+		),
+	)
 
 	analysisTypes := []string{
 		string(AnalysisTimeSeries),
@@ -131,6 +136,10 @@ func createReportPage(ui sourcetool.UIBuilder) error {
 		ui.Markdown(fmt.Sprintf("Generated at: %s", report.GeneratedAt.Format(time.RFC1123)))
 
 		ui.Markdown("### Summary")
+		stats := ui.Columns(3)
+		stats[0].Metric("Rows", len(report.Data))
+		stats[1].Metric("Sections", len(report.Sections))
+		stats[2].Metric("Period", params.EndDate.Sub(params.StartDate))
 		ui.Markdown(report.Summary)
 
 		ui.Markdown("### Data")
@@ -23,6 +23,15 @@ import { WidgetSchema, type Widget } from '@/pb/ts/widget/v1/widget_pb';
 import { pagesStore } from '@/store/modules/pages';
 import type { WidgetType } from '@/store/modules/widgets';
 import { hostInstancesStore } from '@/store/modules/hostInstances';
+import { toast } from '@/hooks/use-toast';
+import {
+  receiveDownloadChunk,
+  setFileTransferConnection,
+} from '@/lib/fileTransfer';
+
+// getQuery returns the query string of the page, which the SDK exposes as
+// QueryParams.
+const getQuery = () => window.location.search.replace(/^\?/, '');
 
 const WebSocketBlock = ({ onDisable }: { onDisable: () => void }) => {
   const dispatch = useDispatch();
@@ -55,6 +64,8 @@ const WebSocketBlock = ({ onDisable }: { onDisable: () => void }) => {
     widgetsStore.selector.getWidgetEntities(state),
   );
   const widgetUpdateAt = useSelector((state) => state.widgets.updateAt);
+  const search = useLocation({ select: (location) => location.searchStr });
+  const currentSearch = useRef(search);
   const exception = useSelector((state) => state.pages.exception);
   const isHostInstancePingError = useSelector(
     (state) => state.hostInstances.isHostInstancePingError,
@@ -63,10 +74,13 @@ const WebSocketBlock = ({ onDisable }: { onDisable: () => void }) => {
   const { sendMessage, readyState } = useWebSocket<Uint8Array>(socketUrl, {
     onMessage: (event) => {
       event.data.arrayBuffer().then((arrayBuffer: ArrayBuffer) => {
-        const message = toJson(
-          MessageSchema,
-          fromBinary(MessageSchema, new Uint8Array(arrayBuffer)),
-        );
+        const decoded = fromBinary(MessageSchema, new Uint8Array(arrayBuffer));
+        // File chunks are handed over as bytes rather than base64 JSON.
+        if (decoded.type.case === 'downloadFileChunk') {
+          receiveDownloadChunk(decoded.type.value);
+          return;
+        }
+        const message = toJson(MessageSchema, decoded);
         console.table({ message });
         if (message.initializeClientCompleted) {
           isInitialLoading.current = false;
@@ -85,6 +99,14 @@ const WebSocketBlock = ({ onDisable }: { onDisable: () => void }) => {
         if (message.exception) {
           dispatch(pagesStore.actions.setException(message.exception));
         }
+        if (message.toast) {
+          toast({
+            title: message.toast.message,
+            variant:
+              message.toast.level === 'error' ? 'destructive' : 'default',
+            duration: message.toast.durationMs,
+          });
+        }
       });
     },
     shouldReconnect: (event) => {
@@ -96,6 +118,15 @@ const WebSocketBlock = ({ onDisable }: { onDisable: () => void }) => {
     },
   });
 
+  useEffect(() => {
+    setFileTransferConnection({
+      sendMessage,
+      getSessionId: () => currentSessionId.current,
+      getPageId: () => currentPageId.current,
+    });
+    return () => setFileTransferConnection(null);
+  }, [sendMessage]);
+
   const connectionStatus = {
     [ReadyState.CONNECTING]: ReadyState.CONNECTING,
     [ReadyState.OPEN]: ReadyState.OPEN,
@@ -183,6 +214,7 @@ const WebSocketBlock = ({ onDisable }: { onDisable: () => void }) => {
                   case: 'initializeClient',
                   value: {
                     pageId: pageId,
+                    query: getQuery(),
                   } satisfies InitializeClientJson,
                 },
               }),
@@ -210,6 +242,7 @@ const WebSocketBlock = ({ onDisable }: { onDisable: () => void }) => {
                     sessionId: currentSessionId.current,
                     pageId: pageId,
                     states: [],
+                    query: getQuery(),
                   } satisfies RerunPageJson,
                 },
               }),
@@ -266,6 +299,7 @@ const WebSocketBlock = ({ onDisable }: { onDisable: () => void }) => {
               pageId: currentPageId.current,
               sessionId: currentSessionId.current,
               states: states,
+              query: getQuery(),
             },
           },
         }),
@@ -279,6 +313,22 @@ const WebSocketBlock = ({ onDisable }: { onDisable: () => void }) => {
     }
   }, [widgetUpdateAt]);
 
+  // A PageLink to the page being shown only changes the query, so the page
+  // is rerun with the new one. Moving to another page is handled above.
+  useEffect(() => {
+    if (currentSearch.current === search) {
+      return;
+    }
+    currentSearch.current = search;
+    if (
+      pageId &&
+      pageId === currentPageId.current &&
+      currentSessionId.current
+    ) {
+      handleRerunPage();
+    }
+  }, [search]);
+
   useEffect(() => {
     (async () => {
       if (!pageId || !currentPageId.current) {
@@ -319,6 +369,7 @@ const WebSocketBlock = ({ onDisable }: { onDisable: () => void }) => {
                   value: {
                     pageId: pageId,
                     sessionId: currentSessionId.current,
+                    query: getQuery(),
                   } satisfies InitializeClientJson,
                 },
               }),
@@ -0,0 +1,189 @@
+import { create, toBinary } from '@bufbuild/protobuf';
+import {
+  MessageSchema,
+  type DownloadFileChunk,
+} from '@/pb/ts/websocket/v1/message_pb';
+import { v4 as uuidv4 } from 'uuid';
+
+// Files are sent in chunks well below the 512KB WebSocket message limit of
+// the server, leaving room for the rest of the message.
+export const FILE_CHUNK_SIZE = 256 * 1024;
+
+type FileTransferConnection = {
+  sendMessage: (message: Uint8Array) => void;
+  getSessionId: () => string;
+  getPageId: () => string;
+};
+
+// The WebSocket lives in the WebSocketController, which registers itself
+// here so that widgets can send files without owning the connection.
+let connection: FileTransferConnection | null = null;
+
+export type DownloadedFile = {
+  blob: Blob;
+  fileName: string;
+  mimeType: string;
+};
+
+type PendingDownload = {
+  promise: Promise<DownloadedFile>;
+  resolve: (file: DownloadedFile) => void;
+  reject: (error: Error) => void;
+  chunks: BlobPart[];
+  received: number;
+};
+
+// Downloads in flight, by widget ID.
+const downloads = new Map<string, PendingDownload>();
+
+export const setFileTransferConnection = (
+  value: FileTransferConnection | null,
+) => {
+  connection = value;
+  if (!value) {
+    downloads.forEach((download) =>
+      download.reject(new Error('WebSocket is not connected')),
+    );
+    downloads.clear();
+  }
+};
+
+export const uploadFile = async ({
+  widgetId,
+  file,
+  onProgress,
+}: {
+  widgetId: string;
+  file: File;
+  onProgress?: (sent: number) => void;
+}) => {
+  if (!connection) {
+    throw new Error('WebSocket is not connected');
+  }
+  const { sendMessage, getSessionId, getPageId } = connection;
+
+  const fileId = uuidv4();
+  // Empty files still send one chunk so that the SDK sees the upload.
+  let offset = 0;
+  do {
+    const chunk = file.slice(offset, offset + FILE_CHUNK_SIZE);
+    const data = new Uint8Array(await chunk.arrayBuffer());
+    sendMessage(
+      toBinary(
+        MessageSchema,
+        create(MessageSchema, {
+          id: uuidv4(),
+          type: {
+            case: 'uploadFileChunk',
+            value: {
+              sessionId: getSessionId(),
+              pageId: getPageId(),
+              widgetId,
+              fileId,
+              name: file.name,
+              mimeType: file.type,
+              size: BigInt(file.size),
+              offset: BigInt(offset),
+              data,
+            },
+          },
+        }),
+      ),
+    );
+    offset += data.length;
+    onProgress?.(offset);
+  } while (offset < file.size);
+
+  return {
+    id: fileId,
+    name: file.name,
+    mimeType: file.type,
+    size: file.size.toString(),
+  };
+};
+
+// requestDownload asks the SDK for the data of a download button or image
+// and resolves once every chunk has arrived. Concurrent requests for the same
+// widget share one transfer.
+export const requestDownload = (widgetId: string) => {
+  const pending = downloads.get(widgetId);
+  if (pending) {
+    return pending.promise;
+  }
+  if (!connection) {
+    return Promise.reject(new Error('WebSocket is not connected'));
+  }
+
+  let resolve!: PendingDownload['resolve'];
+  let reject!: PendingDownload['reject'];
+  const promise = new Promise<DownloadedFile>((res, rej) => {
+    resolve = res;
+    reject = rej;
+  });
+  downloads.set(widgetId, {
+    promise,
+    resolve,
+    reject,
+    chunks: [],
+    received: 0,
+  });
+
+  connection.sendMessage(
+    toBinary(
+      MessageSchema,
+      create(MessageSchema, {
+        id: uuidv4(),
+        type: {
+          case: 'downloadFile',
+          value: {
+            sessionId: connection.getSessionId(),
+            pageId: connection.getPageId(),
+            widgetId,
+          },
+        },
+      }),
+    ),
+  );
+
+  return promise;
+};
+
+export const receiveDownloadChunk = (chunk: DownloadFileChunk) => {
+  const download = downloads.get(chunk.widgetId);
+  if (!download) {
+    return;
+  }
+  if (Number(chunk.offset) !== download.received) {
+    downloads.delete(chunk.widgetId);
+    download.reject(
+      new Error(
+        `Unexpected chunk offset ${chunk.offset}, want ${download.received}`,
+      ),
+    );
+    return;
+  }
+
+  download.chunks.push(new Uint8Array(chunk.data));
+  download.received += chunk.data.length;
+  if (download.received < Number(chunk.size)) {
+    return;
+  }
+
+  downloads.delete(chunk.widgetId);
+  download.resolve({
+    blob: new Blob(download.chunks, { type: chunk.mimeType }),
+    fileName: chunk.fileName,
+    mimeType: chunk.mimeType,
+  });
+};
+
+export const saveFile = ({ blob, fileName }: DownloadedFile) => {
+  const url = URL.createObjectURL(blob);
+  const a = document.createElement('a');
+  a.href = url;
+  a.download = fileName;
+  document.body.appendChild(a);
+  a.click();
+  a.remove();
+  URL.revokeObjectURL(url);
+};
@@ -4,3 +4,19 @@ import { twMerge } from 'tailwind-merge';
 export function cn(...inputs: ClassValue[]) {
   return twMerge(clsx(inputs));
 }
+
+// parseJsonBytes parses a protobuf bytes field holding UTF-8 JSON, which
+// arrives base64 encoded in the JSON form of a message.
+export function parseJsonBytes(value?: string): unknown {
+  if (!value) {
+    return null;
+  }
+  const bytes = Uint8Array.from(atob(value), (c) => c.charCodeAt(0));
+  return JSON.parse(new TextDecoder().decode(bytes));
+}
+
+// toNumber reads a protobuf double from the JSON form of a message, where
+// zero is omitted and non-finite values arrive as strings.
+export function toNumber(value?: number | string, fallback = 0): number {
+  return typeof value === 'number' && Number.isFinite(value) ? value : fallback;
+}
@@ -90,6 +90,18 @@ export const createWidgetState = (widget: WidgetJson): WidgetState | null => {
     };
   }
 
+  if (widget.dateRangeInput) {
+    return {
+      id: widget.id,
+      type: 'dateRangeInput',
+      value: {
+        startValue: widget.dateRangeInput.startValue,
+        endValue: widget.dateRangeInput.endValue,
+      },
+      error: null,
+    };
+  }
+
   if (widget.timeInput) {
     return {
       id: widget.id,
@@ -108,6 +120,42 @@ export const createWidgetState = (widget: WidgetJson): WidgetState | null => {
     };
   }
 
+  if (widget.codeEditor) {
+    return {
+      id: widget.id,
+      type: 'codeEditor',
+      value: widget.codeEditor.value ?? undefined,
+      error: null,
+    };
+  }
+
+  if (widget.tagInput) {
+    return {
+      id: widget.id,
+      type: 'tagInput',
+      value: widget.tagInput.value ?? [],
+      error: null,
+    };
+  }
+
+  if (widget.colorInput) {
+    return {
+      id: widget.id,
+      type: 'colorInput',
+      value: widget.colorInput.value ?? undefined,
+      error: null,
+    };
+  }
+
+  if (widget.fileInput) {
+    return {
+      id: widget.id,
+      type: 'fileInput',
+      value: widget.fileInput.value ?? [],
+      error: null,
+    };
+  }
+
   if (widget.table) {
     return {
       id: widget.id,
@@ -273,6 +321,46 @@ export const validateWidgetValue = <
     };
   }
 
+  // ==============================
+  // dateRangeInput
+
+  if (widget.dateRangeInput && widgetType === 'dateRangeInput') {
+    const schema = z
+      .object({
+        startValue: z.string().optional(),
+        endValue: z.string().optional(),
+      })
+      .superRefine(({ startValue, endValue }, ctx) => {
+        if (widget.dateRangeInput?.required && (!startValue || !endValue)) {
+          ctx.addIssue({
+            code: 'custom',
+            message: 'This field is required',
+          });
+        }
+
+        const minValue = widget.dateRangeInput?.minValue;
+        if (minValue && startValue && startValue < minValue) {
+          ctx.addIssue({
+            code: 'custom',
+            message: `Min is ${minValue}`,
+          });
+        }
+
+        const maxValue = widget.dateRangeInput?.maxValue;
+        if (maxValue && endValue && endValue > maxValue) {
+          ctx.addIssue({
+            code: 'custom',
+            message: `Max is ${maxValue}`,
+          });
+        }
+      });
+
+    return {
+      success: schema.safeParse(value).success,
+      error: schema.safeParse(value).error?.issues?.[0]?.message || null,
+    };
+  }
+
   // ==============================
   // timeInput
 
@@ -317,6 +405,38 @@ export const validateWidgetValue = <
     };
   }
 
+  // ==============================
+  // codeEditor
+  if (widget.codeEditor && widgetType === 'codeEditor') {
+    const schema = z
+      .string()
+      .optional()
+      .refine((value) => (widget.codeEditor?.required ? !!value : true), {
+        message: 'This field is required',
+      });
+
+    return {
+      success: schema.safeParse(value).success,
+      error: schema.safeParse(value).error?.issues?.[0]?.message || null,
+    };
+  }
+
+  // ==============================
+  // colorInput
+  if (widget.colorInput && widgetType === 'colorInput') {
+    const schema = z
+      .string()
+      .optional()
+      .refine((value) => (widget.colorInput?.required ? !!value : true), {
+        message: 'This field is required',
+      });
+
+    return {
+      success: schema.safeParse(value).success,
+      error: schema.safeParse(value).error?.issues?.[0]?.message || null,
+    };
+  }
+
   // ==============================
   // checkbox
   if (widget.checkbox && widgetType === 'checkbox') {
@@ -404,6 +524,46 @@ export const validateWidgetValue = <
     };
   }
 
+  // ==============================
+  // tagInput
+  if (widget.tagInput && widgetType === 'tagInput') {
+    const schema = z
+      .array(z.string())
+      .optional()
+      .refine(
+        (value) =>
+          widget.tagInput?.required ? (value?.length ?? 0) > 0 : true,
+        {
+          message: 'This field is required',
+        },
+      );
+
+    return {
+      success: schema.safeParse(value).success,
+      error: schema.safeParse(value).error?.issues?.[0]?.message || null,
+    };
+  }
+
+  // ==============================
+  // fileInput
+  if (widget.fileInput && widgetType === 'fileInput') {
+    const schema = z
+      .array(z.object({ id: z.string().optional() }))
+      .optional()
+      .refine(
+        (value) =>
+          widget.fileInput?.required ? (value?.length ?? 0) > 0 : true,
+        {
+          message: 'This field is required',
+        },
+      );
+
+    return {
+      success: schema.safeParse(value).success,
+      error: schema.safeParse(value).error?.issues?.[0]?.message || null,
+    };
+  }
+
   return {
     success: true,
     error: null,
@@ -16,7 +16,7 @@ import type { Message as Message$1 } from "@bufbuild/protobuf";
  * Describes the file websocket/v1/message.proto.
  */
 export const file_websocket_v1_message: GenFile = /*@__PURE__*/
-  fileDesc("Chp3ZWJzb2NrZXQvdjEvbWVzc2FnZS5wcm90bxIMd2Vic29ja2V0LnYxIq8ECgdNZXNzYWdlEgoKAmlkGAEgASgJEiwKCWV4Y2VwdGlvbhgCIAEoCzIXLmV4Y2VwdGlvbi52MS5FeGNlcHRpb25IABI3Cg9pbml0aWFsaXplX2hvc3QYAyABKAsyHC53ZWJzb2NrZXQudjEuSW5pdGlhbGl6ZUhvc3RIABJKChlpbml0aWFsaXplX2hvc3RfY29tcGxldGVkGAQgASgLMiUud2Vic29ja2V0LnYxLkluaXRpYWxpemVIb3N0Q29tcGxldGVkSAASOwoRaW5pdGlhbGl6ZV9jbGllbnQYBSABKAsyHi53ZWJzb2NrZXQudjEuSW5pdGlhbGl6ZUNsaWVudEgAEk4KG2luaXRpYWxpemVfY2xpZW50X2NvbXBsZXRlZBgGIAEoCzInLndlYnNvY2tldC52MS5Jbml0aWFsaXplQ2xpZW50Q29tcGxldGVkSAASMwoNcmVuZGVyX3dpZGdldBgHIAEoCzIaLndlYnNvY2tldC52MS5SZW5kZXJXaWRnZXRIABItCgpyZXJ1bl9wYWdlGAggASgLMhcud2Vic29ja2V0LnYxLlJlcnVuUGFnZUgAEjMKDWNsb3NlX3Nlc3Npb24YCSABKAsyGi53ZWJzb2NrZXQudjEuQ2xvc2VTZXNzaW9uSAASNwoPc2NyaXB0X2ZpbmlzaGVkGAogASgLMhwud2Vic29ja2V0LnYxLlNjcmlwdEZpbmlzaGVkSABCBgoEdHlwZSJmCg5Jbml0aWFsaXplSG9zdBIPCgdhcGlfa2V5GAEgASgJEhAKCHNka19uYW1lGAIgASgJEhMKC3Nka192ZXJzaW9uGAMgASgJEhwKBXBhZ2VzGAQgAygLMg0ucGFnZS52MS5QYWdlIjMKF0luaXRpYWxpemVIb3N0Q29tcGxldGVkEhgKEGhvc3RfaW5zdGFuY2VfaWQYASABKAkiSwoQSW5pdGlhbGl6ZUNsaWVudBIXCgpzZXNzaW9uX2lkGAEgASgJSACIAQESDwoHcGFnZV9pZBgCIAEoCUINCgtfc2Vzc2lvbl9pZCIvChlJbml0aWFsaXplQ2xpZW50Q29tcGxldGVkEhIKCnNlc3Npb25faWQYASABKAkiZAoMUmVuZGVyV2lkZ2V0EhIKCnNlc3Npb25faWQYASABKAkSDwoHcGFnZV9pZBgCIAEoCRIMCgRwYXRoGAMgAygFEiEKBndpZGdldBgEIAEoCzIRLndpZGdldC52MS5XaWRnZXQiUwoJUmVydW5QYWdlEhIKCnNlc3Npb25faWQYASABKAkSDwoHcGFnZV9pZBgCIAEoCRIhCgZzdGF0ZXMYAyADKAsyES53aWRnZXQudjEuV2lkZ2V0IiIKDENsb3NlU2Vzc2lvbhISCgpzZXNzaW9uX2lkGAEgASgJIqMBCg5TY3JpcHRGaW5pc2hlZBISCgpzZXNzaW9uX2lkGAEgASgJEjMKBnN0YXR1cxgCIAEoDjIjLndlYnNvY2tldC52MS5TY3JpcHRGaW5pc2hlZC5TdGF0dXMiSAoGU3RhdHVzEhYKElNUQVRVU19VTlNQRUNJRklFRBAAEhIKDlNUQVRVU19TVUNDRVNTEAESEgoOU1RBVFVTX0ZBSUxVUkUQAkJxChBjb20ud2Vic29ja2V0LnYxQgxNZXNzYWdlUHJvdG9QAaICA1dYWKoCDFdlYnNvY2tldC5WMcoCDFdlYnNvY2tldFxWMeICGFdlYnNvY2tldFxWMVxHUEJNZXRhZGF0YeoCDVdlYnNvY2tldDo6VjFiBnByb3RvMw", [file_exception_v1_exception, file_page_v1_page, file_widget_v1_widget]);
+  fileDesc("Chp3ZWJzb2NrZXQvdjEvbWVzc2FnZS5wcm90bxIMd2Vic29ja2V0LnYxIoYGCgdNZXNzYWdlEgoKAmlkGAEgASgJEiwKCWV4Y2VwdGlvbhgCIAEoCzIXLmV4Y2VwdGlvbi52MS5FeGNlcHRpb25IABI3Cg9pbml0aWFsaXplX2hvc3QYAyABKAsyHC53ZWJzb2NrZXQudjEuSW5pdGlhbGl6ZUhvc3RIABJKChlpbml0aWFsaXplX2hvc3RfY29tcGxldGVkGAQgASgLMiUud2Vic29ja2V0LnYxLkluaXRpYWxpemVIb3N0Q29tcGxldGVkSAASOwoRaW5pdGlhbGl6ZV9jbGllbnQYBSABKAsyHi53ZWJzb2NrZXQudjEuSW5pdGlhbGl6ZUNsaWVudEgAEk4KG2luaXRpYWxpemVfY2xpZW50X2NvbXBsZXRlZBgGIAEoCzInLndlYnNvY2tldC52MS5Jbml0aWFsaXplQ2xpZW50Q29tcGxldGVkSAASMwoNcmVuZGVyX3dpZGdldBgHIAEoCzIaLndlYnNvY2tldC52MS5SZW5kZXJXaWRnZXRIABItCgpyZXJ1bl9wYWdlGAggASgLMhcud2Vic29ja2V0LnYxLlJlcnVuUGFnZUgAEjMKDWNsb3NlX3Nlc3Npb24YCSABKAsyGi53ZWJzb2NrZXQudjEuQ2xvc2VTZXNzaW9uSAASNwoPc2NyaXB0X2ZpbmlzaGVkGAogASgLMhwud2Vic29ja2V0LnYxLlNjcmlwdEZpbmlzaGVkSAASOgoRdXBsb2FkX2ZpbGVfY2h1bmsYCyABKAsyHS53ZWJzb2NrZXQudjEuVXBsb2FkRmlsZUNodW5rSAASMwoNZG93bmxvYWRfZmlsZRgMIAEoCzIaLndlYnNvY2tldC52MS5Eb3dubG9hZEZpbGVIABI+ChNkb3dubG9hZF9maWxlX2NodW5rGA0gASgLMh8ud2Vic29ja2V0LnYxLkRvd25sb2FkRmlsZUNodW5rSAASJAoFdG9hc3QYDiABKAsyEy53ZWJzb2NrZXQudjEuVG9hc3RIAEIGCgR0eXBlImYKDkluaXRpYWxpemVIb3N0Eg8KB2FwaV9rZXkYASABKAkSEAoIc2RrX25hbWUYAiABKAkSEwoLc2RrX3ZlcnNpb24YAyABKAkSHAoFcGFnZXMYBCADKAsyDS5wYWdlLnYxLlBhZ2UiMwoXSW5pdGlhbGl6ZUhvc3RDb21wbGV0ZWQSGAoQaG9zdF9pbnN0YW5jZV9pZBgBIAEoCSJaChBJbml0aWFsaXplQ2xpZW50EhcKCnNlc3Npb25faWQYASABKAlIAIgBARIPCgdwYWdlX2lkGAIgASgJEg0KBXF1ZXJ5GAMgASgJQg0KC19zZXNzaW9uX2lkIi8KGUluaXRpYWxpemVDbGllbnRDb21wbGV0ZWQSEgoKc2Vzc2lvbl9pZBgBIAEoCSJkCgxSZW5kZXJXaWRnZXQSEgoKc2Vzc2lvbl9pZBgBIAEoCRIPCgdwYWdlX2lkGAIgASgJEgwKBHBhdGgYAyADKAUSIQoGd2lkZ2V0GAQgASgLMhEud2lkZ2V0LnYxLldpZGdldCJiCglSZXJ1blBhZ2USEgoKc2Vzc2lvbl9pZBgBIAEoCRIPCgdwYWdlX2lkGAIgASgJEiEKBnN0YXRlcxgDIAMoCzIRLndpZGdldC52MS5XaWRnZXQSDQoFcXVlcnkYBCABKAkiIgoMQ2xvc2VTZXNzaW9uEhIKCnNlc3Npb25faWQYASABKAkiowEKDlNjcmlwdEZpbmlzaGVkEhIKCnNlc3Npb25faWQYASABKAkSMwoGc3RhdHVzGAIgASgOMiMud2Vic29ja2V0LnYxLlNjcmlwdEZpbmlzaGVkLlN0YXR1cyJICgZTdGF0dXMSFgoSU1RBVFVTX1VOU1BFQ0lGSUVEEAASEgoOU1RBVFVTX1NVQ0NFU1MQARISCg5TVEFUVVNfRkFJTFVSRRACIqcBCg9VcGxvYWRGaWxlQ2h1bmsSEgoKc2Vzc2lvbl9pZBgBIAEoCRIPCgdwYWdlX2lkGAIgASgJEhEKCXdpZGdldF9pZBgDIAEoCRIPCgdmaWxlX2lkGAQgASgJEgwKBG5hbWUYBSABKAkSEQoJbWltZV90eXBlGAYgASgJEgwKBHNpemUYByABKAMSDgoGb2Zmc2V0GAggASgDEgwKBGRhdGEYCSABKAwiRgoMRG93bmxvYWRGaWxlEhIKCnNlc3Npb25faWQYASABKAkSDwoHcGFnZV9pZBgCIAEoCRIRCgl3aWRnZXRfaWQYAyABKAkinQEKEURvd25sb2FkRmlsZUNodW5rEhIKCnNlc3Npb25faWQYASABKAkSDwoHcGFnZV9pZBgCIAEoCRIRCgl3aWRnZXRfaWQYAyABKAkSEQoJZmlsZV9uYW1lGAQgASgJEhEKCW1pbWVfdHlwZRgFIAEoCRIMCgRzaXplGAYgASgDEg4KBm9mZnNldBgHIAEoAxIMCgRkYXRhGAggASgMInYKBVRvYXN0EhIKCnNlc3Npb25faWQYASABKAkSDwoHcGFnZV9pZBgCIAEoCRIPCgdtZXNzYWdlGAMgASgJEg0KBWxldmVsGAQgASgJEhgKC2R1cmF0aW9uX21zGAUgASgFSACIAQFCDgoMX2R1cmF0aW9uX21zQnEKEGNvbS53ZWJzb2NrZXQudjFCDE1lc3NhZ2VQcm90b1ABogIDV1hYqgIMV2Vic29ja2V0LlYxygIMV2Vic29ja2V0XFYx4gIYV2Vic29ja2V0XFYxXEdQQk1ldGFkYXRh6gINV2Vic29ja2V0OjpWMWIGcHJvdG8z", [file_exception_v1_exception, file_page_v1_page, file_widget_v1_widget]);
 
 /**
  * @generated from message websocket.v1.Message
@@ -84,6 +84,30 @@ export type Message = Message$1<"websocket.v1.Message"> & {
      */
     value: ScriptFinished;
     case: "scriptFinished";
+  } | {
+    /**
+     * @generated from field: websocket.v1.UploadFileChunk upload_file_chunk = 11;
+     */
+    value: UploadFileChunk;
+    case: "uploadFileChunk";
+  } | {
+    /**
+     * @generated from field: websocket.v1.DownloadFile download_file = 12;
+     */
+    value: DownloadFile;
+    case: "downloadFile";
+  } | {
+    /**
+     * @generated from field: websocket.v1.DownloadFileChunk download_file_chunk = 13;
+     */
+    value: DownloadFileChunk;
+    case: "downloadFileChunk";
+  } | {
+    /**
+     * @generated from field: websocket.v1.Toast toast = 14;
+     */
+    value: Toast;
+    case: "toast";
   } | { case: undefined; value?: undefined };
 };
 
@@ -140,6 +164,26 @@ export type MessageJson = {
    * @generated from field: websocket.v1.ScriptFinished script_finished = 10;
    */
   scriptFinished?: ScriptFinishedJson;
+
+  /**
+   * @generated from field: websocket.v1.UploadFileChunk upload_file_chunk = 11;
+   */
+  uploadFileChunk?: UploadFileChunkJson;
+
+  /**
+   * @generated from field: websocket.v1.DownloadFile download_file = 12;
+   */
+  downloadFile?: DownloadFileJson;
+
+  /**
+   * @generated from field: websocket.v1.DownloadFileChunk download_file_chunk = 13;
+   */
+  downloadFileChunk?: DownloadFileChunkJson;
+
+  /**
+   * @generated from field: websocket.v1.Toast toast = 14;
+   */
+  toast?: ToastJson;
 };
 
 /**
@@ -246,6 +290,11 @@ export type InitializeClient = Message$1<"websocket.v1.InitializeClient"> & {
    * @generated from field: string page_id = 2;
    */
   pageId: string;
+
+  /**
+   * @generated from field: string query = 3;
+   */
+  query: string;
 };
 
 /**
@@ -261,6 +310,11 @@ export type InitializeClientJson = {
    * @generated from field: string page_id = 2;
    */
   pageId?: string;
+
+  /**
+   * @generated from field: string query = 3;
+   */
+  query?: string;
 };
 
 /**
@@ -372,6 +426,11 @@ export type RerunPage = Message$1<"websocket.v1.RerunPage"> & {
    * @generated from field: repeated widget.v1.Widget states = 3;
    */
   states: Widget[];
+
+  /**
+   * @generated from field: string query = 4;
+   */
+  query: string;
 };
 
 /**
@@ -392,6 +451,11 @@ export type RerunPageJson = {
    * @generated from field: repeated widget.v1.Widget states = 3;
    */
   states?: WidgetJson[];
+
+  /**
+   * @generated from field: string query = 4;
+   */
+  query?: string;
 };
 
 /**
@@ -496,3 +560,321 @@ export type ScriptFinished_StatusJson = "STATUS_UNSPECIFIED" | "STATUS_SUCCESS"
 export const ScriptFinished_StatusSchema: GenEnum<ScriptFinished_Status, ScriptFinished_StatusJson> = /*@__PURE__*/
   enumDesc(file_websocket_v1_message, 8, 0);
 
+/**
+ * @generated from message websocket.v1.UploadFileChunk
+ */
+export type UploadFileChunk = Message$1<"websocket.v1.UploadFileChunk"> & {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId: string;
+
+  /**
+   * @generated from field: string page_id = 2;
+   */
+  pageId: string;
+
+  /**
+   * @generated from field: string widget_id = 3;
+   */
+  widgetId: string;
+
+  /**
+   * @generated from field: string file_id = 4;
+   */
+  fileId: string;
+
+  /**
+   * @generated from field: string name = 5;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string mime_type = 6;
+   */
+  mimeType: string;
+
+  /**
+   * @generated from field: int64 size = 7;
+   */
+  size: bigint;
+
+  /**
+   * @generated from field: int64 offset = 8;
+   */
+  offset: bigint;
+
+  /**
+   * @generated from field: bytes data = 9;
+   */
+  data: Uint8Array;
+};
+
+/**
+ * JSON type for the message websocket.v1.UploadFileChunk.
+ */
+export type UploadFileChunkJson = {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId?: string;
+
+  /**
+   * @generated from field: string page_id = 2;
+   */
+  pageId?: string;
+
+  /**
+   * @generated from field: string widget_id = 3;
+   */
+  widgetId?: string;
+
+  /**
+   * @generated from field: string file_id = 4;
+   */
+  fileId?: string;
+
+  /**
+   * @generated from field: string name = 5;
+   */
+  name?: string;
+
+  /**
+   * @generated from field: string mime_type = 6;
+   */
+  mimeType?: string;
+
+  /**
+   * @generated from field: int64 size = 7;
+   */
+  size?: string;
+
+  /**
+   * @generated from field: int64 offset = 8;
+   */
+  offset?: string;
+
+  /**
+   * @generated from field: bytes data = 9;
+   */
+  data?: string;
+};
+
+/**
+ * Describes the message websocket.v1.UploadFileChunk.
+ * Use `create(UploadFileChunkSchema)` to create a new message.
+ */
+export const UploadFileChunkSchema: GenMessage<UploadFileChunk, UploadFileChunkJson> = /*@__PURE__*/
+  messageDesc(file_websocket_v1_message, 9);
+
+/**
+ * @generated from message websocket.v1.DownloadFile
+ */
+export type DownloadFile = Message$1<"websocket.v1.DownloadFile"> & {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId: string;
+
+  /**
+   * @generated from field: string page_id = 2;
+   */
+  pageId: string;
+
+  /**
+   * @generated from field: string widget_id = 3;
+   */
+  widgetId: string;
+};
+
+/**
+ * JSON type for the message websocket.v1.DownloadFile.
+ */
+export type DownloadFileJson = {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId?: string;
+
+  /**
+   * @generated from field: string page_id = 2;
+   */
+  pageId?: string;
+
+  /**
+   * @generated from field: string widget_id = 3;
+   */
+  widgetId?: string;
+};
+
+/**
+ * Describes the message websocket.v1.DownloadFile.
+ * Use `create(DownloadFileSchema)` to create a new message.
+ */
+export const DownloadFileSchema: GenMessage<DownloadFile, DownloadFileJson> = /*@__PURE__*/
+  messageDesc(file_websocket_v1_message, 10);
+
+/**
+ * @generated from message websocket.v1.DownloadFileChunk
+ */
+export type DownloadFileChunk = Message$1<"websocket.v1.DownloadFileChunk"> & {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId: string;
+
+  /**
+   * @generated from field: string page_id = 2;
+   */
+  pageId: string;
+
+  /**
+   * @generated from field: string widget_id = 3;
+   */
+  widgetId: string;
+
+  /**
+   * @generated from field: string file_name = 4;
+   */
+  fileName: string;
+
+  /**
+   * @generated from field: string mime_type = 5;
+   */
+  mimeType: string;
+
+  /**
+   * @generated from field: int64 size = 6;
+   */
+  size: bigint;
+
+  /**
+   * @generated from field: int64 offset = 7;
+   */
+  offset: bigint;
+
+  /**
+   * @generated from field: bytes data = 8;
+   */
+  data: Uint8Array;
+};
+
+/**
+ * JSON type for the message websocket.v1.DownloadFileChunk.
+ */
+export type DownloadFileChunkJson = {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId?: string;
+
+  /**
+   * @generated from field: string page_id = 2;
+   */
+  pageId?: string;
+
+  /**
+   * @generated from field: string widget_id = 3;
+   */
+  widgetId?: string;
+
+  /**
+   * @generated from field: string file_name = 4;
+   */
+  fileName?: string;
+
+  /**
+   * @generated from field: string mime_type = 5;
+   */
+  mimeType?: string;
+
+  /**
+   * @generated from field: int64 size = 6;
+   */
+  size?: string;
+
+  /**
+   * @generated from field: int64 offset = 7;
+   */
+  offset?: string;
+
+  /**
+   * @generated from field: bytes data = 8;
+   */
+  data?: string;
+};
+
+/**
+ * Describes the message websocket.v1.DownloadFileChunk.
+ * Use `create(DownloadFileChunkSchema)` to create a new message.
+ */
+export const DownloadFileChunkSchema: GenMessage<DownloadFileChunk, DownloadFileChunkJson> = /*@__PURE__*/
+  messageDesc(file_websocket_v1_message, 11);
+
+/**
+ * @generated from message websocket.v1.Toast
+ */
+export type Toast = Message$1<"websocket.v1.Toast"> & {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId: string;
+
+  /**
+   * @generated from field: string page_id = 2;
+   */
+  pageId: string;
+
+  /**
+   * @generated from field: string message = 3;
+   */
+  message: string;
+
+  /**
+   * @generated from field: string level = 4;
+   */
+  level: string;
+
+  /**
+   * @generated from field: optional int32 duration_ms = 5;
+   */
+  durationMs?: number;
+};
+
+/**
+ * JSON type for the message websocket.v1.Toast.
+ */
+export type ToastJson = {
+  /**
+   * @generated from field: string session_id = 1;
+   */
+  sessionId?: string;
+
+  /**
+   * @generated from field: string page_id = 2;
+   */
+  pageId?: string;
+
+  /**
+   * @generated from field: string message = 3;
+   */
+  message?: string;
+
+  /**
+   * @generated from field: string level = 4;
+   */
+  level?: string;
+
+  /**
+   * @generated from field: optional int32 duration_ms = 5;
+   */
+  durationMs?: number;
+};
+
+/**
+ * Describes the message websocket.v1.Toast.
+ * Use `create(ToastSchema)` to create a new message.
+ */
+export const ToastSchema: GenMessage<Toast, ToastJson> = /*@__PURE__*/
+  messageDesc(file_websocket_v1_message, 12);
+
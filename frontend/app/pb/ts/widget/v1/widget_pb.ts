@@ -10,7 +10,44 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file widget/v1/widget.proto.
  */
 export const file_widget_v1_widget: GenFile = /*@__PURE__*/
-  fileDesc("ChZ3aWRnZXQvdjEvd2lkZ2V0LnByb3RvEgl3aWRnZXQudjEiOAoGQnV0dG9uEg0KBXZhbHVlGAEgASgIEg0KBWxhYmVsGAIgASgJEhAKCGRpc2FibGVkGAMgASgIImMKCENoZWNrYm94Eg0KBXZhbHVlGAEgASgIEg0KBWxhYmVsGAIgASgJEhUKDWRlZmF1bHRfdmFsdWUYAyABKAgSEAoIcmVxdWlyZWQYBCABKAgSEAoIZGlzYWJsZWQYBSABKAgieQoNQ2hlY2tib3hHcm91cBINCgV2YWx1ZRgBIAMoBRINCgVsYWJlbBgCIAEoCRIPCgdvcHRpb25zGAMgAygJEhUKDWRlZmF1bHRfdmFsdWUYBCADKAUSEAoIcmVxdWlyZWQYBSABKAgSEAoIZGlzYWJsZWQYBiABKAgiHAoKQ29sdW1uSXRlbRIOCgZ3ZWlnaHQYASABKAEiGgoHQ29sdW1ucxIPCgdjb2x1bW5zGAEgASgFItUBCglEYXRlSW5wdXQSEgoFdmFsdWUYASABKAlIAIgBARINCgVsYWJlbBgCIAEoCRITCgtwbGFjZWhvbGRlchgDIAEoCRIaCg1kZWZhdWx0X3ZhbHVlGAQgASgJSAGIAQESEAoIcmVxdWlyZWQYBSABKAgSEAoIZGlzYWJsZWQYBiABKAgSDgoGZm9ybWF0GAcgASgJEhEKCW1heF92YWx1ZRgIIAEoCRIRCgltaW5fdmFsdWUYCSABKAlCCAoGX3ZhbHVlQhAKDl9kZWZhdWx0X3ZhbHVlItkBCg1EYXRlVGltZUlucHV0EhIKBXZhbHVlGAEgASgJSACIAQESDQoFbGFiZWwYAiABKAkSEwoLcGxhY2Vob2xkZXIYAyABKAkSGgoNZGVmYXVsdF92YWx1ZRgEIAEoCUgBiAEBEhAKCHJlcXVpcmVkGAUgASgIEhAKCGRpc2FibGVkGAYgASgIEg4KBmZvcm1hdBgHIAEoCRIRCgltYXhfdmFsdWUYCCABKAkSEQoJbWluX3ZhbHVlGAkgASgJQggKBl92YWx1ZUIQCg5fZGVmYXVsdF92YWx1ZSJdCgRGb3JtEg0KBXZhbHVlGAEgASgIEhQKDGJ1dHRvbl9sYWJlbBgCIAEoCRIXCg9idXR0b25fZGlzYWJsZWQYAyABKAgSFwoPY2xlYXJfb25fc3VibWl0GAQgASgIIhgKCE1hcmtkb3duEgwKBGJvZHkYASABKAkijAEKC011bHRpU2VsZWN0Eg0KBXZhbHVlGAEgAygFEg0KBWxhYmVsGAIgASgJEg8KB29wdGlvbnMYAyADKAkSEwoLcGxhY2Vob2xkZXIYBCABKAkSFQoNZGVmYXVsdF92YWx1ZRgFIAMoBRIQCghyZXF1aXJlZBgGIAEoCBIQCghkaXNhYmxlZBgHIAEoCCLtAQoLTnVtYmVySW5wdXQSEgoFdmFsdWUYASABKAFIAIgBARINCgVsYWJlbBgCIAEoCRITCgtwbGFjZWhvbGRlchgDIAEoCRIaCg1kZWZhdWx0X3ZhbHVlGAQgASgBSAGIAQESEAoIcmVxdWlyZWQYBSABKAgSEAoIZGlzYWJsZWQYBiABKAgSFgoJbWF4X3ZhbHVlGAcgASgBSAKIAQESFgoJbWluX3ZhbHVlGAggASgBSAOIAQFCCAoGX3ZhbHVlQhAKDl9kZWZhdWx0X3ZhbHVlQgwKCl9tYXhfdmFsdWVCDAoKX21pbl92YWx1ZSKXAQoFUmFkaW8SEgoFdmFsdWUYASABKAVIAIgBARINCgVsYWJlbBgCIAEoCRIPCgdvcHRpb25zGAMgAygJEhoKDWRlZmF1bHRfdmFsdWUYBCABKAVIAYgBARIQCghyZXF1aXJlZBgFIAEoCBIQCghkaXNhYmxlZBgGIAEoCEIICgZfdmFsdWVCEAoOX2RlZmF1bHRfdmFsdWUisAEKCVNlbGVjdGJveBISCgV2YWx1ZRgBIAEoBUgAiAEBEg0KBWxhYmVsGAIgASgJEg8KB29wdGlvbnMYAyADKAkSEwoLcGxhY2Vob2xkZXIYBCABKAkSGgoNZGVmYXVsdF92YWx1ZRgFIAEoBUgBiAEBEhAKCHJlcXVpcmVkGAYgASgIEhAKCGRpc2FibGVkGAcgASgIQggKBl92YWx1ZUIQCg5fZGVmYXVsdF92YWx1ZSLAAQoFVGFibGUSDAoEZGF0YRgBIAEoDBIkCgV2YWx1ZRgCIAEoCzIVLndpZGdldC52MS5UYWJsZVZhbHVlEg4KBmhlYWRlchgDIAEoCRITCgtkZXNjcmlwdGlvbhgEIAEoCRITCgZoZWlnaHQYBSABKAVIAIgBARIUCgxjb2x1bW5fb3JkZXIYBiADKAkSEQoJb25fc2VsZWN0GAcgASgJEhUKDXJvd19zZWxlY3Rpb24YCCABKAlCCQoHX2hlaWdodCJSCgpUYWJsZVZhbHVlEjYKCXNlbGVjdGlvbhgBIAEoCzIeLndpZGdldC52MS5UYWJsZVZhbHVlU2VsZWN0aW9uSACIAQFCDAoKX3NlbGVjdGlvbiIwChNUYWJsZVZhbHVlU2VsZWN0aW9uEgsKA3JvdxgBIAEoBRIMCgRyb3dzGAIgAygFIs8CCghUZXh0QXJlYRISCgV2YWx1ZRgBIAEoCUgAiAEBEg0KBWxhYmVsGAIgASgJEhMKC3BsYWNlaG9sZGVyGAMgASgJEhoKDWRlZmF1bHRfdmFsdWUYBCABKAlIAYgBARIQCghyZXF1aXJlZBgFIAEoCBIQCghkaXNhYmxlZBgGIAEoCBIXCgptYXhfbGVuZ3RoGAcgASgFSAKIAQESFwoKbWluX2xlbmd0aBgIIAEoBUgDiAEBEhYKCW1heF9saW5lcxgJIAEoBUgEiAEBEhYKCW1pbl9saW5lcxgKIAEoBUgFiAEBEhMKC2F1dG9fcmVzaXplGAsgASgIQggKBl92YWx1ZUIQCg5fZGVmYXVsdF92YWx1ZUINCgtfbWF4X2xlbmd0aEINCgtfbWluX2xlbmd0aEIMCgpfbWF4X2xpbmVzQgwKCl9taW5fbGluZXMi7wEKCVRleHRJbnB1dBISCgV2YWx1ZRgBIAEoCUgAiAEBEg0KBWxhYmVsGAIgASgJEhMKC3BsYWNlaG9sZGVyGAMgASgJEhoKDWRlZmF1bHRfdmFsdWUYBCABKAlIAYgBARIQCghyZXF1aXJlZBgFIAEoCBIQCghkaXNhYmxlZBgGIAEoCBIXCgptYXhfbGVuZ3RoGAcgASgFSAKIAQESFwoKbWluX2xlbmd0aBgIIAEoBUgDiAEBQggKBl92YWx1ZUIQCg5fZGVmYXVsdF92YWx1ZUINCgtfbWF4X2xlbmd0aEINCgtfbWluX2xlbmd0aCKfAQoJVGltZUlucHV0EhIKBXZhbHVlGAEgASgJSACIAQESDQoFbGFiZWwYAiABKAkSEwoLcGxhY2Vob2xkZXIYAyABKAkSGgoNZGVmYXVsdF92YWx1ZRgEIAEoCUgBiAEBEhAKCHJlcXVpcmVkGAUgASgIEhAKCGRpc2FibGVkGAYgASgIQggKBl92YWx1ZUIQCg5fZGVmYXVsdF92YWx1ZSLxBQoGV2lkZ2V0EgoKAmlkGAEgASgJEiMKBmJ1dHRvbhgCIAEoCzIRLndpZGdldC52MS5CdXR0b25IABInCghjaGVja2JveBgDIAEoCzITLndpZGdldC52MS5DaGVja2JveEgAEjIKDmNoZWNrYm94X2dyb3VwGAQgASgLMhgud2lkZ2V0LnYxLkNoZWNrYm94R3JvdXBIABIsCgtjb2x1bW5faXRlbRgFIAEoCzIVLndpZGdldC52MS5Db2x1bW5JdGVtSAASJQoHY29sdW1ucxgGIAEoCzISLndpZGdldC52MS5Db2x1bW5zSAASKgoKZGF0ZV9pbnB1dBgHIAEoCzIULndpZGdldC52MS5EYXRlSW5wdXRIABIzCg9kYXRlX3RpbWVfaW5wdXQYCCABKAsyGC53aWRnZXQudjEuRGF0ZVRpbWVJbnB1dEgAEh8KBGZvcm0YCSABKAsyDy53aWRnZXQudjEuRm9ybUgAEicKCG1hcmtkb3duGAogASgLMhMud2lkZ2V0LnYxLk1hcmtkb3duSAASLgoMbXVsdGlfc2VsZWN0GAsgASgLMhYud2lkZ2V0LnYxLk11bHRpU2VsZWN0SAASLgoMbnVtYmVyX2lucHV0GAwgASgLMhYud2lkZ2V0LnYxLk51bWJlcklucHV0SAASIQoFcmFkaW8YDSABKAsyEC53aWRnZXQudjEuUmFkaW9IABIpCglzZWxlY3Rib3gYDiABKAsyFC53aWRnZXQudjEuU2VsZWN0Ym94SAASIQoFdGFibGUYDyABKAsyEC53aWRnZXQudjEuVGFibGVIABIoCgl0ZXh0X2FyZWEYECABKAsyEy53aWRnZXQudjEuVGV4dEFyZWFIABIqCgp0ZXh0X2lucHV0GBEgASgLMhQud2lkZ2V0LnYxLlRleHRJbnB1dEgAEioKCnRpbWVfaW5wdXQYEiABKAsyFC53aWRnZXQudjEuVGltZUlucHV0SABCBgoEdHlwZUJhCg1jb20ud2lkZ2V0LnYxQgtXaWRnZXRQcm90b1ABogIDV1hYqgIJV2lkZ2V0LlYxygIJV2lkZ2V0XFYx4gIVV2lkZ2V0XFYxXEdQQk1ldGFkYXRh6gIKV2lkZ2V0OjpWMWIGcHJvdG8z");
+  fileDesc("ChZ3aWRnZXQvdjEvd2lkZ2V0LnByb3RvEgl3aWRnZXQudjEiJAoFQWxlcnQSDQoFbGV2ZWwYASABKAkSDAoEYm9keRgCIAEoCSI4CgZCdXR0b24SDQoFdmFsdWUYASABKAgSDQoFbGFiZWwYAiABKAkSEAoIZGlzYWJsZWQYAyABKAgiFwoHQ2FwdGlvbhIMCgR0ZXh0GAEgASgJIqoBCgVDaGFydBIMCgRkYXRhGAEgASgMEgwKBHR5cGUYAiABKAkSDQoFdGl0bGUYAyABKAkSEwoLZGVzY3JpcHRpb24YBCABKAkSDwoHeF9maWVsZBgFIAEoCRIQCgh5X2ZpZWxkcxgGIAMoCRITCgZoZWlnaHQYByABKAVIAIgBARIPCgdzdGFja2VkGAggASgIEg0KBWVycm9yGAkgASgJQgkKB19oZWlnaHQiYwoIQ2hlY2tib3gSDQoFdmFsdWUYASABKAgSDQoFbGFiZWwYAiABKAkSFQoNZGVmYXVsdF92YWx1ZRgDIAEoCBIQCghyZXF1aXJlZBgEIAEoCBIQCghkaXNhYmxlZBgFIAEoCCJ5Cg1DaGVja2JveEdyb3VwEg0KBXZhbHVlGAEgAygFEg0KBWxhYmVsGAIgASgJEg8KB29wdGlvbnMYAyADKAkSFQoNZGVmYXVsdF92YWx1ZRgEIAMoBRIQCghyZXF1aXJlZBgFIAEoCBIQCghkaXNhYmxlZBgGIAEoCCKDAgoKQ29kZUVkaXRvchISCgV2YWx1ZRgBIAEoCUgAiAEBEg0KBWxhYmVsGAIgASgJEhMKC3BsYWNlaG9sZGVyGAMgASgJEhoKDWRlZmF1bHRfdmFsdWUYBCABKAlIAYgBARIQCghyZXF1aXJlZBgFIAEoCBIQCghkaXNhYmxlZBgGIAEoCBIQCghsYW5ndWFnZRgHIAEoCRIUCgxsaW5lX251bWJlcnMYCCABKAgSEQoJcmVhZF9vbmx5GAkgASgIEhcKCm1heF9oZWlnaHQYCiABKAVIAogBAUIICgZfdmFsdWVCEAoOX2RlZmF1bHRfdmFsdWVCDQoLX21heF9oZWlnaHQinQEKCkNvbG9ySW5wdXQSEgoFdmFsdWUYASABKAlIAIgBARINCgVsYWJlbBgCIAEoCRIaCg1kZWZhdWx0X3ZhbHVlGAMgASgJSAGIAQESEAoIcmVxdWlyZWQYBCABKAgSEAoIZGlzYWJsZWQYBSABKAgSEAoIc3dhdGNoZXMYBiADKAlCCAoGX3ZhbHVlQhAKDl9kZWZhdWx0X3ZhbHVlIhwKCkNvbHVtbkl0ZW0SDgoGd2VpZ2h0GAEgASgBIhoKB0NvbHVtbnMSDwoHY29sdW1ucxgBIAEoBSLVAQoJRGF0ZUlucHV0EhIKBXZhbHVlGAEgASgJSACIAQESDQoFbGFiZWwYAiABKAkSEwoLcGxhY2Vob2xkZXIYAyABKAkSGgoNZGVmYXVsdF92YWx1ZRgEIAEoCUgBiAEBEhAKCHJlcXVpcmVkGAUgASgIEhAKCGRpc2FibGVkGAYgASgIEg4KBmZvcm1hdBgHIAEoCRIRCgltYXhfdmFsdWUYCCABKAkSEQoJbWluX3ZhbHVlGAkgASgJQggKBl92YWx1ZUIQCg5fZGVmYXVsdF92YWx1ZSLrAgoORGF0ZVJhbmdlSW5wdXQSGAoLc3RhcnRfdmFsdWUYASABKAlIAIgBARIWCgllbmRfdmFsdWUYAiABKAlIAYgBARINCgVsYWJlbBgDIAEoCRIgChNkZWZhdWx0X3N0YXJ0X3ZhbHVlGAQgASgJSAKIAQESHgoRZGVmYXVsdF9lbmRfdmFsdWUYBSABKAlIA4gBARIQCghyZXF1aXJlZBgGIAEoCBIQCghkaXNhYmxlZBgHIAEoCBIOCgZmb3JtYXQYCCABKAkSEQoJbWF4X3ZhbHVlGAkgASgJEhEKCW1pbl92YWx1ZRgKIAEoCRIwCgdwcmVzZXRzGAsgAygLMh8ud2lkZ2V0LnYxLkRhdGVSYW5nZUlucHV0UHJlc2V0Qg4KDF9zdGFydF92YWx1ZUIMCgpfZW5kX3ZhbHVlQhYKFF9kZWZhdWx0X3N0YXJ0X3ZhbHVlQhQKEl9kZWZhdWx0X2VuZF92YWx1ZSJNChREYXRlUmFuZ2VJbnB1dFByZXNldBINCgVsYWJlbBgBIAEoCRITCgtzdGFydF92YWx1ZRgCIAEoCRIRCgllbmRfdmFsdWUYAyABKAki2QEKDURhdGVUaW1lSW5wdXQSEgoFdmFsdWUYASABKAlIAIgBARINCgVsYWJlbBgCIAEoCRITCgtwbGFjZWhvbGRlchgDIAEoCRIaCg1kZWZhdWx0X3ZhbHVlGAQgASgJSAGIAQESEAoIcmVxdWlyZWQYBSABKAgSEAoIZGlzYWJsZWQYBiABKAgSDgoGZm9ybWF0GAcgASgJEhEKCW1heF92YWx1ZRgIIAEoCRIRCgltaW5fdmFsdWUYCSABKAlCCAoGX3ZhbHVlQhAKDl9kZWZhdWx0X3ZhbHVlIk0KBkRpYWxvZxINCgV2YWx1ZRgBIAEoCBINCgV0aXRsZRgCIAEoCRIMCgRvcGVuGAMgASgIEhcKD2Nsb3NlX29uX3N1Ym1pdBgEIAEoCCIJCgdEaXZpZGVyImUKDkRvd25sb2FkQnV0dG9uEg0KBWxhYmVsGAEgASgJEhEKCWZpbGVfbmFtZRgCIAEoCRIRCgltaW1lX3R5cGUYAyABKAkSDAoEc2l6ZRgEIAEoAxIQCghkaXNhYmxlZBgFIAEoCCIoCghFeHBhbmRlchINCgV2YWx1ZRgBIAEoCBINCgVsYWJlbBgCIAEoCSK3AQoJRmlsZUlucHV0EicKBXZhbHVlGAEgAygLMhgud2lkZ2V0LnYxLkZpbGVJbnB1dEZpbGUSDQoFbGFiZWwYAiABKAkSDgoGYWNjZXB0GAMgAygJEhoKDW1heF9maWxlX3NpemUYBCABKANIAIgBARIQCghtdWx0aXBsZRgFIAEoCBIQCghyZXF1aXJlZBgGIAEoCBIQCghkaXNhYmxlZBgHIAEoCEIQCg5fbWF4X2ZpbGVfc2l6ZSJKCg1GaWxlSW5wdXRGaWxlEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSEQoJbWltZV90eXBlGAMgASgJEgwKBHNpemUYBCABKAMiXQoERm9ybRINCgV2YWx1ZRgBIAEoCBIUCgxidXR0b25fbGFiZWwYAiABKAkSFwoPYnV0dG9uX2Rpc2FibGVkGAMgASgIEhcKD2NsZWFyX29uX3N1Ym1pdBgEIAEoCCIWCgZIZWFkZXISDAoEdGV4dBgBIAEoCSJkCgVJbWFnZRILCgN1cmwYASABKAkSEQoJbWltZV90eXBlGAIgASgJEgwKBHNpemUYAyABKAMSEgoFd2lkdGgYBCABKAVIAIgBARIPCgdjYXB0aW9uGAUgASgJQggKBl93aWR0aCI7CgRKc29uEgwKBGRhdGEYASABKAwSFgoOZXhwYW5kZWRfZGVwdGgYAiABKAUSDQoFZXJyb3IYAyABKAkiIgoETGluaxINCgVsYWJlbBgBIAEoCRILCgN1cmwYAiABKAkiGAoITWFya2Rvd24SDAoEYm9keRgBIAEoCSJyCgZNZXRyaWMSDQoFbGFiZWwYASABKAkSDQoFdmFsdWUYAiABKAkSEgoFZGVsdGEYAyABKAlIAIgBARIXCg9kZWx0YV9kaXJlY3Rpb24YBCABKAkSEwoLZGVsdGFfY29sb3IYBSABKAlCCAoGX2RlbHRhIowBCgtNdWx0aVNlbGVjdBINCgV2YWx1ZRgBIAMoBRINCgVsYWJlbBgCIAEoCRIPCgdvcHRpb25zGAMgAygJEhMKC3BsYWNlaG9sZGVyGAQgASgJEhUKDWRlZmF1bHRfdmFsdWUYBSADKAUSEAoIcmVxdWlyZWQYBiABKAgSEAoIZGlzYWJsZWQYByABKAgi7QEKC051bWJlcklucHV0EhIKBXZhbHVlGAEgASgBSACIAQESDQoFbGFiZWwYAiABKAkSEwoLcGxhY2Vob2xkZXIYAyABKAkSGgoNZGVmYXVsdF92YWx1ZRgEIAEoAUgBiAEBEhAKCHJlcXVpcmVkGAUgASgIEhAKCGRpc2FibGVkGAYgASgIEhYKCW1heF92YWx1ZRgHIAEoAUgCiAEBEhYKCW1pbl92YWx1ZRgIIAEoAUgDiAEBQggKBl92YWx1ZUIQCg5fZGVmYXVsdF92YWx1ZUIMCgpfbWF4X3ZhbHVlQgwKCl9taW5fdmFsdWUiWgoIUGFnZUxpbmsSDQoFbGFiZWwYASABKAkSDwoHcGFnZV9pZBgCIAEoCRINCgVyb3V0ZRgDIAEoCRINCgVxdWVyeRgEIAEoCRIQCghkaXNhYmxlZBgFIAEoCCI2CghQcm9ncmVzcxINCgVsYWJlbBgBIAEoCRINCgV2YWx1ZRgCIAEoARIMCgR0ZXh0GAMgASgJIpcBCgVSYWRpbxISCgV2YWx1ZRgBIAEoBUgAiAEBEg0KBWxhYmVsGAIgASgJEg8KB29wdGlvbnMYAyADKAkSGgoNZGVmYXVsdF92YWx1ZRgEIAEoBUgBiAEBEhAKCHJlcXVpcmVkGAUgASgIEhAKCGRpc2FibGVkGAYgASgIQggKBl92YWx1ZUIQCg5fZGVmYXVsdF92YWx1ZSKoAQoLUmFuZ2VTbGlkZXISCwoDbG93GAEgASgBEgwKBGhpZ2gYAiABKAESDQoFbGFiZWwYAyABKAkSEwoLZGVmYXVsdF9sb3cYBCABKAESFAoMZGVmYXVsdF9oaWdoGAUgASgBEhEKCW1pbl92YWx1ZRgGIAEoARIRCgltYXhfdmFsdWUYByABKAESDAoEc3RlcBgIIAEoARIQCghkaXNhYmxlZBgJIAEoCCKwAQoJU2VsZWN0Ym94EhIKBXZhbHVlGAEgASgFSACIAQESDQoFbGFiZWwYAiABKAkSDwoHb3B0aW9ucxgDIAMoCRITCgtwbGFjZWhvbGRlchgEIAEoCRIaCg1kZWZhdWx0X3ZhbHVlGAUgASgFSAGIAQESEAoIcmVxdWlyZWQYBiABKAgSEAoIZGlzYWJsZWQYByABKAhCCAoGX3ZhbHVlQhAKDl9kZWZhdWx0X3ZhbHVlIoMBCgZTbGlkZXISDQoFdmFsdWUYASABKAESDQoFbGFiZWwYAiABKAkSFQoNZGVmYXVsdF92YWx1ZRgDIAEoARIRCgltaW5fdmFsdWUYBCABKAESEQoJbWF4X3ZhbHVlGAUgASgBEgwKBHN0ZXAYBiABKAESEAoIZGlzYWJsZWQYByABKAgiCAoGU3BhY2VyIicKB1NwaW5uZXISDAoEdGV4dBgBIAEoCRIOCgZhY3RpdmUYAiABKAgiGQoJU3ViaGVhZGVyEgwKBHRleHQYASABKAkiGAoHVGFiSXRlbRINCgVsYWJlbBgBIAEoCSLOAgoFVGFibGUSDAoEZGF0YRgBIAEoDBIkCgV2YWx1ZRgCIAEoCzIVLndpZGdldC52MS5UYWJsZVZhbHVlEg4KBmhlYWRlchgDIAEoCRITCgtkZXNjcmlwdGlvbhgEIAEoCRITCgZoZWlnaHQYBSABKAVIAIgBARIUCgxjb2x1bW5fb3JkZXIYBiADKAkSEQoJb25fc2VsZWN0GAcgASgJEhUKDXJvd19zZWxlY3Rpb24YCCABKAkSEQoJcGFnaW5hdGVkGAkgASgIEhIKCnRvdGFsX3Jvd3MYCiABKAMSGAoQZWRpdGFibGVfY29sdW1ucxgLIAMoCRITCgtyb3dfYWN0aW9ucxgMIAMoCRInCgdjb2x1bW5zGA0gAygLMhYud2lkZ2V0LnYxLlRhYmxlQ29sdW1uEg0KBWVycm9yGA4gASgJQgkKB19oZWlnaHQiaAoLVGFibGVDb2x1bW4SDAoEbmFtZRgBIAEoCRINCgVsYWJlbBgCIAEoCRIxCgZmb3JtYXQYAyABKAsyHC53aWRnZXQudjEuVGFibGVDb2x1bW5Gb3JtYXRIAIgBAUIJCgdfZm9ybWF0ImwKEVRhYmxlQ29sdW1uRm9ybWF0EgwKBHR5cGUYASABKAkSFQoIZGVjaW1hbHMYAiABKAVIAIgBARIQCghjdXJyZW5jeRgDIAEoCRITCgtkYXRlX2Zvcm1hdBgEIAEoCUILCglfZGVjaW1hbHMingMKClRhYmxlVmFsdWUSNgoJc2VsZWN0aW9uGAEgASgLMh4ud2lkZ2V0LnYxLlRhYmxlVmFsdWVTZWxlY3Rpb25IAIgBARI4CgpwYWdpbmF0aW9uGAIgASgLMh8ud2lkZ2V0LnYxLlRhYmxlVmFsdWVQYWdpbmF0aW9uSAGIAQESLAoEc29ydBgDIAEoCzIZLndpZGdldC52MS5UYWJsZVZhbHVlU29ydEgCiAEBEjMKB2ZpbHRlcnMYBCADKAsyIi53aWRnZXQudjEuVGFibGVWYWx1ZS5GaWx0ZXJzRW50cnkSKAoFZWRpdHMYBSADKAsyGS53aWRnZXQudjEuVGFibGVWYWx1ZUVkaXQSMAoGYWN0aW9uGAYgASgLMhsud2lkZ2V0LnYxLlRhYmxlVmFsdWVBY3Rpb25IA4gBARouCgxGaWx0ZXJzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4AUIMCgpfc2VsZWN0aW9uQg0KC19wYWdpbmF0aW9uQgcKBV9zb3J0QgkKB19hY3Rpb24iLQoQVGFibGVWYWx1ZUFjdGlvbhILCgNyb3cYASABKAUSDAoEbmFtZRgCIAEoCSJTCg5UYWJsZVZhbHVlRWRpdBILCgNyb3cYASABKAUSDgoGY29sdW1uGAIgASgJEhEKCW9sZF92YWx1ZRgDIAEoCRIRCgluZXdfdmFsdWUYBCABKAkiNwoUVGFibGVWYWx1ZVBhZ2luYXRpb24SDAoEcGFnZRgBIAEoBRIRCglwYWdlX3NpemUYAiABKAUiMAoTVGFibGVWYWx1ZVNlbGVjdGlvbhILCgNyb3cYASABKAUSDAoEcm93cxgCIAMoBSIzCg5UYWJsZVZhbHVlU29ydBIOCgZjb2x1bW4YASABKAkSEQoJZGlyZWN0aW9uGAIgASgJIiMKBFRhYnMSDQoFdmFsdWUYASABKAUSDAoEdGFicxgCIAEoBSKxAQoIVGFnSW5wdXQSDQoFdmFsdWUYASADKAkSDQoFbGFiZWwYAiABKAkSEwoLcGxhY2Vob2xkZXIYAyABKAkSFQoNZGVmYXVsdF92YWx1ZRgEIAMoCRIQCghyZXF1aXJlZBgFIAEoCBIQCghkaXNhYmxlZBgGIAEoCBITCgtzdWdnZXN0aW9ucxgHIAMoCRIVCghtYXhfdGFncxgIIAEoBUgAiAEBQgsKCV9tYXhfdGFncyLPAgoIVGV4dEFyZWESEgoFdmFsdWUYASABKAlIAIgBARINCgVsYWJlbBgCIAEoCRITCgtwbGFjZWhvbGRlchgDIAEoCRIaCg1kZWZhdWx0X3ZhbHVlGAQgASgJSAGIAQESEAoIcmVxdWlyZWQYBSABKAgSEAoIZGlzYWJsZWQYBiABKAgSFwoKbWF4X2xlbmd0aBgHIAEoBUgCiAEBEhcKCm1pbl9sZW5ndGgYCCABKAVIA4gBARIWCgltYXhfbGluZXMYCSABKAVIBIgBARIWCgltaW5fbGluZXMYCiABKAVIBYgBARITCgthdXRvX3Jlc2l6ZRgLIAEoCEIICgZfdmFsdWVCEAoOX2RlZmF1bHRfdmFsdWVCDQoLX21heF9sZW5ndGhCDQoLX21pbl9sZW5ndGhCDAoKX21heF9saW5lc0IMCgpfbWluX2xpbmVzIu8BCglUZXh0SW5wdXQSEgoFdmFsdWUYASABKAlIAIgBARINCgVsYWJlbBgCIAEoCRITCgtwbGFjZWhvbGRlchgDIAEoCRIaCg1kZWZhdWx0X3ZhbHVlGAQgASgJSAGIAQESEAoIcmVxdWlyZWQYBSABKAgSEAoIZGlzYWJsZWQYBiABKAgSFwoKbWF4X2xlbmd0aBgHIAEoBUgCiAEBEhcKCm1pbl9sZW5ndGgYCCABKAVIA4gBAUIICgZfdmFsdWVCEAoOX2RlZmF1bHRfdmFsdWVCDQoLX21heF9sZW5ndGhCDQoLX21pbl9sZW5ndGginwEKCVRpbWVJbnB1dBISCgV2YWx1ZRgBIAEoCUgAiAEBEg0KBWxhYmVsGAIgASgJEhMKC3BsYWNlaG9sZGVyGAMgASgJEhoKDWRlZmF1bHRfdmFsdWUYBCABKAlIAYgBARIQCghyZXF1aXJlZBgFIAEoCBIQCghkaXNhYmxlZBgGIAEoCEIICgZfdmFsdWVCEAoOX2RlZmF1bHRfdmFsdWUiaAoGVG9nZ2xlEg0KBXZhbHVlGAEgASgIEg0KBWxhYmVsGAIgASgJEhUKDWRlZmF1bHRfdmFsdWUYAyABKAgSEAoIZGlzYWJsZWQYBCABKAgSFwoPcmVydW5fb25fY2hhbmdlGAUgASgIIq4OCgZXaWRnZXQSCgoCaWQYASABKAkSIwoGYnV0dG9uGAIgASgLMhEud2lkZ2V0LnYxLkJ1dHRvbkgAEicKCGNoZWNrYm94GAMgASgLMhMud2lkZ2V0LnYxLkNoZWNrYm94SAASMgoOY2hlY2tib3hfZ3JvdXAYBCABKAsyGC53aWRnZXQudjEuQ2hlY2tib3hHcm91cEgAEiwKC2NvbHVtbl9pdGVtGAUgASgLMhUud2lkZ2V0LnYxLkNvbHVtbkl0ZW1IABIlCgdjb2x1bW5zGAYgASgLMhIud2lkZ2V0LnYxLkNvbHVtbnNIABIqCgpkYXRlX2lucHV0GAcgASgLMhQud2lkZ2V0LnYxLkRhdGVJbnB1dEgAEjMKD2RhdGVfdGltZV9pbnB1dBgIIAEoCzIYLndpZGdldC52MS5EYXRlVGltZUlucHV0SAASHwoEZm9ybRgJIAEoCzIPLndpZGdldC52MS5Gb3JtSAASJwoIbWFya2Rvd24YCiABKAsyEy53aWRnZXQudjEuTWFya2Rvd25IABIuCgxtdWx0aV9zZWxlY3QYCyABKAsyFi53aWRnZXQudjEuTXVsdGlTZWxlY3RIABIuCgxudW1iZXJfaW5wdXQYDCABKAsyFi53aWRnZXQudjEuTnVtYmVySW5wdXRIABIhCgVyYWRpbxgNIAEoCzIQLndpZGdldC52MS5SYWRpb0gAEikKCXNlbGVjdGJveBgOIAEoCzIULndpZGdldC52MS5TZWxlY3Rib3hIABIhCgV0YWJsZRgPIAEoCzIQLndpZGdldC52MS5UYWJsZUgAEigKCXRleHRfYXJlYRgQIAEoCzITLndpZGdldC52MS5UZXh0QXJlYUgAEioKCnRleHRfaW5wdXQYESABKAsyFC53aWRnZXQudjEuVGV4dElucHV0SAASKgoKdGltZV9pbnB1dBgSIAEoCzIULndpZGdldC52MS5UaW1lSW5wdXRIABIqCgpmaWxlX2lucHV0GBMgASgLMhQud2lkZ2V0LnYxLkZpbGVJbnB1dEgAEjQKD2Rvd25sb2FkX2J1dHRvbhgUIAEoCzIZLndpZGdldC52MS5Eb3dubG9hZEJ1dHRvbkgAEiEKBWNoYXJ0GBUgASgLMhAud2lkZ2V0LnYxLkNoYXJ0SAASHwoEdGFicxgWIAEoCzIPLndpZGdldC52MS5UYWJzSAASJgoIdGFiX2l0ZW0YFyABKAsyEi53aWRnZXQudjEuVGFiSXRlbUgAEicKCGV4cGFuZGVyGBggASgLMhMud2lkZ2V0LnYxLkV4cGFuZGVySAASIwoGZGlhbG9nGBkgASgLMhEud2lkZ2V0LnYxLkRpYWxvZ0gAEiEKBWFsZXJ0GBogASgLMhAud2lkZ2V0LnYxLkFsZXJ0SAASIwoGbWV0cmljGBsgASgLMhEud2lkZ2V0LnYxLk1ldHJpY0gAEicKCHByb2dyZXNzGBwgASgLMhMud2lkZ2V0LnYxLlByb2dyZXNzSAASJQoHc3Bpbm5lchgdIAEoCzISLndpZGdldC52MS5TcGlubmVySAASIwoGc2xpZGVyGB4gASgLMhEud2lkZ2V0LnYxLlNsaWRlckgAEi4KDHJhbmdlX3NsaWRlchgfIAEoCzIWLndpZGdldC52MS5SYW5nZVNsaWRlckgAEiMKBnRvZ2dsZRggIAEoCzIRLndpZGdldC52MS5Ub2dnbGVIABI1ChBkYXRlX3JhbmdlX2lucHV0GCEgASgLMhkud2lkZ2V0LnYxLkRhdGVSYW5nZUlucHV0SAASHwoEanNvbhgiIAEoCzIPLndpZGdldC52MS5Kc29uSAASLAoLY29kZV9lZGl0b3IYIyABKAsyFS53aWRnZXQudjEuQ29kZUVkaXRvckgAEiEKBWltYWdlGCQgASgLMhAud2lkZ2V0LnYxLkltYWdlSAASHwoEbGluaxglIAEoCzIPLndpZGdldC52MS5MaW5rSAASKAoJcGFnZV9saW5rGCYgASgLMhMud2lkZ2V0LnYxLlBhZ2VMaW5rSAASIwoGaGVhZGVyGCcgASgLMhEud2lkZ2V0LnYxLkhlYWRlckgAEikKCXN1YmhlYWRlchgoIAEoCzIULndpZGdldC52MS5TdWJoZWFkZXJIABIlCgdjYXB0aW9uGCkgASgLMhIud2lkZ2V0LnYxLkNhcHRpb25IABIlCgdkaXZpZGVyGCogASgLMhIud2lkZ2V0LnYxLkRpdmlkZXJIABIjCgZzcGFjZXIYKyABKAsyES53aWRnZXQudjEuU3BhY2VySAASKAoJdGFnX2lucHV0GCwgASgLMhMud2lkZ2V0LnYxLlRhZ0lucHV0SAASLAoLY29sb3JfaW5wdXQYLSABKAsyFS53aWRnZXQudjEuQ29sb3JJbnB1dEgAQgYKBHR5cGVCYQoNY29tLndpZGdldC52MUILV2lkZ2V0UHJvdG9QAaICA1dYWKoCCVdpZGdldC5WMcoCCVdpZGdldFxWMeICFVdpZGdldFxWMVxHUEJNZXRhZGF0YeoCCldpZGdldDo6VjFiBnByb3RvMw");
+
+/**
+ * @generated from message widget.v1.Alert
+ */
+export type Alert = Message<"widget.v1.Alert"> & {
+  /**
+   * @generated from field: string level = 1;
+   */
+  level: string;
+
+  /**
+   * @generated from field: string body = 2;
+   */
+  body: string;
+};
+
+/**
+ * JSON type for the message widget.v1.Alert.
+ */
+export type AlertJson = {
+  /**
+   * @generated from field: string level = 1;
+   */
+  level?: string;
+
+  /**
+   * @generated from field: string body = 2;
+   */
+  body?: string;
+};
+
+/**
+ * Describes the message widget.v1.Alert.
+ * Use `create(AlertSchema)` to create a new message.
+ */
+export const AlertSchema: GenMessage<Alert, AlertJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 0);
 
 /**
  * @generated from message widget.v1.Button
@@ -57,7 +94,141 @@ export type ButtonJson = {
  * Use `create(ButtonSchema)` to create a new message.
  */
 export const ButtonSchema: GenMessage<Button, ButtonJson> = /*@__PURE__*/
-  messageDesc(file_widget_v1_widget, 0);
+  messageDesc(file_widget_v1_widget, 1);
+
+/**
+ * @generated from message widget.v1.Caption
+ */
+export type Caption = Message<"widget.v1.Caption"> & {
+  /**
+   * @generated from field: string text = 1;
+   */
+  text: string;
+};
+
+/**
+ * JSON type for the message widget.v1.Caption.
+ */
+export type CaptionJson = {
+  /**
+   * @generated from field: string text = 1;
+   */
+  text?: string;
+};
+
+/**
+ * Describes the message widget.v1.Caption.
+ * Use `create(CaptionSchema)` to create a new message.
+ */
+export const CaptionSchema: GenMessage<Caption, CaptionJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 2);
+
+/**
+ * @generated from message widget.v1.Chart
+ */
+export type Chart = Message<"widget.v1.Chart"> & {
+  /**
+   * @generated from field: bytes data = 1;
+   */
+  data: Uint8Array;
+
+  /**
+   * @generated from field: string type = 2;
+   */
+  type: string;
+
+  /**
+   * @generated from field: string title = 3;
+   */
+  title: string;
+
+  /**
+   * @generated from field: string description = 4;
+   */
+  description: string;
+
+  /**
+   * @generated from field: string x_field = 5;
+   */
+  xField: string;
+
+  /**
+   * @generated from field: repeated string y_fields = 6;
+   */
+  yFields: string[];
+
+  /**
+   * @generated from field: optional int32 height = 7;
+   */
+  height?: number;
+
+  /**
+   * @generated from field: bool stacked = 8;
+   */
+  stacked: boolean;
+
+  /**
+   * @generated from field: string error = 9;
+   */
+  error: string;
+};
+
+/**
+ * JSON type for the message widget.v1.Chart.
+ */
+export type ChartJson = {
+  /**
+   * @generated from field: bytes data = 1;
+   */
+  data?: string;
+
+  /**
+   * @generated from field: string type = 2;
+   */
+  type?: string;
+
+  /**
+   * @generated from field: string title = 3;
+   */
+  title?: string;
+
+  /**
+   * @generated from field: string description = 4;
+   */
+  description?: string;
+
+  /**
+   * @generated from field: string x_field = 5;
+   */
+  xField?: string;
+
+  /**
+   * @generated from field: repeated string y_fields = 6;
+   */
+  yFields?: string[];
+
+  /**
+   * @generated from field: optional int32 height = 7;
+   */
+  height?: number;
+
+  /**
+   * @generated from field: bool stacked = 8;
+   */
+  stacked?: boolean;
+
+  /**
+   * @generated from field: string error = 9;
+   */
+  error?: string;
+};
+
+/**
+ * Describes the message widget.v1.Chart.
+ * Use `create(ChartSchema)` to create a new message.
+ */
+export const ChartSchema: GenMessage<Chart, ChartJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 3);
 
 /**
  * @generated from message widget.v1.Checkbox
@@ -124,7 +295,7 @@ export type CheckboxJson = {
  * Use `create(CheckboxSchema)` to create a new message.
  */
 export const CheckboxSchema: GenMessage<Checkbox, CheckboxJson> = /*@__PURE__*/
-  messageDesc(file_widget_v1_widget, 1);
+  messageDesc(file_widget_v1_widget, 4);
 
 /**
  * @generated from message widget.v1.CheckboxGroup
@@ -201,66 +372,12 @@ export type CheckboxGroupJson = {
  * Use `create(CheckboxGroupSchema)` to create a new message.
  */
 export const CheckboxGroupSchema: GenMessage<CheckboxGroup, CheckboxGroupJson> = /*@__PURE__*/
-  messageDesc(file_widget_v1_widget, 2);
-
-/**
- * @generated from message widget.v1.ColumnItem
- */
-export type ColumnItem = Message<"widget.v1.ColumnItem"> & {
-  /**
-   * @generated from field: double weight = 1;
-   */
-  weight: number;
-};
-
-/**
- * JSON type for the message widget.v1.ColumnItem.
- */
-export type ColumnItemJson = {
-  /**
-   * @generated from field: double weight = 1;
-   */
-  weight?: number | "NaN" | "Infinity" | "-Infinity";
-};
-
-/**
- * Describes the message widget.v1.ColumnItem.
- * Use `create(ColumnItemSchema)` to create a new message.
- */
-export const ColumnItemSchema: GenMessage<ColumnItem, ColumnItemJson> = /*@__PURE__*/
-  messageDesc(file_widget_v1_widget, 3);
-
-/**
- * @generated from message widget.v1.Columns
- */
-export type Columns = Message<"widget.v1.Columns"> & {
-  /**
-   * @generated from field: int32 columns = 1;
-   */
-  columns: number;
-};
-
-/**
- * JSON type for the message widget.v1.Columns.
- */
-export type ColumnsJson = {
-  /**
-   * @generated from field: int32 columns = 1;
-   */
-  columns?: number;
-};
-
-/**
- * Describes the message widget.v1.Columns.
- * Use `create(ColumnsSchema)` to create a new message.
- */
-export const ColumnsSchema: GenMessage<Columns, ColumnsJson> = /*@__PURE__*/
-  messageDesc(file_widget_v1_widget, 4);
+  messageDesc(file_widget_v1_widget, 5);
 
 /**
- * @generated from message widget.v1.DateInput
+ * @generated from message widget.v1.CodeEditor
  */
-export type DateInput = Message<"widget.v1.DateInput"> & {
+export type CodeEditor = Message<"widget.v1.CodeEditor"> & {
   /**
    * @generated from field: optional string value = 1;
    */
@@ -292,25 +409,30 @@ export type DateInput = Message<"widget.v1.DateInput"> & {
   disabled: boolean;
 
   /**
-   * @generated from field: string format = 7;
+   * @generated from field: string language = 7;
    */
-  format: string;
+  language: string;
 
   /**
-   * @generated from field: string max_value = 8;
+   * @generated from field: bool line_numbers = 8;
    */
-  maxValue: string;
+  lineNumbers: boolean;
 
   /**
-   * @generated from field: string min_value = 9;
+   * @generated from field: bool read_only = 9;
    */
-  minValue: string;
+  readOnly: boolean;
+
+  /**
+   * @generated from field: optional int32 max_height = 10;
+   */
+  maxHeight?: number;
 };
 
 /**
- * JSON type for the message widget.v1.DateInput.
+ * JSON type for the message widget.v1.CodeEditor.
  */
-export type DateInputJson = {
+export type CodeEditorJson = {
   /**
    * @generated from field: optional string value = 1;
    */
@@ -342,32 +464,37 @@ export type DateInputJson = {
   disabled?: boolean;
 
   /**
-   * @generated from field: string format = 7;
+   * @generated from field: string language = 7;
    */
-  format?: string;
+  language?: string;
 
   /**
-   * @generated from field: string max_value = 8;
+   * @generated from field: bool line_numbers = 8;
    */
-  maxValue?: string;
+  lineNumbers?: boolean;
 
   /**
-   * @generated from field: string min_value = 9;
+   * @generated from field: bool read_only = 9;
    */
-  minValue?: string;
+  readOnly?: boolean;
+
+  /**
+   * @generated from field: optional int32 max_height = 10;
+   */
+  maxHeight?: number;
 };
 
 /**
- * Describes the message widget.v1.DateInput.
- * Use `create(DateInputSchema)` to create a new message.
+ * Describes the message widget.v1.CodeEditor.
+ * Use `create(CodeEditorSchema)` to create a new message.
  */
-export const DateInputSchema: GenMessage<DateInput, DateInputJson> = /*@__PURE__*/
-  messageDesc(file_widget_v1_widget, 5);
+export const CodeEditorSchema: GenMessage<CodeEditor, CodeEditorJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 6);
 
 /**
- * @generated from message widget.v1.DateTimeInput
+ * @generated from message widget.v1.ColorInput
  */
-export type DateTimeInput = Message<"widget.v1.DateTimeInput"> & {
+export type ColorInput = Message<"widget.v1.ColorInput"> & {
   /**
    * @generated from field: optional string value = 1;
    */
@@ -379,45 +506,30 @@ export type DateTimeInput = Message<"widget.v1.DateTimeInput"> & {
   label: string;
 
   /**
-   * @generated from field: string placeholder = 3;
-   */
-  placeholder: string;
-
-  /**
-   * @generated from field: optional string default_value = 4;
+   * @generated from field: optional string default_value = 3;
    */
   defaultValue?: string;
 
   /**
-   * @generated from field: bool required = 5;
+   * @generated from field: bool required = 4;
    */
   required: boolean;
 
   /**
-   * @generated from field: bool disabled = 6;
+   * @generated from field: bool disabled = 5;
    */
   disabled: boolean;
 
   /**
-   * @generated from field: string format = 7;
-   */
-  format: string;
-
-  /**
-   * @generated from field: string max_value = 8;
-   */
-  maxValue: string;
-
-  /**
-   * @generated from field: string min_value = 9;
+   * @generated from field: repeated string swatches = 6;
    */
-  minValue: string;
+  swatches: string[];
 };
 
 /**
- * JSON type for the message widget.v1.DateTimeInput.
+ * JSON type for the message widget.v1.ColorInput.
  */
-export type DateTimeInputJson = {
+export type ColorInputJson = {
   /**
    * @generated from field: optional string value = 1;
    */
@@ -429,160 +541,222 @@ export type DateTimeInputJson = {
   label?: string;
 
   /**
-   * @generated from field: string placeholder = 3;
-   */
-  placeholder?: string;
-
-  /**
-   * @generated from field: optional string default_value = 4;
+   * @generated from field: optional string default_value = 3;
    */
   defaultValue?: string;
 
   /**
-   * @generated from field: bool required = 5;
+   * @generated from field: bool required = 4;
    */
   required?: boolean;
 
   /**
-   * @generated from field: bool disabled = 6;
+   * @generated from field: bool disabled = 5;
    */
   disabled?: boolean;
 
   /**
-   * @generated from field: string format = 7;
-   */
-  format?: string;
-
-  /**
-   * @generated from field: string max_value = 8;
-   */
-  maxValue?: string;
-
-  /**
-   * @generated from field: string min_value = 9;
+   * @generated from field: repeated string swatches = 6;
    */
-  minValue?: string;
+  swatches?: string[];
 };
 
 /**
- * Describes the message widget.v1.DateTimeInput.
- * Use `create(DateTimeInputSchema)` to create a new message.
+ * Describes the message widget.v1.ColorInput.
+ * Use `create(ColorInputSchema)` to create a new message.
  */
-export const DateTimeInputSchema: GenMessage<DateTimeInput, DateTimeInputJson> = /*@__PURE__*/
-  messageDesc(file_widget_v1_widget, 6);
+export const ColorInputSchema: GenMessage<ColorInput, ColorInputJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 7);
 
 /**
- * @generated from message widget.v1.Form
+ * @generated from message widget.v1.ColumnItem
  */
-export type Form = Message<"widget.v1.Form"> & {
+export type ColumnItem = Message<"widget.v1.ColumnItem"> & {
   /**
-   * @generated from field: bool value = 1;
+   * @generated from field: double weight = 1;
    */
-  value: boolean;
+  weight: number;
+};
 
+/**
+ * JSON type for the message widget.v1.ColumnItem.
+ */
+export type ColumnItemJson = {
   /**
-   * @generated from field: string button_label = 2;
+   * @generated from field: double weight = 1;
    */
-  buttonLabel: string;
+  weight?: number | "NaN" | "Infinity" | "-Infinity";
+};
 
+/**
+ * Describes the message widget.v1.ColumnItem.
+ * Use `create(ColumnItemSchema)` to create a new message.
+ */
+export const ColumnItemSchema: GenMessage<ColumnItem, ColumnItemJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 8);
+
+/**
+ * @generated from message widget.v1.Columns
+ */
+export type Columns = Message<"widget.v1.Columns"> & {
   /**
-   * @generated from field: bool button_disabled = 3;
+   * @generated from field: int32 columns = 1;
    */
-  buttonDisabled: boolean;
+  columns: number;
+};
 
+/**
+ * JSON type for the message widget.v1.Columns.
+ */
+export type ColumnsJson = {
   /**
-   * @generated from field: bool clear_on_submit = 4;
+   * @generated from field: int32 columns = 1;
    */
-  clearOnSubmit: boolean;
+  columns?: number;
 };
 
 /**
- * JSON type for the message widget.v1.Form.
+ * Describes the message widget.v1.Columns.
+ * Use `create(ColumnsSchema)` to create a new message.
  */
-export type FormJson = {
+export const ColumnsSchema: GenMessage<Columns, ColumnsJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 9);
+
+/**
+ * @generated from message widget.v1.DateInput
+ */
+export type DateInput = Message<"widget.v1.DateInput"> & {
   /**
-   * @generated from field: bool value = 1;
+   * @generated from field: optional string value = 1;
    */
-  value?: boolean;
+  value?: string;
 
   /**
-   * @generated from field: string button_label = 2;
+   * @generated from field: string label = 2;
    */
-  buttonLabel?: string;
+  label: string;
 
   /**
-   * @generated from field: bool button_disabled = 3;
+   * @generated from field: string placeholder = 3;
    */
-  buttonDisabled?: boolean;
+  placeholder: string;
 
   /**
-   * @generated from field: bool clear_on_submit = 4;
+   * @generated from field: optional string default_value = 4;
    */
-  clearOnSubmit?: boolean;
-};
+  defaultValue?: string;
 
-/**
- * Describes the message widget.v1.Form.
- * Use `create(FormSchema)` to create a new message.
- */
-export const FormSchema: GenMessage<Form, FormJson> = /*@__PURE__*/
-  messageDesc(file_widget_v1_widget, 7);
+  /**
+   * @generated from field: bool required = 5;
+   */
+  required: boolean;
 
-/**
- * @generated from message widget.v1.Markdown
- */
-export type Markdown = Message<"widget.v1.Markdown"> & {
   /**
-   * @generated from field: string body = 1;
+   * @generated from field: bool disabled = 6;
    */
-  body: string;
+  disabled: boolean;
+
+  /**
+   * @generated from field: string format = 7;
+   */
+  format: string;
+
+  /**
+   * @generated from field: string max_value = 8;
+   */
+  maxValue: string;
+
+  /**
+   * @generated from field: string min_value = 9;
+   */
+  minValue: string;
 };
 
 /**
- * JSON type for the message widget.v1.Markdown.
+ * JSON type for the message widget.v1.DateInput.
  */
-export type MarkdownJson = {
+export type DateInputJson = {
   /**
-   * @generated from field: string body = 1;
+   * @generated from field: optional string value = 1;
    */
-  body?: string;
+  value?: string;
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label?: string;
+
+  /**
+   * @generated from field: string placeholder = 3;
+   */
+  placeholder?: string;
+
+  /**
+   * @generated from field: optional string default_value = 4;
+   */
+  defaultValue?: string;
+
+  /**
+   * @generated from field: bool required = 5;
+   */
+  required?: boolean;
+
+  /**
+   * @generated from field: bool disabled = 6;
+   */
+  disabled?: boolean;
+
+  /**
+   * @generated from field: string format = 7;
+   */
+  format?: string;
+
+  /**
+   * @generated from field: string max_value = 8;
+   */
+  maxValue?: string;
+
+  /**
+   * @generated from field: string min_value = 9;
+   */
+  minValue?: string;
 };
 
 /**
- * Describes the message widget.v1.Markdown.
- * Use `create(MarkdownSchema)` to create a new message.
+ * Describes the message widget.v1.DateInput.
+ * Use `create(DateInputSchema)` to create a new message.
  */
-export const MarkdownSchema: GenMessage<Markdown, MarkdownJson> = /*@__PURE__*/
-  messageDesc(file_widget_v1_widget, 8);
+export const DateInputSchema: GenMessage<DateInput, DateInputJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 10);
 
 /**
- * @generated from message widget.v1.MultiSelect
+ * @generated from message widget.v1.DateRangeInput
  */
-export type MultiSelect = Message<"widget.v1.MultiSelect"> & {
+export type DateRangeInput = Message<"widget.v1.DateRangeInput"> & {
   /**
-   * @generated from field: repeated int32 value = 1;
+   * @generated from field: optional string start_value = 1;
    */
-  value: number[];
+  startValue?: string;
 
   /**
-   * @generated from field: string label = 2;
+   * @generated from field: optional string end_value = 2;
    */
-  label: string;
+  endValue?: string;
 
   /**
-   * @generated from field: repeated string options = 3;
+   * @generated from field: string label = 3;
    */
-  options: string[];
+  label: string;
 
   /**
-   * @generated from field: string placeholder = 4;
+   * @generated from field: optional string default_start_value = 4;
    */
-  placeholder: string;
+  defaultStartValue?: string;
 
   /**
-   * @generated from field: repeated int32 default_value = 5;
+   * @generated from field: optional string default_end_value = 5;
    */
-  defaultValue: number[];
+  defaultEndValue?: string;
 
   /**
    * @generated from field: bool required = 6;
@@ -593,36 +767,56 @@ export type MultiSelect = Message<"widget.v1.MultiSelect"> & {
    * @generated from field: bool disabled = 7;
    */
   disabled: boolean;
+
+  /**
+   * @generated from field: string format = 8;
+   */
+  format: string;
+
+  /**
+   * @generated from field: string max_value = 9;
+   */
+  maxValue: string;
+
+  /**
+   * @generated from field: string min_value = 10;
+   */
+  minValue: string;
+
+  /**
+   * @generated from field: repeated widget.v1.DateRangeInputPreset presets = 11;
+   */
+  presets: DateRangeInputPreset[];
 };
 
 /**
- * JSON type for the message widget.v1.MultiSelect.
+ * JSON type for the message widget.v1.DateRangeInput.
  */
-export type MultiSelectJson = {
+export type DateRangeInputJson = {
   /**
-   * @generated from field: repeated int32 value = 1;
+   * @generated from field: optional string start_value = 1;
    */
-  value?: number[];
+  startValue?: string;
 
   /**
-   * @generated from field: string label = 2;
+   * @generated from field: optional string end_value = 2;
    */
-  label?: string;
+  endValue?: string;
 
   /**
-   * @generated from field: repeated string options = 3;
+   * @generated from field: string label = 3;
    */
-  options?: string[];
+  label?: string;
 
   /**
-   * @generated from field: string placeholder = 4;
+   * @generated from field: optional string default_start_value = 4;
    */
-  placeholder?: string;
+  defaultStartValue?: string;
 
   /**
-   * @generated from field: repeated int32 default_value = 5;
+   * @generated from field: optional string default_end_value = 5;
    */
-  defaultValue?: number[];
+  defaultEndValue?: string;
 
   /**
    * @generated from field: bool required = 6;
@@ -633,436 +827,2284 @@ export type MultiSelectJson = {
    * @generated from field: bool disabled = 7;
    */
   disabled?: boolean;
+
+  /**
+   * @generated from field: string format = 8;
+   */
+  format?: string;
+
+  /**
+   * @generated from field: string max_value = 9;
+   */
+  maxValue?: string;
+
+  /**
+   * @generated from field: string min_value = 10;
+   */
+  minValue?: string;
+
+  /**
+   * @generated from field: repeated widget.v1.DateRangeInputPreset presets = 11;
+   */
+  presets?: DateRangeInputPresetJson[];
 };
 
 /**
- * Describes the message widget.v1.MultiSelect.
- * Use `create(MultiSelectSchema)` to create a new message.
+ * Describes the message widget.v1.DateRangeInput.
+ * Use `create(DateRangeInputSchema)` to create a new message.
  */
-export const MultiSelectSchema: GenMessage<MultiSelect, MultiSelectJson> = /*@__PURE__*/
-  messageDesc(file_widget_v1_widget, 9);
+export const DateRangeInputSchema: GenMessage<DateRangeInput, DateRangeInputJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 11);
 
 /**
- * @generated from message widget.v1.NumberInput
+ * @generated from message widget.v1.DateRangeInputPreset
  */
-export type NumberInput = Message<"widget.v1.NumberInput"> & {
+export type DateRangeInputPreset = Message<"widget.v1.DateRangeInputPreset"> & {
   /**
-   * @generated from field: optional double value = 1;
+   * @generated from field: string label = 1;
    */
-  value?: number;
+  label: string;
 
   /**
-   * @generated from field: string label = 2;
+   * @generated from field: string start_value = 2;
    */
-  label: string;
+  startValue: string;
 
   /**
-   * @generated from field: string placeholder = 3;
+   * @generated from field: string end_value = 3;
    */
-  placeholder: string;
+  endValue: string;
+};
 
+/**
+ * JSON type for the message widget.v1.DateRangeInputPreset.
+ */
+export type DateRangeInputPresetJson = {
   /**
-   * @generated from field: optional double default_value = 4;
+   * @generated from field: string label = 1;
    */
-  defaultValue?: number;
+  label?: string;
 
   /**
-   * @generated from field: bool required = 5;
+   * @generated from field: string start_value = 2;
    */
-  required: boolean;
+  startValue?: string;
 
   /**
-   * @generated from field: bool disabled = 6;
+   * @generated from field: string end_value = 3;
    */
-  disabled: boolean;
+  endValue?: string;
+};
+
+/**
+ * Describes the message widget.v1.DateRangeInputPreset.
+ * Use `create(DateRangeInputPresetSchema)` to create a new message.
+ */
+export const DateRangeInputPresetSchema: GenMessage<DateRangeInputPreset, DateRangeInputPresetJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 12);
 
+/**
+ * @generated from message widget.v1.DateTimeInput
+ */
+export type DateTimeInput = Message<"widget.v1.DateTimeInput"> & {
   /**
-   * @generated from field: optional double max_value = 7;
+   * @generated from field: optional string value = 1;
    */
-  maxValue?: number;
+  value?: string;
 
   /**
-   * @generated from field: optional double min_value = 8;
+   * @generated from field: string label = 2;
+   */
+  label: string;
+
+  /**
+   * @generated from field: string placeholder = 3;
+   */
+  placeholder: string;
+
+  /**
+   * @generated from field: optional string default_value = 4;
+   */
+  defaultValue?: string;
+
+  /**
+   * @generated from field: bool required = 5;
+   */
+  required: boolean;
+
+  /**
+   * @generated from field: bool disabled = 6;
+   */
+  disabled: boolean;
+
+  /**
+   * @generated from field: string format = 7;
+   */
+  format: string;
+
+  /**
+   * @generated from field: string max_value = 8;
+   */
+  maxValue: string;
+
+  /**
+   * @generated from field: string min_value = 9;
+   */
+  minValue: string;
+};
+
+/**
+ * JSON type for the message widget.v1.DateTimeInput.
+ */
+export type DateTimeInputJson = {
+  /**
+   * @generated from field: optional string value = 1;
+   */
+  value?: string;
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label?: string;
+
+  /**
+   * @generated from field: string placeholder = 3;
+   */
+  placeholder?: string;
+
+  /**
+   * @generated from field: optional string default_value = 4;
+   */
+  defaultValue?: string;
+
+  /**
+   * @generated from field: bool required = 5;
+   */
+  required?: boolean;
+
+  /**
+   * @generated from field: bool disabled = 6;
+   */
+  disabled?: boolean;
+
+  /**
+   * @generated from field: string format = 7;
+   */
+  format?: string;
+
+  /**
+   * @generated from field: string max_value = 8;
+   */
+  maxValue?: string;
+
+  /**
+   * @generated from field: string min_value = 9;
+   */
+  minValue?: string;
+};
+
+/**
+ * Describes the message widget.v1.DateTimeInput.
+ * Use `create(DateTimeInputSchema)` to create a new message.
+ */
+export const DateTimeInputSchema: GenMessage<DateTimeInput, DateTimeInputJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 13);
+
+/**
+ * @generated from message widget.v1.Dialog
+ */
+export type Dialog = Message<"widget.v1.Dialog"> & {
+  /**
+   * @generated from field: bool value = 1;
+   */
+  value: boolean;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title: string;
+
+  /**
+   * @generated from field: bool open = 3;
+   */
+  open: boolean;
+
+  /**
+   * @generated from field: bool close_on_submit = 4;
+   */
+  closeOnSubmit: boolean;
+};
+
+/**
+ * JSON type for the message widget.v1.Dialog.
+ */
+export type DialogJson = {
+  /**
+   * @generated from field: bool value = 1;
+   */
+  value?: boolean;
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title?: string;
+
+  /**
+   * @generated from field: bool open = 3;
+   */
+  open?: boolean;
+
+  /**
+   * @generated from field: bool close_on_submit = 4;
+   */
+  closeOnSubmit?: boolean;
+};
+
+/**
+ * Describes the message widget.v1.Dialog.
+ * Use `create(DialogSchema)` to create a new message.
+ */
+export const DialogSchema: GenMessage<Dialog, DialogJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 14);
+
+/**
+ * @generated from message widget.v1.Divider
+ */
+export type Divider = Message<"widget.v1.Divider"> & {
+};
+
+/**
+ * JSON type for the message widget.v1.Divider.
+ */
+export type DividerJson = {
+};
+
+/**
+ * Describes the message widget.v1.Divider.
+ * Use `create(DividerSchema)` to create a new message.
+ */
+export const DividerSchema: GenMessage<Divider, DividerJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 15);
+
+/**
+ * @generated from message widget.v1.DownloadButton
+ */
+export type DownloadButton = Message<"widget.v1.DownloadButton"> & {
+  /**
+   * @generated from field: string label = 1;
+   */
+  label: string;
+
+  /**
+   * @generated from field: string file_name = 2;
+   */
+  fileName: string;
+
+  /**
+   * @generated from field: string mime_type = 3;
+   */
+  mimeType: string;
+
+  /**
+   * @generated from field: int64 size = 4;
+   */
+  size: bigint;
+
+  /**
+   * @generated from field: bool disabled = 5;
+   */
+  disabled: boolean;
+};
+
+/**
+ * JSON type for the message widget.v1.DownloadButton.
+ */
+export type DownloadButtonJson = {
+  /**
+   * @generated from field: string label = 1;
+   */
+  label?: string;
+
+  /**
+   * @generated from field: string file_name = 2;
+   */
+  fileName?: string;
+
+  /**
+   * @generated from field: string mime_type = 3;
+   */
+  mimeType?: string;
+
+  /**
+   * @generated from field: int64 size = 4;
+   */
+  size?: string;
+
+  /**
+   * @generated from field: bool disabled = 5;
+   */
+  disabled?: boolean;
+};
+
+/**
+ * Describes the message widget.v1.DownloadButton.
+ * Use `create(DownloadButtonSchema)` to create a new message.
+ */
+export const DownloadButtonSchema: GenMessage<DownloadButton, DownloadButtonJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 16);
+
+/**
+ * @generated from message widget.v1.Expander
+ */
+export type Expander = Message<"widget.v1.Expander"> & {
+  /**
+   * @generated from field: bool value = 1;
+   */
+  value: boolean;
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label: string;
+};
+
+/**
+ * JSON type for the message widget.v1.Expander.
+ */
+export type ExpanderJson = {
+  /**
+   * @generated from field: bool value = 1;
+   */
+  value?: boolean;
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label?: string;
+};
+
+/**
+ * Describes the message widget.v1.Expander.
+ * Use `create(ExpanderSchema)` to create a new message.
+ */
+export const ExpanderSchema: GenMessage<Expander, ExpanderJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 17);
+
+/**
+ * @generated from message widget.v1.FileInput
+ */
+export type FileInput = Message<"widget.v1.FileInput"> & {
+  /**
+   * @generated from field: repeated widget.v1.FileInputFile value = 1;
+   */
+  value: FileInputFile[];
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label: string;
+
+  /**
+   * @generated from field: repeated string accept = 3;
+   */
+  accept: string[];
+
+  /**
+   * @generated from field: optional int64 max_file_size = 4;
+   */
+  maxFileSize?: bigint;
+
+  /**
+   * @generated from field: bool multiple = 5;
+   */
+  multiple: boolean;
+
+  /**
+   * @generated from field: bool required = 6;
+   */
+  required: boolean;
+
+  /**
+   * @generated from field: bool disabled = 7;
+   */
+  disabled: boolean;
+};
+
+/**
+ * JSON type for the message widget.v1.FileInput.
+ */
+export type FileInputJson = {
+  /**
+   * @generated from field: repeated widget.v1.FileInputFile value = 1;
+   */
+  value?: FileInputFileJson[];
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label?: string;
+
+  /**
+   * @generated from field: repeated string accept = 3;
+   */
+  accept?: string[];
+
+  /**
+   * @generated from field: optional int64 max_file_size = 4;
+   */
+  maxFileSize?: string;
+
+  /**
+   * @generated from field: bool multiple = 5;
+   */
+  multiple?: boolean;
+
+  /**
+   * @generated from field: bool required = 6;
+   */
+  required?: boolean;
+
+  /**
+   * @generated from field: bool disabled = 7;
+   */
+  disabled?: boolean;
+};
+
+/**
+ * Describes the message widget.v1.FileInput.
+ * Use `create(FileInputSchema)` to create a new message.
+ */
+export const FileInputSchema: GenMessage<FileInput, FileInputJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 18);
+
+/**
+ * @generated from message widget.v1.FileInputFile
+ */
+export type FileInputFile = Message<"widget.v1.FileInputFile"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string mime_type = 3;
+   */
+  mimeType: string;
+
+  /**
+   * @generated from field: int64 size = 4;
+   */
+  size: bigint;
+};
+
+/**
+ * JSON type for the message widget.v1.FileInputFile.
+ */
+export type FileInputFileJson = {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id?: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name?: string;
+
+  /**
+   * @generated from field: string mime_type = 3;
+   */
+  mimeType?: string;
+
+  /**
+   * @generated from field: int64 size = 4;
+   */
+  size?: string;
+};
+
+/**
+ * Describes the message widget.v1.FileInputFile.
+ * Use `create(FileInputFileSchema)` to create a new message.
+ */
+export const FileInputFileSchema: GenMessage<FileInputFile, FileInputFileJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 19);
+
+/**
+ * @generated from message widget.v1.Form
+ */
+export type Form = Message<"widget.v1.Form"> & {
+  /**
+   * @generated from field: bool value = 1;
+   */
+  value: boolean;
+
+  /**
+   * @generated from field: string button_label = 2;
+   */
+  buttonLabel: string;
+
+  /**
+   * @generated from field: bool button_disabled = 3;
+   */
+  buttonDisabled: boolean;
+
+  /**
+   * @generated from field: bool clear_on_submit = 4;
+   */
+  clearOnSubmit: boolean;
+};
+
+/**
+ * JSON type for the message widget.v1.Form.
+ */
+export type FormJson = {
+  /**
+   * @generated from field: bool value = 1;
+   */
+  value?: boolean;
+
+  /**
+   * @generated from field: string button_label = 2;
+   */
+  buttonLabel?: string;
+
+  /**
+   * @generated from field: bool button_disabled = 3;
+   */
+  buttonDisabled?: boolean;
+
+  /**
+   * @generated from field: bool clear_on_submit = 4;
+   */
+  clearOnSubmit?: boolean;
+};
+
+/**
+ * Describes the message widget.v1.Form.
+ * Use `create(FormSchema)` to create a new message.
+ */
+export const FormSchema: GenMessage<Form, FormJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 20);
+
+/**
+ * @generated from message widget.v1.Header
+ */
+export type Header = Message<"widget.v1.Header"> & {
+  /**
+   * @generated from field: string text = 1;
+   */
+  text: string;
+};
+
+/**
+ * JSON type for the message widget.v1.Header.
+ */
+export type HeaderJson = {
+  /**
+   * @generated from field: string text = 1;
+   */
+  text?: string;
+};
+
+/**
+ * Describes the message widget.v1.Header.
+ * Use `create(HeaderSchema)` to create a new message.
+ */
+export const HeaderSchema: GenMessage<Header, HeaderJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 21);
+
+/**
+ * @generated from message widget.v1.Image
+ */
+export type Image = Message<"widget.v1.Image"> & {
+  /**
+   * @generated from field: string url = 1;
+   */
+  url: string;
+
+  /**
+   * @generated from field: string mime_type = 2;
+   */
+  mimeType: string;
+
+  /**
+   * @generated from field: int64 size = 3;
+   */
+  size: bigint;
+
+  /**
+   * @generated from field: optional int32 width = 4;
+   */
+  width?: number;
+
+  /**
+   * @generated from field: string caption = 5;
+   */
+  caption: string;
+};
+
+/**
+ * JSON type for the message widget.v1.Image.
+ */
+export type ImageJson = {
+  /**
+   * @generated from field: string url = 1;
+   */
+  url?: string;
+
+  /**
+   * @generated from field: string mime_type = 2;
+   */
+  mimeType?: string;
+
+  /**
+   * @generated from field: int64 size = 3;
+   */
+  size?: string;
+
+  /**
+   * @generated from field: optional int32 width = 4;
+   */
+  width?: number;
+
+  /**
+   * @generated from field: string caption = 5;
+   */
+  caption?: string;
+};
+
+/**
+ * Describes the message widget.v1.Image.
+ * Use `create(ImageSchema)` to create a new message.
+ */
+export const ImageSchema: GenMessage<Image, ImageJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 22);
+
+/**
+ * @generated from message widget.v1.Json
+ */
+export type Json = Message<"widget.v1.Json"> & {
+  /**
+   * @generated from field: bytes data = 1;
+   */
+  data: Uint8Array;
+
+  /**
+   * @generated from field: int32 expanded_depth = 2;
+   */
+  expandedDepth: number;
+
+  /**
+   * @generated from field: string error = 3;
+   */
+  error: string;
+};
+
+/**
+ * JSON type for the message widget.v1.Json.
+ */
+export type JsonJson = {
+  /**
+   * @generated from field: bytes data = 1;
+   */
+  data?: string;
+
+  /**
+   * @generated from field: int32 expanded_depth = 2;
+   */
+  expandedDepth?: number;
+
+  /**
+   * @generated from field: string error = 3;
+   */
+  error?: string;
+};
+
+/**
+ * Describes the message widget.v1.Json.
+ * Use `create(JsonSchema)` to create a new message.
+ */
+export const JsonSchema: GenMessage<Json, JsonJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 23);
+
+/**
+ * @generated from message widget.v1.Link
+ */
+export type Link = Message<"widget.v1.Link"> & {
+  /**
+   * @generated from field: string label = 1;
+   */
+  label: string;
+
+  /**
+   * @generated from field: string url = 2;
+   */
+  url: string;
+};
+
+/**
+ * JSON type for the message widget.v1.Link.
+ */
+export type LinkJson = {
+  /**
+   * @generated from field: string label = 1;
+   */
+  label?: string;
+
+  /**
+   * @generated from field: string url = 2;
+   */
+  url?: string;
+};
+
+/**
+ * Describes the message widget.v1.Link.
+ * Use `create(LinkSchema)` to create a new message.
+ */
+export const LinkSchema: GenMessage<Link, LinkJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 24);
+
+/**
+ * @generated from message widget.v1.Markdown
+ */
+export type Markdown = Message<"widget.v1.Markdown"> & {
+  /**
+   * @generated from field: string body = 1;
+   */
+  body: string;
+};
+
+/**
+ * JSON type for the message widget.v1.Markdown.
+ */
+export type MarkdownJson = {
+  /**
+   * @generated from field: string body = 1;
+   */
+  body?: string;
+};
+
+/**
+ * Describes the message widget.v1.Markdown.
+ * Use `create(MarkdownSchema)` to create a new message.
+ */
+export const MarkdownSchema: GenMessage<Markdown, MarkdownJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 25);
+
+/**
+ * @generated from message widget.v1.Metric
+ */
+export type Metric = Message<"widget.v1.Metric"> & {
+  /**
+   * @generated from field: string label = 1;
+   */
+  label: string;
+
+  /**
+   * @generated from field: string value = 2;
+   */
+  value: string;
+
+  /**
+   * @generated from field: optional string delta = 3;
+   */
+  delta?: string;
+
+  /**
+   * @generated from field: string delta_direction = 4;
+   */
+  deltaDirection: string;
+
+  /**
+   * @generated from field: string delta_color = 5;
+   */
+  deltaColor: string;
+};
+
+/**
+ * JSON type for the message widget.v1.Metric.
+ */
+export type MetricJson = {
+  /**
+   * @generated from field: string label = 1;
+   */
+  label?: string;
+
+  /**
+   * @generated from field: string value = 2;
+   */
+  value?: string;
+
+  /**
+   * @generated from field: optional string delta = 3;
+   */
+  delta?: string;
+
+  /**
+   * @generated from field: string delta_direction = 4;
+   */
+  deltaDirection?: string;
+
+  /**
+   * @generated from field: string delta_color = 5;
+   */
+  deltaColor?: string;
+};
+
+/**
+ * Describes the message widget.v1.Metric.
+ * Use `create(MetricSchema)` to create a new message.
+ */
+export const MetricSchema: GenMessage<Metric, MetricJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 26);
+
+/**
+ * @generated from message widget.v1.MultiSelect
+ */
+export type MultiSelect = Message<"widget.v1.MultiSelect"> & {
+  /**
+   * @generated from field: repeated int32 value = 1;
+   */
+  value: number[];
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label: string;
+
+  /**
+   * @generated from field: repeated string options = 3;
+   */
+  options: string[];
+
+  /**
+   * @generated from field: string placeholder = 4;
+   */
+  placeholder: string;
+
+  /**
+   * @generated from field: repeated int32 default_value = 5;
+   */
+  defaultValue: number[];
+
+  /**
+   * @generated from field: bool required = 6;
+   */
+  required: boolean;
+
+  /**
+   * @generated from field: bool disabled = 7;
+   */
+  disabled: boolean;
+};
+
+/**
+ * JSON type for the message widget.v1.MultiSelect.
+ */
+export type MultiSelectJson = {
+  /**
+   * @generated from field: repeated int32 value = 1;
+   */
+  value?: number[];
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label?: string;
+
+  /**
+   * @generated from field: repeated string options = 3;
+   */
+  options?: string[];
+
+  /**
+   * @generated from field: string placeholder = 4;
+   */
+  placeholder?: string;
+
+  /**
+   * @generated from field: repeated int32 default_value = 5;
+   */
+  defaultValue?: number[];
+
+  /**
+   * @generated from field: bool required = 6;
+   */
+  required?: boolean;
+
+  /**
+   * @generated from field: bool disabled = 7;
+   */
+  disabled?: boolean;
+};
+
+/**
+ * Describes the message widget.v1.MultiSelect.
+ * Use `create(MultiSelectSchema)` to create a new message.
+ */
+export const MultiSelectSchema: GenMessage<MultiSelect, MultiSelectJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 27);
+
+/**
+ * @generated from message widget.v1.NumberInput
+ */
+export type NumberInput = Message<"widget.v1.NumberInput"> & {
+  /**
+   * @generated from field: optional double value = 1;
+   */
+  value?: number;
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label: string;
+
+  /**
+   * @generated from field: string placeholder = 3;
+   */
+  placeholder: string;
+
+  /**
+   * @generated from field: optional double default_value = 4;
+   */
+  defaultValue?: number;
+
+  /**
+   * @generated from field: bool required = 5;
+   */
+  required: boolean;
+
+  /**
+   * @generated from field: bool disabled = 6;
+   */
+  disabled: boolean;
+
+  /**
+   * @generated from field: optional double max_value = 7;
+   */
+  maxValue?: number;
+
+  /**
+   * @generated from field: optional double min_value = 8;
+   */
+  minValue?: number;
+};
+
+/**
+ * JSON type for the message widget.v1.NumberInput.
+ */
+export type NumberInputJson = {
+  /**
+   * @generated from field: optional double value = 1;
+   */
+  value?: number | "NaN" | "Infinity" | "-Infinity";
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label?: string;
+
+  /**
+   * @generated from field: string placeholder = 3;
+   */
+  placeholder?: string;
+
+  /**
+   * @generated from field: optional double default_value = 4;
+   */
+  defaultValue?: number | "NaN" | "Infinity" | "-Infinity";
+
+  /**
+   * @generated from field: bool required = 5;
+   */
+  required?: boolean;
+
+  /**
+   * @generated from field: bool disabled = 6;
+   */
+  disabled?: boolean;
+
+  /**
+   * @generated from field: optional double max_value = 7;
+   */
+  maxValue?: number | "NaN" | "Infinity" | "-Infinity";
+
+  /**
+   * @generated from field: optional double min_value = 8;
+   */
+  minValue?: number | "NaN" | "Infinity" | "-Infinity";
+};
+
+/**
+ * Describes the message widget.v1.NumberInput.
+ * Use `create(NumberInputSchema)` to create a new message.
+ */
+export const NumberInputSchema: GenMessage<NumberInput, NumberInputJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 28);
+
+/**
+ * @generated from message widget.v1.PageLink
+ */
+export type PageLink = Message<"widget.v1.PageLink"> & {
+  /**
+   * @generated from field: string label = 1;
+   */
+  label: string;
+
+  /**
+   * @generated from field: string page_id = 2;
+   */
+  pageId: string;
+
+  /**
+   * @generated from field: string route = 3;
+   */
+  route: string;
+
+  /**
+   * @generated from field: string query = 4;
+   */
+  query: string;
+
+  /**
+   * @generated from field: bool disabled = 5;
+   */
+  disabled: boolean;
+};
+
+/**
+ * JSON type for the message widget.v1.PageLink.
+ */
+export type PageLinkJson = {
+  /**
+   * @generated from field: string label = 1;
+   */
+  label?: string;
+
+  /**
+   * @generated from field: string page_id = 2;
+   */
+  pageId?: string;
+
+  /**
+   * @generated from field: string route = 3;
+   */
+  route?: string;
+
+  /**
+   * @generated from field: string query = 4;
+   */
+  query?: string;
+
+  /**
+   * @generated from field: bool disabled = 5;
+   */
+  disabled?: boolean;
+};
+
+/**
+ * Describes the message widget.v1.PageLink.
+ * Use `create(PageLinkSchema)` to create a new message.
+ */
+export const PageLinkSchema: GenMessage<PageLink, PageLinkJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 29);
+
+/**
+ * @generated from message widget.v1.Progress
+ */
+export type Progress = Message<"widget.v1.Progress"> & {
+  /**
+   * @generated from field: string label = 1;
+   */
+  label: string;
+
+  /**
+   * @generated from field: double value = 2;
+   */
+  value: number;
+
+  /**
+   * @generated from field: string text = 3;
+   */
+  text: string;
+};
+
+/**
+ * JSON type for the message widget.v1.Progress.
+ */
+export type ProgressJson = {
+  /**
+   * @generated from field: string label = 1;
+   */
+  label?: string;
+
+  /**
+   * @generated from field: double value = 2;
+   */
+  value?: number | "NaN" | "Infinity" | "-Infinity";
+
+  /**
+   * @generated from field: string text = 3;
+   */
+  text?: string;
+};
+
+/**
+ * Describes the message widget.v1.Progress.
+ * Use `create(ProgressSchema)` to create a new message.
+ */
+export const ProgressSchema: GenMessage<Progress, ProgressJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 30);
+
+/**
+ * @generated from message widget.v1.Radio
+ */
+export type Radio = Message<"widget.v1.Radio"> & {
+  /**
+   * @generated from field: optional int32 value = 1;
+   */
+  value?: number;
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label: string;
+
+  /**
+   * @generated from field: repeated string options = 3;
+   */
+  options: string[];
+
+  /**
+   * @generated from field: optional int32 default_value = 4;
+   */
+  defaultValue?: number;
+
+  /**
+   * @generated from field: bool required = 5;
+   */
+  required: boolean;
+
+  /**
+   * @generated from field: bool disabled = 6;
+   */
+  disabled: boolean;
+};
+
+/**
+ * JSON type for the message widget.v1.Radio.
+ */
+export type RadioJson = {
+  /**
+   * @generated from field: optional int32 value = 1;
+   */
+  value?: number;
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label?: string;
+
+  /**
+   * @generated from field: repeated string options = 3;
+   */
+  options?: string[];
+
+  /**
+   * @generated from field: optional int32 default_value = 4;
+   */
+  defaultValue?: number;
+
+  /**
+   * @generated from field: bool required = 5;
+   */
+  required?: boolean;
+
+  /**
+   * @generated from field: bool disabled = 6;
+   */
+  disabled?: boolean;
+};
+
+/**
+ * Describes the message widget.v1.Radio.
+ * Use `create(RadioSchema)` to create a new message.
+ */
+export const RadioSchema: GenMessage<Radio, RadioJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 31);
+
+/**
+ * @generated from message widget.v1.RangeSlider
+ */
+export type RangeSlider = Message<"widget.v1.RangeSlider"> & {
+  /**
+   * @generated from field: double low = 1;
+   */
+  low: number;
+
+  /**
+   * @generated from field: double high = 2;
+   */
+  high: number;
+
+  /**
+   * @generated from field: string label = 3;
+   */
+  label: string;
+
+  /**
+   * @generated from field: double default_low = 4;
+   */
+  defaultLow: number;
+
+  /**
+   * @generated from field: double default_high = 5;
+   */
+  defaultHigh: number;
+
+  /**
+   * @generated from field: double min_value = 6;
+   */
+  minValue: number;
+
+  /**
+   * @generated from field: double max_value = 7;
+   */
+  maxValue: number;
+
+  /**
+   * @generated from field: double step = 8;
+   */
+  step: number;
+
+  /**
+   * @generated from field: bool disabled = 9;
+   */
+  disabled: boolean;
+};
+
+/**
+ * JSON type for the message widget.v1.RangeSlider.
+ */
+export type RangeSliderJson = {
+  /**
+   * @generated from field: double low = 1;
+   */
+  low?: number | "NaN" | "Infinity" | "-Infinity";
+
+  /**
+   * @generated from field: double high = 2;
+   */
+  high?: number | "NaN" | "Infinity" | "-Infinity";
+
+  /**
+   * @generated from field: string label = 3;
+   */
+  label?: string;
+
+  /**
+   * @generated from field: double default_low = 4;
+   */
+  defaultLow?: number | "NaN" | "Infinity" | "-Infinity";
+
+  /**
+   * @generated from field: double default_high = 5;
+   */
+  defaultHigh?: number | "NaN" | "Infinity" | "-Infinity";
+
+  /**
+   * @generated from field: double min_value = 6;
+   */
+  minValue?: number | "NaN" | "Infinity" | "-Infinity";
+
+  /**
+   * @generated from field: double max_value = 7;
+   */
+  maxValue?: number | "NaN" | "Infinity" | "-Infinity";
+
+  /**
+   * @generated from field: double step = 8;
+   */
+  step?: number | "NaN" | "Infinity" | "-Infinity";
+
+  /**
+   * @generated from field: bool disabled = 9;
+   */
+  disabled?: boolean;
+};
+
+/**
+ * Describes the message widget.v1.RangeSlider.
+ * Use `create(RangeSliderSchema)` to create a new message.
+ */
+export const RangeSliderSchema: GenMessage<RangeSlider, RangeSliderJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 32);
+
+/**
+ * @generated from message widget.v1.Selectbox
+ */
+export type Selectbox = Message<"widget.v1.Selectbox"> & {
+  /**
+   * @generated from field: optional int32 value = 1;
+   */
+  value?: number;
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label: string;
+
+  /**
+   * @generated from field: repeated string options = 3;
+   */
+  options: string[];
+
+  /**
+   * @generated from field: string placeholder = 4;
+   */
+  placeholder: string;
+
+  /**
+   * @generated from field: optional int32 default_value = 5;
+   */
+  defaultValue?: number;
+
+  /**
+   * @generated from field: bool required = 6;
+   */
+  required: boolean;
+
+  /**
+   * @generated from field: bool disabled = 7;
+   */
+  disabled: boolean;
+};
+
+/**
+ * JSON type for the message widget.v1.Selectbox.
+ */
+export type SelectboxJson = {
+  /**
+   * @generated from field: optional int32 value = 1;
+   */
+  value?: number;
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label?: string;
+
+  /**
+   * @generated from field: repeated string options = 3;
+   */
+  options?: string[];
+
+  /**
+   * @generated from field: string placeholder = 4;
+   */
+  placeholder?: string;
+
+  /**
+   * @generated from field: optional int32 default_value = 5;
+   */
+  defaultValue?: number;
+
+  /**
+   * @generated from field: bool required = 6;
+   */
+  required?: boolean;
+
+  /**
+   * @generated from field: bool disabled = 7;
+   */
+  disabled?: boolean;
+};
+
+/**
+ * Describes the message widget.v1.Selectbox.
+ * Use `create(SelectboxSchema)` to create a new message.
+ */
+export const SelectboxSchema: GenMessage<Selectbox, SelectboxJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 33);
+
+/**
+ * @generated from message widget.v1.Slider
+ */
+export type Slider = Message<"widget.v1.Slider"> & {
+  /**
+   * @generated from field: double value = 1;
+   */
+  value: number;
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label: string;
+
+  /**
+   * @generated from field: double default_value = 3;
+   */
+  defaultValue: number;
+
+  /**
+   * @generated from field: double min_value = 4;
+   */
+  minValue: number;
+
+  /**
+   * @generated from field: double max_value = 5;
+   */
+  maxValue: number;
+
+  /**
+   * @generated from field: double step = 6;
+   */
+  step: number;
+
+  /**
+   * @generated from field: bool disabled = 7;
+   */
+  disabled: boolean;
+};
+
+/**
+ * JSON type for the message widget.v1.Slider.
+ */
+export type SliderJson = {
+  /**
+   * @generated from field: double value = 1;
+   */
+  value?: number | "NaN" | "Infinity" | "-Infinity";
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label?: string;
+
+  /**
+   * @generated from field: double default_value = 3;
+   */
+  defaultValue?: number | "NaN" | "Infinity" | "-Infinity";
+
+  /**
+   * @generated from field: double min_value = 4;
+   */
+  minValue?: number | "NaN" | "Infinity" | "-Infinity";
+
+  /**
+   * @generated from field: double max_value = 5;
+   */
+  maxValue?: number | "NaN" | "Infinity" | "-Infinity";
+
+  /**
+   * @generated from field: double step = 6;
+   */
+  step?: number | "NaN" | "Infinity" | "-Infinity";
+
+  /**
+   * @generated from field: bool disabled = 7;
+   */
+  disabled?: boolean;
+};
+
+/**
+ * Describes the message widget.v1.Slider.
+ * Use `create(SliderSchema)` to create a new message.
+ */
+export const SliderSchema: GenMessage<Slider, SliderJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 34);
+
+/**
+ * @generated from message widget.v1.Spacer
+ */
+export type Spacer = Message<"widget.v1.Spacer"> & {
+};
+
+/**
+ * JSON type for the message widget.v1.Spacer.
+ */
+export type SpacerJson = {
+};
+
+/**
+ * Describes the message widget.v1.Spacer.
+ * Use `create(SpacerSchema)` to create a new message.
+ */
+export const SpacerSchema: GenMessage<Spacer, SpacerJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 35);
+
+/**
+ * @generated from message widget.v1.Spinner
+ */
+export type Spinner = Message<"widget.v1.Spinner"> & {
+  /**
+   * @generated from field: string text = 1;
+   */
+  text: string;
+
+  /**
+   * @generated from field: bool active = 2;
+   */
+  active: boolean;
+};
+
+/**
+ * JSON type for the message widget.v1.Spinner.
+ */
+export type SpinnerJson = {
+  /**
+   * @generated from field: string text = 1;
+   */
+  text?: string;
+
+  /**
+   * @generated from field: bool active = 2;
+   */
+  active?: boolean;
+};
+
+/**
+ * Describes the message widget.v1.Spinner.
+ * Use `create(SpinnerSchema)` to create a new message.
+ */
+export const SpinnerSchema: GenMessage<Spinner, SpinnerJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 36);
+
+/**
+ * @generated from message widget.v1.Subheader
+ */
+export type Subheader = Message<"widget.v1.Subheader"> & {
+  /**
+   * @generated from field: string text = 1;
+   */
+  text: string;
+};
+
+/**
+ * JSON type for the message widget.v1.Subheader.
+ */
+export type SubheaderJson = {
+  /**
+   * @generated from field: string text = 1;
+   */
+  text?: string;
+};
+
+/**
+ * Describes the message widget.v1.Subheader.
+ * Use `create(SubheaderSchema)` to create a new message.
+ */
+export const SubheaderSchema: GenMessage<Subheader, SubheaderJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 37);
+
+/**
+ * @generated from message widget.v1.TabItem
+ */
+export type TabItem = Message<"widget.v1.TabItem"> & {
+  /**
+   * @generated from field: string label = 1;
+   */
+  label: string;
+};
+
+/**
+ * JSON type for the message widget.v1.TabItem.
+ */
+export type TabItemJson = {
+  /**
+   * @generated from field: string label = 1;
+   */
+  label?: string;
+};
+
+/**
+ * Describes the message widget.v1.TabItem.
+ * Use `create(TabItemSchema)` to create a new message.
+ */
+export const TabItemSchema: GenMessage<TabItem, TabItemJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 38);
+
+/**
+ * @generated from message widget.v1.Table
+ */
+export type Table = Message<"widget.v1.Table"> & {
+  /**
+   * @generated from field: bytes data = 1;
+   */
+  data: Uint8Array;
+
+  /**
+   * @generated from field: widget.v1.TableValue value = 2;
+   */
+  value?: TableValue;
+
+  /**
+   * @generated from field: string header = 3;
+   */
+  header: string;
+
+  /**
+   * @generated from field: string description = 4;
+   */
+  description: string;
+
+  /**
+   * @generated from field: optional int32 height = 5;
+   */
+  height?: number;
+
+  /**
+   * @generated from field: repeated string column_order = 6;
+   */
+  columnOrder: string[];
+
+  /**
+   * @generated from field: string on_select = 7;
+   */
+  onSelect: string;
+
+  /**
+   * @generated from field: string row_selection = 8;
+   */
+  rowSelection: string;
+
+  /**
+   * @generated from field: bool paginated = 9;
+   */
+  paginated: boolean;
+
+  /**
+   * @generated from field: int64 total_rows = 10;
+   */
+  totalRows: bigint;
+
+  /**
+   * @generated from field: repeated string editable_columns = 11;
+   */
+  editableColumns: string[];
+
+  /**
+   * @generated from field: repeated string row_actions = 12;
+   */
+  rowActions: string[];
+
+  /**
+   * @generated from field: repeated widget.v1.TableColumn columns = 13;
+   */
+  columns: TableColumn[];
+
+  /**
+   * @generated from field: string error = 14;
+   */
+  error: string;
+};
+
+/**
+ * JSON type for the message widget.v1.Table.
+ */
+export type TableJson = {
+  /**
+   * @generated from field: bytes data = 1;
+   */
+  data?: string;
+
+  /**
+   * @generated from field: widget.v1.TableValue value = 2;
+   */
+  value?: TableValueJson;
+
+  /**
+   * @generated from field: string header = 3;
+   */
+  header?: string;
+
+  /**
+   * @generated from field: string description = 4;
+   */
+  description?: string;
+
+  /**
+   * @generated from field: optional int32 height = 5;
+   */
+  height?: number;
+
+  /**
+   * @generated from field: repeated string column_order = 6;
+   */
+  columnOrder?: string[];
+
+  /**
+   * @generated from field: string on_select = 7;
+   */
+  onSelect?: string;
+
+  /**
+   * @generated from field: string row_selection = 8;
+   */
+  rowSelection?: string;
+
+  /**
+   * @generated from field: bool paginated = 9;
+   */
+  paginated?: boolean;
+
+  /**
+   * @generated from field: int64 total_rows = 10;
+   */
+  totalRows?: string;
+
+  /**
+   * @generated from field: repeated string editable_columns = 11;
+   */
+  editableColumns?: string[];
+
+  /**
+   * @generated from field: repeated string row_actions = 12;
+   */
+  rowActions?: string[];
+
+  /**
+   * @generated from field: repeated widget.v1.TableColumn columns = 13;
+   */
+  columns?: TableColumnJson[];
+
+  /**
+   * @generated from field: string error = 14;
+   */
+  error?: string;
+};
+
+/**
+ * Describes the message widget.v1.Table.
+ * Use `create(TableSchema)` to create a new message.
+ */
+export const TableSchema: GenMessage<Table, TableJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 39);
+
+/**
+ * @generated from message widget.v1.TableColumn
+ */
+export type TableColumn = Message<"widget.v1.TableColumn"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label: string;
+
+  /**
+   * @generated from field: optional widget.v1.TableColumnFormat format = 3;
+   */
+  format?: TableColumnFormat;
+};
+
+/**
+ * JSON type for the message widget.v1.TableColumn.
+ */
+export type TableColumnJson = {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name?: string;
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label?: string;
+
+  /**
+   * @generated from field: optional widget.v1.TableColumnFormat format = 3;
    */
-  minValue?: number;
+  format?: TableColumnFormatJson;
 };
 
 /**
- * JSON type for the message widget.v1.NumberInput.
+ * Describes the message widget.v1.TableColumn.
+ * Use `create(TableColumnSchema)` to create a new message.
  */
-export type NumberInputJson = {
+export const TableColumnSchema: GenMessage<TableColumn, TableColumnJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 40);
+
+/**
+ * @generated from message widget.v1.TableColumnFormat
+ */
+export type TableColumnFormat = Message<"widget.v1.TableColumnFormat"> & {
   /**
-   * @generated from field: optional double value = 1;
+   * @generated from field: string type = 1;
    */
-  value?: number | "NaN" | "Infinity" | "-Infinity";
+  type: string;
 
   /**
-   * @generated from field: string label = 2;
+   * @generated from field: optional int32 decimals = 2;
    */
-  label?: string;
+  decimals?: number;
 
   /**
-   * @generated from field: string placeholder = 3;
+   * @generated from field: string currency = 3;
    */
-  placeholder?: string;
+  currency: string;
 
   /**
-   * @generated from field: optional double default_value = 4;
+   * @generated from field: string date_format = 4;
    */
-  defaultValue?: number | "NaN" | "Infinity" | "-Infinity";
+  dateFormat: string;
+};
 
+/**
+ * JSON type for the message widget.v1.TableColumnFormat.
+ */
+export type TableColumnFormatJson = {
   /**
-   * @generated from field: bool required = 5;
+   * @generated from field: string type = 1;
    */
-  required?: boolean;
+  type?: string;
 
   /**
-   * @generated from field: bool disabled = 6;
+   * @generated from field: optional int32 decimals = 2;
    */
-  disabled?: boolean;
+  decimals?: number;
 
   /**
-   * @generated from field: optional double max_value = 7;
+   * @generated from field: string currency = 3;
    */
-  maxValue?: number | "NaN" | "Infinity" | "-Infinity";
+  currency?: string;
 
   /**
-   * @generated from field: optional double min_value = 8;
+   * @generated from field: string date_format = 4;
    */
-  minValue?: number | "NaN" | "Infinity" | "-Infinity";
+  dateFormat?: string;
 };
 
 /**
- * Describes the message widget.v1.NumberInput.
- * Use `create(NumberInputSchema)` to create a new message.
+ * Describes the message widget.v1.TableColumnFormat.
+ * Use `create(TableColumnFormatSchema)` to create a new message.
  */
-export const NumberInputSchema: GenMessage<NumberInput, NumberInputJson> = /*@__PURE__*/
-  messageDesc(file_widget_v1_widget, 10);
+export const TableColumnFormatSchema: GenMessage<TableColumnFormat, TableColumnFormatJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 41);
 
 /**
- * @generated from message widget.v1.Radio
+ * @generated from message widget.v1.TableValue
  */
-export type Radio = Message<"widget.v1.Radio"> & {
+export type TableValue = Message<"widget.v1.TableValue"> & {
   /**
-   * @generated from field: optional int32 value = 1;
+   * @generated from field: optional widget.v1.TableValueSelection selection = 1;
    */
-  value?: number;
+  selection?: TableValueSelection;
 
   /**
-   * @generated from field: string label = 2;
+   * @generated from field: optional widget.v1.TableValuePagination pagination = 2;
    */
-  label: string;
+  pagination?: TableValuePagination;
 
   /**
-   * @generated from field: repeated string options = 3;
+   * @generated from field: optional widget.v1.TableValueSort sort = 3;
    */
-  options: string[];
+  sort?: TableValueSort;
 
   /**
-   * @generated from field: optional int32 default_value = 4;
+   * @generated from field: map<string, string> filters = 4;
    */
-  defaultValue?: number;
+  filters: { [key: string]: string };
 
   /**
-   * @generated from field: bool required = 5;
+   * @generated from field: repeated widget.v1.TableValueEdit edits = 5;
    */
-  required: boolean;
+  edits: TableValueEdit[];
 
   /**
-   * @generated from field: bool disabled = 6;
+   * @generated from field: optional widget.v1.TableValueAction action = 6;
    */
-  disabled: boolean;
+  action?: TableValueAction;
 };
 
 /**
- * JSON type for the message widget.v1.Radio.
+ * JSON type for the message widget.v1.TableValue.
  */
-export type RadioJson = {
+export type TableValueJson = {
   /**
-   * @generated from field: optional int32 value = 1;
+   * @generated from field: optional widget.v1.TableValueSelection selection = 1;
    */
-  value?: number;
+  selection?: TableValueSelectionJson;
 
   /**
-   * @generated from field: string label = 2;
+   * @generated from field: optional widget.v1.TableValuePagination pagination = 2;
    */
-  label?: string;
+  pagination?: TableValuePaginationJson;
 
   /**
-   * @generated from field: repeated string options = 3;
+   * @generated from field: optional widget.v1.TableValueSort sort = 3;
    */
-  options?: string[];
+  sort?: TableValueSortJson;
 
   /**
-   * @generated from field: optional int32 default_value = 4;
+   * @generated from field: map<string, string> filters = 4;
    */
-  defaultValue?: number;
+  filters?: { [key: string]: string };
 
   /**
-   * @generated from field: bool required = 5;
+   * @generated from field: repeated widget.v1.TableValueEdit edits = 5;
    */
-  required?: boolean;
+  edits?: TableValueEditJson[];
 
   /**
-   * @generated from field: bool disabled = 6;
+   * @generated from field: optional widget.v1.TableValueAction action = 6;
    */
-  disabled?: boolean;
+  action?: TableValueActionJson;
 };
 
 /**
- * Describes the message widget.v1.Radio.
- * Use `create(RadioSchema)` to create a new message.
+ * Describes the message widget.v1.TableValue.
+ * Use `create(TableValueSchema)` to create a new message.
  */
-export const RadioSchema: GenMessage<Radio, RadioJson> = /*@__PURE__*/
-  messageDesc(file_widget_v1_widget, 11);
+export const TableValueSchema: GenMessage<TableValue, TableValueJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 42);
 
 /**
- * @generated from message widget.v1.Selectbox
+ * @generated from message widget.v1.TableValueAction
  */
-export type Selectbox = Message<"widget.v1.Selectbox"> & {
+export type TableValueAction = Message<"widget.v1.TableValueAction"> & {
   /**
-   * @generated from field: optional int32 value = 1;
+   * @generated from field: int32 row = 1;
    */
-  value?: number;
+  row: number;
 
   /**
-   * @generated from field: string label = 2;
+   * @generated from field: string name = 2;
    */
-  label: string;
+  name: string;
+};
 
+/**
+ * JSON type for the message widget.v1.TableValueAction.
+ */
+export type TableValueActionJson = {
   /**
-   * @generated from field: repeated string options = 3;
+   * @generated from field: int32 row = 1;
    */
-  options: string[];
+  row?: number;
 
   /**
-   * @generated from field: string placeholder = 4;
+   * @generated from field: string name = 2;
    */
-  placeholder: string;
+  name?: string;
+};
+
+/**
+ * Describes the message widget.v1.TableValueAction.
+ * Use `create(TableValueActionSchema)` to create a new message.
+ */
+export const TableValueActionSchema: GenMessage<TableValueAction, TableValueActionJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 43);
 
+/**
+ * @generated from message widget.v1.TableValueEdit
+ */
+export type TableValueEdit = Message<"widget.v1.TableValueEdit"> & {
   /**
-   * @generated from field: optional int32 default_value = 5;
+   * @generated from field: int32 row = 1;
    */
-  defaultValue?: number;
+  row: number;
 
   /**
-   * @generated from field: bool required = 6;
+   * @generated from field: string column = 2;
    */
-  required: boolean;
+  column: string;
 
   /**
-   * @generated from field: bool disabled = 7;
+   * @generated from field: string old_value = 3;
    */
-  disabled: boolean;
+  oldValue: string;
+
+  /**
+   * @generated from field: string new_value = 4;
+   */
+  newValue: string;
 };
 
 /**
- * JSON type for the message widget.v1.Selectbox.
+ * JSON type for the message widget.v1.TableValueEdit.
  */
-export type SelectboxJson = {
+export type TableValueEditJson = {
   /**
-   * @generated from field: optional int32 value = 1;
+   * @generated from field: int32 row = 1;
    */
-  value?: number;
+  row?: number;
 
   /**
-   * @generated from field: string label = 2;
+   * @generated from field: string column = 2;
    */
-  label?: string;
+  column?: string;
 
   /**
-   * @generated from field: repeated string options = 3;
+   * @generated from field: string old_value = 3;
    */
-  options?: string[];
+  oldValue?: string;
 
   /**
-   * @generated from field: string placeholder = 4;
+   * @generated from field: string new_value = 4;
    */
-  placeholder?: string;
+  newValue?: string;
+};
+
+/**
+ * Describes the message widget.v1.TableValueEdit.
+ * Use `create(TableValueEditSchema)` to create a new message.
+ */
+export const TableValueEditSchema: GenMessage<TableValueEdit, TableValueEditJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 44);
 
+/**
+ * @generated from message widget.v1.TableValuePagination
+ */
+export type TableValuePagination = Message<"widget.v1.TableValuePagination"> & {
   /**
-   * @generated from field: optional int32 default_value = 5;
+   * @generated from field: int32 page = 1;
    */
-  defaultValue?: number;
+  page: number;
 
   /**
-   * @generated from field: bool required = 6;
+   * @generated from field: int32 page_size = 2;
    */
-  required?: boolean;
+  pageSize: number;
+};
 
+/**
+ * JSON type for the message widget.v1.TableValuePagination.
+ */
+export type TableValuePaginationJson = {
   /**
-   * @generated from field: bool disabled = 7;
+   * @generated from field: int32 page = 1;
    */
-  disabled?: boolean;
+  page?: number;
+
+  /**
+   * @generated from field: int32 page_size = 2;
+   */
+  pageSize?: number;
 };
 
 /**
- * Describes the message widget.v1.Selectbox.
- * Use `create(SelectboxSchema)` to create a new message.
+ * Describes the message widget.v1.TableValuePagination.
+ * Use `create(TableValuePaginationSchema)` to create a new message.
  */
-export const SelectboxSchema: GenMessage<Selectbox, SelectboxJson> = /*@__PURE__*/
-  messageDesc(file_widget_v1_widget, 12);
+export const TableValuePaginationSchema: GenMessage<TableValuePagination, TableValuePaginationJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 45);
 
 /**
- * @generated from message widget.v1.Table
+ * @generated from message widget.v1.TableValueSelection
  */
-export type Table = Message<"widget.v1.Table"> & {
+export type TableValueSelection = Message<"widget.v1.TableValueSelection"> & {
   /**
-   * @generated from field: bytes data = 1;
+   * @generated from field: int32 row = 1;
    */
-  data: Uint8Array;
+  row: number;
 
   /**
-   * @generated from field: widget.v1.TableValue value = 2;
+   * @generated from field: repeated int32 rows = 2;
    */
-  value?: TableValue;
+  rows: number[];
+};
 
+/**
+ * JSON type for the message widget.v1.TableValueSelection.
+ */
+export type TableValueSelectionJson = {
   /**
-   * @generated from field: string header = 3;
+   * @generated from field: int32 row = 1;
    */
-  header: string;
+  row?: number;
 
   /**
-   * @generated from field: string description = 4;
+   * @generated from field: repeated int32 rows = 2;
    */
-  description: string;
+  rows?: number[];
+};
+
+/**
+ * Describes the message widget.v1.TableValueSelection.
+ * Use `create(TableValueSelectionSchema)` to create a new message.
+ */
+export const TableValueSelectionSchema: GenMessage<TableValueSelection, TableValueSelectionJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 46);
 
+/**
+ * @generated from message widget.v1.TableValueSort
+ */
+export type TableValueSort = Message<"widget.v1.TableValueSort"> & {
   /**
-   * @generated from field: optional int32 height = 5;
+   * @generated from field: string column = 1;
    */
-  height?: number;
+  column: string;
 
   /**
-   * @generated from field: repeated string column_order = 6;
+   * @generated from field: string direction = 2;
    */
-  columnOrder: string[];
+  direction: string;
+};
 
+/**
+ * JSON type for the message widget.v1.TableValueSort.
+ */
+export type TableValueSortJson = {
   /**
-   * @generated from field: string on_select = 7;
+   * @generated from field: string column = 1;
    */
-  onSelect: string;
+  column?: string;
 
   /**
-   * @generated from field: string row_selection = 8;
+   * @generated from field: string direction = 2;
    */
-  rowSelection: string;
+  direction?: string;
 };
 
 /**
- * JSON type for the message widget.v1.Table.
+ * Describes the message widget.v1.TableValueSort.
+ * Use `create(TableValueSortSchema)` to create a new message.
  */
-export type TableJson = {
+export const TableValueSortSchema: GenMessage<TableValueSort, TableValueSortJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 47);
+
+/**
+ * @generated from message widget.v1.Tabs
+ */
+export type Tabs = Message<"widget.v1.Tabs"> & {
   /**
-   * @generated from field: bytes data = 1;
+   * @generated from field: int32 value = 1;
    */
-  data?: string;
+  value: number;
 
   /**
-   * @generated from field: widget.v1.TableValue value = 2;
+   * @generated from field: int32 tabs = 2;
    */
-  value?: TableValueJson;
+  tabs: number;
+};
 
+/**
+ * JSON type for the message widget.v1.Tabs.
+ */
+export type TabsJson = {
   /**
-   * @generated from field: string header = 3;
+   * @generated from field: int32 value = 1;
    */
-  header?: string;
+  value?: number;
 
   /**
-   * @generated from field: string description = 4;
+   * @generated from field: int32 tabs = 2;
    */
-  description?: string;
+  tabs?: number;
+};
+
+/**
+ * Describes the message widget.v1.Tabs.
+ * Use `create(TabsSchema)` to create a new message.
+ */
+export const TabsSchema: GenMessage<Tabs, TabsJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 48);
 
+/**
+ * @generated from message widget.v1.TagInput
+ */
+export type TagInput = Message<"widget.v1.TagInput"> & {
   /**
-   * @generated from field: optional int32 height = 5;
+   * @generated from field: repeated string value = 1;
    */
-  height?: number;
+  value: string[];
 
   /**
-   * @generated from field: repeated string column_order = 6;
+   * @generated from field: string label = 2;
    */
-  columnOrder?: string[];
+  label: string;
 
   /**
-   * @generated from field: string on_select = 7;
+   * @generated from field: string placeholder = 3;
    */
-  onSelect?: string;
+  placeholder: string;
 
   /**
-   * @generated from field: string row_selection = 8;
+   * @generated from field: repeated string default_value = 4;
    */
-  rowSelection?: string;
-};
+  defaultValue: string[];
 
-/**
- * Describes the message widget.v1.Table.
- * Use `create(TableSchema)` to create a new message.
- */
-export const TableSchema: GenMessage<Table, TableJson> = /*@__PURE__*/
-  messageDesc(file_widget_v1_widget, 13);
+  /**
+   * @generated from field: bool required = 5;
+   */
+  required: boolean;
 
-/**
- * @generated from message widget.v1.TableValue
- */
-export type TableValue = Message<"widget.v1.TableValue"> & {
   /**
-   * @generated from field: optional widget.v1.TableValueSelection selection = 1;
+   * @generated from field: bool disabled = 6;
    */
-  selection?: TableValueSelection;
+  disabled: boolean;
+
+  /**
+   * @generated from field: repeated string suggestions = 7;
+   */
+  suggestions: string[];
+
+  /**
+   * @generated from field: optional int32 max_tags = 8;
+   */
+  maxTags?: number;
 };
 
 /**
- * JSON type for the message widget.v1.TableValue.
+ * JSON type for the message widget.v1.TagInput.
  */
-export type TableValueJson = {
+export type TagInputJson = {
   /**
-   * @generated from field: optional widget.v1.TableValueSelection selection = 1;
+   * @generated from field: repeated string value = 1;
+   */
+  value?: string[];
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label?: string;
+
+  /**
+   * @generated from field: string placeholder = 3;
    */
-  selection?: TableValueSelectionJson;
-};
+  placeholder?: string;
 
-/**
- * Describes the message widget.v1.TableValue.
- * Use `create(TableValueSchema)` to create a new message.
- */
-export const TableValueSchema: GenMessage<TableValue, TableValueJson> = /*@__PURE__*/
-  messageDesc(file_widget_v1_widget, 14);
+  /**
+   * @generated from field: repeated string default_value = 4;
+   */
+  defaultValue?: string[];
 
-/**
- * @generated from message widget.v1.TableValueSelection
- */
-export type TableValueSelection = Message<"widget.v1.TableValueSelection"> & {
   /**
-   * @generated from field: int32 row = 1;
+   * @generated from field: bool required = 5;
    */
-  row: number;
+  required?: boolean;
 
   /**
-   * @generated from field: repeated int32 rows = 2;
+   * @generated from field: bool disabled = 6;
    */
-  rows: number[];
-};
+  disabled?: boolean;
 
-/**
- * JSON type for the message widget.v1.TableValueSelection.
- */
-export type TableValueSelectionJson = {
   /**
-   * @generated from field: int32 row = 1;
+   * @generated from field: repeated string suggestions = 7;
    */
-  row?: number;
+  suggestions?: string[];
 
   /**
-   * @generated from field: repeated int32 rows = 2;
+   * @generated from field: optional int32 max_tags = 8;
    */
-  rows?: number[];
+  maxTags?: number;
 };
 
 /**
- * Describes the message widget.v1.TableValueSelection.
- * Use `create(TableValueSelectionSchema)` to create a new message.
+ * Describes the message widget.v1.TagInput.
+ * Use `create(TagInputSchema)` to create a new message.
  */
-export const TableValueSelectionSchema: GenMessage<TableValueSelection, TableValueSelectionJson> = /*@__PURE__*/
-  messageDesc(file_widget_v1_widget, 15);
+export const TagInputSchema: GenMessage<TagInput, TagInputJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 49);
 
 /**
  * @generated from message widget.v1.TextArea
@@ -1189,7 +3231,7 @@ export type TextAreaJson = {
  * Use `create(TextAreaSchema)` to create a new message.
  */
 export const TextAreaSchema: GenMessage<TextArea, TextAreaJson> = /*@__PURE__*/
-  messageDesc(file_widget_v1_widget, 16);
+  messageDesc(file_widget_v1_widget, 50);
 
 /**
  * @generated from message widget.v1.TextInput
@@ -1286,7 +3328,7 @@ export type TextInputJson = {
  * Use `create(TextInputSchema)` to create a new message.
  */
 export const TextInputSchema: GenMessage<TextInput, TextInputJson> = /*@__PURE__*/
-  messageDesc(file_widget_v1_widget, 17);
+  messageDesc(file_widget_v1_widget, 51);
 
 /**
  * @generated from message widget.v1.TimeInput
@@ -1363,7 +3405,74 @@ export type TimeInputJson = {
  * Use `create(TimeInputSchema)` to create a new message.
  */
 export const TimeInputSchema: GenMessage<TimeInput, TimeInputJson> = /*@__PURE__*/
-  messageDesc(file_widget_v1_widget, 18);
+  messageDesc(file_widget_v1_widget, 52);
+
+/**
+ * @generated from message widget.v1.Toggle
+ */
+export type Toggle = Message<"widget.v1.Toggle"> & {
+  /**
+   * @generated from field: bool value = 1;
+   */
+  value: boolean;
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label: string;
+
+  /**
+   * @generated from field: bool default_value = 3;
+   */
+  defaultValue: boolean;
+
+  /**
+   * @generated from field: bool disabled = 4;
+   */
+  disabled: boolean;
+
+  /**
+   * @generated from field: bool rerun_on_change = 5;
+   */
+  rerunOnChange: boolean;
+};
+
+/**
+ * JSON type for the message widget.v1.Toggle.
+ */
+export type ToggleJson = {
+  /**
+   * @generated from field: bool value = 1;
+   */
+  value?: boolean;
+
+  /**
+   * @generated from field: string label = 2;
+   */
+  label?: string;
+
+  /**
+   * @generated from field: bool default_value = 3;
+   */
+  defaultValue?: boolean;
+
+  /**
+   * @generated from field: bool disabled = 4;
+   */
+  disabled?: boolean;
+
+  /**
+   * @generated from field: bool rerun_on_change = 5;
+   */
+  rerunOnChange?: boolean;
+};
+
+/**
+ * Describes the message widget.v1.Toggle.
+ * Use `create(ToggleSchema)` to create a new message.
+ */
+export const ToggleSchema: GenMessage<Toggle, ToggleJson> = /*@__PURE__*/
+  messageDesc(file_widget_v1_widget, 53);
 
 /**
  * @generated from message widget.v1.Widget
@@ -1479,6 +3588,168 @@ export type Widget = Message<"widget.v1.Widget"> & {
      */
     value: TimeInput;
     case: "timeInput";
+  } | {
+    /**
+     * @generated from field: widget.v1.FileInput file_input = 19;
+     */
+    value: FileInput;
+    case: "fileInput";
+  } | {
+    /**
+     * @generated from field: widget.v1.DownloadButton download_button = 20;
+     */
+    value: DownloadButton;
+    case: "downloadButton";
+  } | {
+    /**
+     * @generated from field: widget.v1.Chart chart = 21;
+     */
+    value: Chart;
+    case: "chart";
+  } | {
+    /**
+     * @generated from field: widget.v1.Tabs tabs = 22;
+     */
+    value: Tabs;
+    case: "tabs";
+  } | {
+    /**
+     * @generated from field: widget.v1.TabItem tab_item = 23;
+     */
+    value: TabItem;
+    case: "tabItem";
+  } | {
+    /**
+     * @generated from field: widget.v1.Expander expander = 24;
+     */
+    value: Expander;
+    case: "expander";
+  } | {
+    /**
+     * @generated from field: widget.v1.Dialog dialog = 25;
+     */
+    value: Dialog;
+    case: "dialog";
+  } | {
+    /**
+     * @generated from field: widget.v1.Alert alert = 26;
+     */
+    value: Alert;
+    case: "alert";
+  } | {
+    /**
+     * @generated from field: widget.v1.Metric metric = 27;
+     */
+    value: Metric;
+    case: "metric";
+  } | {
+    /**
+     * @generated from field: widget.v1.Progress progress = 28;
+     */
+    value: Progress;
+    case: "progress";
+  } | {
+    /**
+     * @generated from field: widget.v1.Spinner spinner = 29;
+     */
+    value: Spinner;
+    case: "spinner";
+  } | {
+    /**
+     * @generated from field: widget.v1.Slider slider = 30;
+     */
+    value: Slider;
+    case: "slider";
+  } | {
+    /**
+     * @generated from field: widget.v1.RangeSlider range_slider = 31;
+     */
+    value: RangeSlider;
+    case: "rangeSlider";
+  } | {
+    /**
+     * @generated from field: widget.v1.Toggle toggle = 32;
+     */
+    value: Toggle;
+    case: "toggle";
+  } | {
+    /**
+     * @generated from field: widget.v1.DateRangeInput date_range_input = 33;
+     */
+    value: DateRangeInput;
+    case: "dateRangeInput";
+  } | {
+    /**
+     * @generated from field: widget.v1.Json json = 34;
+     */
+    value: Json;
+    case: "json";
+  } | {
+    /**
+     * @generated from field: widget.v1.CodeEditor code_editor = 35;
+     */
+    value: CodeEditor;
+    case: "codeEditor";
+  } | {
+    /**
+     * @generated from field: widget.v1.Image image = 36;
+     */
+    value: Image;
+    case: "image";
+  } | {
+    /**
+     * @generated from field: widget.v1.Link link = 37;
+     */
+    value: Link;
+    case: "link";
+  } | {
+    /**
+     * @generated from field: widget.v1.PageLink page_link = 38;
+     */
+    value: PageLink;
+    case: "pageLink";
+  } | {
+    /**
+     * @generated from field: widget.v1.Header header = 39;
+     */
+    value: Header;
+    case: "header";
+  } | {
+    /**
+     * @generated from field: widget.v1.Subheader subheader = 40;
+     */
+    value: Subheader;
+    case: "subheader";
+  } | {
+    /**
+     * @generated from field: widget.v1.Caption caption = 41;
+     */
+    value: Caption;
+    case: "caption";
+  } | {
+    /**
+     * @generated from field: widget.v1.Divider divider = 42;
+     */
+    value: Divider;
+    case: "divider";
+  } | {
+    /**
+     * @generated from field: widget.v1.Spacer spacer = 43;
+     */
+    value: Spacer;
+    case: "spacer";
+  } | {
+    /**
+     * @generated from field: widget.v1.TagInput tag_input = 44;
+     */
+    value: TagInput;
+    case: "tagInput";
+  } | {
+    /**
+     * @generated from field: widget.v1.ColorInput color_input = 45;
+     */
+    value: ColorInput;
+    case: "colorInput";
   } | { case: undefined; value?: undefined };
 };
 
@@ -1575,6 +3846,141 @@ export type WidgetJson = {
    * @generated from field: widget.v1.TimeInput time_input = 18;
    */
   timeInput?: TimeInputJson;
+
+  /**
+   * @generated from field: widget.v1.FileInput file_input = 19;
+   */
+  fileInput?: FileInputJson;
+
+  /**
+   * @generated from field: widget.v1.DownloadButton download_button = 20;
+   */
+  downloadButton?: DownloadButtonJson;
+
+  /**
+   * @generated from field: widget.v1.Chart chart = 21;
+   */
+  chart?: ChartJson;
+
+  /**
+   * @generated from field: widget.v1.Tabs tabs = 22;
+   */
+  tabs?: TabsJson;
+
+  /**
+   * @generated from field: widget.v1.TabItem tab_item = 23;
+   */
+  tabItem?: TabItemJson;
+
+  /**
+   * @generated from field: widget.v1.Expander expander = 24;
+   */
+  expander?: ExpanderJson;
+
+  /**
+   * @generated from field: widget.v1.Dialog dialog = 25;
+   */
+  dialog?: DialogJson;
+
+  /**
+   * @generated from field: widget.v1.Alert alert = 26;
+   */
+  alert?: AlertJson;
+
+  /**
+   * @generated from field: widget.v1.Metric metric = 27;
+   */
+  metric?: MetricJson;
+
+  /**
+   * @generated from field: widget.v1.Progress progress = 28;
+   */
+  progress?: ProgressJson;
+
+  /**
+   * @generated from field: widget.v1.Spinner spinner = 29;
+   */
+  spinner?: SpinnerJson;
+
+  /**
+   * @generated from field: widget.v1.Slider slider = 30;
+   */
+  slider?: SliderJson;
+
+  /**
+   * @generated from field: widget.v1.RangeSlider range_slider = 31;
+   */
+  rangeSlider?: RangeSliderJson;
+
+  /**
+   * @generated from field: widget.v1.Toggle toggle = 32;
+   */
+  toggle?: ToggleJson;
+
+  /**
+   * @generated from field: widget.v1.DateRangeInput date_range_input = 33;
+   */
+  dateRangeInput?: DateRangeInputJson;
+
+  /**
+   * @generated from field: widget.v1.Json json = 34;
+   */
+  json?: JsonJson;
+
+  /**
+   * @generated from field: widget.v1.CodeEditor code_editor = 35;
+   */
+  codeEditor?: CodeEditorJson;
+
+  /**
+   * @generated from field: widget.v1.Image image = 36;
+   */
+  image?: ImageJson;
+
+  /**
+   * @generated from field: widget.v1.Link link = 37;
+   */
+  link?: LinkJson;
+
+  /**
+   * @generated from field: widget.v1.PageLink page_link = 38;
+   */
+  pageLink?: PageLinkJson;
+
+  /**
+   * @generated from field: widget.v1.Header header = 39;
+   */
+  header?: HeaderJson;
+
+  /**
+   * @generated from field: widget.v1.Subheader subheader = 40;
+   */
+  subheader?: SubheaderJson;
+
+  /**
+   * @generated from field: widget.v1.Caption caption = 41;
+   */
+  caption?: CaptionJson;
+
+  /**
+   * @generated from field: widget.v1.Divider divider = 42;
+   */
+  divider?: DividerJson;
+
+  /**
+   * @generated from field: widget.v1.Spacer spacer = 43;
+   */
+  spacer?: SpacerJson;
+
+  /**
+   * @generated from field: widget.v1.TagInput tag_input = 44;
+   */
+  tagInput?: TagInputJson;
+
+  /**
+   * @generated from field: widget.v1.ColorInput color_input = 45;
+   */
+  colorInput?: ColorInputJson;
 };
 
 /**
@@ -1582,5 +3988,5 @@ export type WidgetJson = {
  * Use `create(WidgetSchema)` to create a new message.
  */
 export const WidgetSchema: GenMessage<Widget, WidgetJson> = /*@__PURE__*/
-  messageDesc(file_widget_v1_widget, 19);
+  messageDesc(file_widget_v1_widget, 54);
 
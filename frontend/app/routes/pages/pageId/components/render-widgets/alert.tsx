@@ -0,0 +1,50 @@
+import { Alert, AlertDescription } from '@/components/ui/alert';
+import { cn } from '@/lib/utils';
+import { useSelector } from '@/store';
+import { widgetsStore } from '@/store/modules/widgets';
+import { CircleAlert, CircleCheck, Info, TriangleAlert } from 'lucide-react';
+import { type FC } from 'react';
+import Markdown from 'react-markdown';
+
+const levels = {
+  info: { icon: Info, className: '' },
+  success: {
+    icon: CircleCheck,
+    className: 'border-green-600/50 text-green-700 dark:text-green-400',
+  },
+  warning: {
+    icon: TriangleAlert,
+    className: 'border-amber-500/50 text-amber-700 dark:text-amber-400',
+  },
+  error: { icon: CircleAlert, className: 'border-destructive/50' },
+} as const;
+
+export const WidgetAlert: FC<{
+  widgetId: string;
+}> = ({ widgetId }) => {
+  const widget = useSelector((state) =>
+    widgetsStore.selector.getWidget(state, widgetId),
+  );
+
+  if (!widget || !widget.widget?.alert) {
+    return null;
+  }
+
+  const level =
+    levels[widget.widget.alert.level as keyof typeof levels] ?? levels.info;
+  const Icon = level.icon;
+
+  return (
+    <Alert
+      variant={
+        widget.widget.alert.level === 'error' ? 'destructive' : 'default'
+      }
+      className={level.className}
+    >
+      <Icon />
+      <AlertDescription className="WidgetMarkdown text-current">
+        <Markdown>{widget.widget.alert.body}</Markdown>
+      </AlertDescription>
+    </Alert>
+  );
+};
@@ -0,0 +1,20 @@
+import { useSelector } from '@/store';
+import { widgetsStore } from '@/store/modules/widgets';
+import { type FC } from 'react';
+
+export const WidgetCaption: FC<{
+  widgetId: string;
+}> = ({ widgetId }) => {
+  const widget = useSelector((state) =>
+    widgetsStore.selector.getWidget(state, widgetId),
+  );
+
+  return (
+    widget &&
+    widget.widget?.caption && (
+      <p className="text-sm text-muted-foreground">
+        {widget.widget.caption.text}
+      </p>
+    )
+  );
+};
@@ -0,0 +1,200 @@
+import {
+  ChartContainer,
+  ChartLegend,
+  ChartLegendContent,
+  ChartTooltip,
+  ChartTooltipContent,
+  type ChartConfig,
+} from '@/components/ui/chart';
+import { cn, parseJsonBytes } from '@/lib/utils';
+import { useSelector } from '@/store';
+import { widgetsStore } from '@/store/modules/widgets';
+import { useMemo, type FC } from 'react';
+import {
+  Area,
+  AreaChart,
+  Bar,
+  BarChart,
+  CartesianGrid,
+  Cell,
+  Line,
+  LineChart,
+  Pie,
+  PieChart,
+  Scatter,
+  ScatterChart,
+  XAxis,
+  YAxis,
+} from 'recharts';
+
+type DataPoint = Record<string, unknown>;
+
+const seriesColor = (index: number) => `hsl(var(--chart-${(index % 5) + 1}))`;
+
+export const WidgetChart: FC<{
+  widgetId: string;
+}> = ({ widgetId }) => {
+  const widget = useSelector((state) =>
+    widgetsStore.selector.getWidget(state, widgetId),
+  );
+  const chart = widget?.widget?.chart;
+
+  // The X field defaults to the first field of the data and the series to
+  // the remaining numeric fields, matching the SDK docs.
+  const { data, xField, yFields, config, parseError } = useMemo(() => {
+    let data: DataPoint[] = [];
+    let parseError: string | null = null;
+    try {
+      const parsed = parseJsonBytes(chart?.data);
+      data = Array.isArray(parsed) ? parsed : [];
+    } catch (e) {
+      parseError = e instanceof Error ? e.message : String(e);
+    }
+    const fields = Object.keys(data[0] ?? {});
+    const xField = chart?.xField || fields[0] || '';
+    const yFields = chart?.yFields?.length
+      ? chart.yFields
+      : fields.filter(
+          (field) => field !== xField && typeof data[0]?.[field] === 'number',
+        );
+    const config = Object.fromEntries(
+      yFields.map((field, index) => [
+        field,
+        { label: field, color: seriesColor(index) },
+      ]),
+    ) satisfies ChartConfig;
+    return { data, xField, yFields, config, parseError };
+  }, [chart?.data, chart?.xField, chart?.yFields]);
+
+  if (!widget || !chart) {
+    return null;
+  }
+
+  const stackId = chart.stacked ? 'stack' : undefined;
+  const error = chart.error || parseError;
+
+  const renderChart = () => {
+    switch (chart.type) {
+      case 'bar':
+        return (
+          <BarChart data={data}>
+            <CartesianGrid vertical={false} />
+            <XAxis dataKey={xField} tickLine={false} axisLine={false} />
+            <YAxis tickLine={false} axisLine={false} />
+            <ChartTooltip content={<ChartTooltipContent />} />
+            <ChartLegend content={<ChartLegendContent />} />
+            {yFields.map((field, index) => (
+              <Bar
+                key={field}
+                dataKey={field}
+                fill={seriesColor(index)}
+                stackId={stackId}
+                radius={4}
+              />
+            ))}
+          </BarChart>
+        );
+      case 'area':
+        return (
+          <AreaChart data={data}>
+            <CartesianGrid vertical={false} />
+            <XAxis dataKey={xField} tickLine={false} axisLine={false} />
+            <YAxis tickLine={false} axisLine={false} />
+            <ChartTooltip content={<ChartTooltipContent />} />
+            <ChartLegend content={<ChartLegendContent />} />
+            {yFields.map((field, index) => (
+              <Area
+                key={field}
+                dataKey={field}
+                type="monotone"
+                stroke={seriesColor(index)}
+                fill={seriesColor(index)}
+                fillOpacity={0.3}
+                stackId={stackId}
+              />
+            ))}
+          </AreaChart>
+        );
+      case 'pie':
+        return (
+          <PieChart>
+            <ChartTooltip content={<ChartTooltipContent />} />
+            <Pie data={data} dataKey={yFields[0] ?? ''} nameKey={xField}>
+              {data.map((_, index) => (
+                <Cell key={index} fill={seriesColor(index)} />
+              ))}
+            </Pie>
+          </PieChart>
+        );
+      case 'scatter':
+        // Scatter points take their Y from the axis, so each series is
+        // plotted from its own copy of the data.
+        return (
+          <ScatterChart>
+            <CartesianGrid />
+            <XAxis
+              dataKey={xField}
+              name={xField}
+              type={
+                typeof data[0]?.[xField] === 'number' ? 'number' : 'category'
+              }
+            />
+            <YAxis dataKey="value" tickLine={false} axisLine={false} />
+            <ChartTooltip content={<ChartTooltipContent />} />
+            <ChartLegend content={<ChartLegendContent />} />
+            {yFields.map((field, index) => (
+              <Scatter
+                key={field}
+                name={field}
+                data={data.map((point) => ({
+                  [xField]: point[xField],
+                  value: point[field],
+                }))}
+                fill={seriesColor(index)}
+              />
+            ))}
+          </ScatterChart>
+        );
+      default:
+        return (
+          <LineChart data={data}>
+            <CartesianGrid vertical={false} />
+            <XAxis dataKey={xField} tickLine={false} axisLine={false} />
+            <YAxis tickLine={false} axisLine={false} />
+            <ChartTooltip content={<ChartTooltipContent />} />
+            <ChartLegend content={<ChartLegendContent />} />
+            {yFields.map((field, index) => (
+              <Line
+                key={field}
+                dataKey={field}
+                type="monotone"
+                stroke={seriesColor(index)}
+                strokeWidth={2}
+                dot={false}
+              />
+            ))}
+          </LineChart>
+        );
+    }
+  };
+
+  return (
+    <div className="space-y-2">
+      {chart.title && <h3 className="text-lg font-semibold">{chart.title}</h3>}
+      {chart.description && (
+        <p className="text-sm text-muted-foreground">{chart.description}</p>
+      )}
+      {error ? (
+        <p className="text-sm font-medium text-destructive">{error}</p>
+      ) : (
+        <ChartContainer
+          config={config}
+          className={cn('w-full', chart.height && 'aspect-auto')}
+          style={chart.height ? { height: chart.height } : undefined}
+        >
+          {renderChart()}
+        </ChartContainer>
+      )}
+    </div>
+  );
+};
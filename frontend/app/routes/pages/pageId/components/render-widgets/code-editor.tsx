@@ -0,0 +1,157 @@
+import { Label } from '@/components/ui/label';
+import { cn } from '@/lib/utils';
+import { useDispatch, useSelector } from '@/store';
+import { widgetsStore } from '@/store/modules/widgets';
+import { useEffect, useId, useState, type FC } from 'react';
+import { createHighlighter, type Highlighter } from 'shiki';
+import { useDebouncedCallback } from 'use-debounce';
+
+const theme = 'github-dark-dimmed';
+
+// The highlighter is shared by every editor on the page and only loads the
+// languages the SDK offers.
+let highlighter: Promise<Highlighter> | null = null;
+const getHighlighter = () => {
+  highlighter ??= createHighlighter({
+    themes: [theme],
+    langs: ['sql', 'json', 'yaml', 'go'],
+  });
+  return highlighter;
+};
+
+const useHighlightedHtml = (code: string, language?: string) => {
+  const [html, setHtml] = useState('');
+
+  useEffect(() => {
+    let cancelled = false;
+    (async () => {
+      const h = await getHighlighter();
+      const lang = h.getLoadedLanguages().includes(language ?? '')
+        ? (language ?? 'text')
+        : 'text';
+      // A trailing newline would otherwise collapse the last line and
+      // leave the caret below the highlighted text.
+      const html = h.codeToHtml(`${code}\n`, { lang, theme });
+      if (!cancelled) {
+        setHtml(html);
+      }
+    })();
+    return () => {
+      cancelled = true;
+    };
+  }, [code, language]);
+
+  return html;
+};
+
+export const WidgetCodeEditor: FC<{
+  widgetId: string;
+}> = ({ widgetId }) => {
+  const id = useId();
+  const dispatch = useDispatch();
+  const widget = useSelector((state) =>
+    widgetsStore.selector.getWidget(state, widgetId),
+  );
+  const state = useSelector((state) =>
+    widgetsStore.selector.getWidgetState(state, widgetId),
+  );
+  const isWidgetWaiting = useSelector((state) => state.widgets.isWidgetWaiting);
+
+  const codeEditor = widget?.widget?.codeEditor;
+  // Read-only editors show what the page rendered last.
+  const code =
+    (codeEditor?.readOnly
+      ? codeEditor.value
+      : state?.type === 'codeEditor'
+        ? state.value
+        : undefined) ?? '';
+  const html = useHighlightedHtml(code, codeEditor?.language);
+
+  const handleChangeDebounce = useDebouncedCallback((value: string) => {
+    dispatch(
+      widgetsStore.actions.setWidgetValue({
+        widgetId,
+        widgetType: 'codeEditor',
+        value,
+      }),
+    );
+  }, 1000);
+
+  const handleChange = (value: string) => {
+    if (isWidgetWaiting) {
+      return;
+    }
+    handleChangeDebounce(value);
+    dispatch(
+      widgetsStore.actions.setWidgetState({
+        widgetId,
+        widgetType: 'codeEditor',
+        value,
+      }),
+    );
+  };
+
+  if (!widget || !codeEditor || state?.type !== 'codeEditor') {
+    return null;
+  }
+
+  const lineCount = code.split('\n').length;
+
+  // The textarea sits on top of the highlighted code with transparent text,
+  // so the browser handles editing and shiki handles the colours.
+  return (
+    <div className="space-y-2">
+      {codeEditor.label && (
+        <Label
+          className={cn('block', state.error && 'text-destructive')}
+          htmlFor={id}
+        >
+          {codeEditor.label}
+        </Label>
+      )}
+      <div
+        className={cn(
+          'flex overflow-auto rounded-md border bg-[#22272e] font-mono text-xs leading-5',
+          codeEditor.disabled && 'opacity-50',
+        )}
+        style={
+          codeEditor.maxHeight ? { maxHeight: codeEditor.maxHeight } : undefined
+        }
+      >
+        {codeEditor.lineNumbers && (
+          <div
+            aria-hidden
+            className="sticky left-0 bg-[#22272e] py-3 pr-2 pl-3 text-right text-[#768390] select-none"
+          >
+            {Array.from({ length: lineCount }, (_, index) => (
+              <div key={index}>{index + 1}</div>
+            ))}
+          </div>
+        )}
+        <div className="grid grow">
+          <div
+            aria-hidden
+            className="pointer-events-none [grid-area:1/1] [&>pre]:bg-transparent! [&>pre]:px-3 [&>pre]:py-3"
+            dangerouslySetInnerHTML={{ __html: html }}
+          />
+          <textarea
+            id={id}
+            className="resize-none overflow-hidden bg-transparent px-3 py-3 whitespace-pre text-transparent caret-white outline-none [grid-area:1/1] placeholder:text-[#768390]"
+            value={code}
+            placeholder={codeEditor.placeholder}
+            readOnly={codeEditor.readOnly}
+            disabled={codeEditor.disabled || isWidgetWaiting}
+            spellCheck={false}
+            wrap="off"
+            onChange={(e) => handleChange(e.target.value)}
+          />
+        </div>
+      </div>
+      {state.error && (
+        <p className={cn('text-sm font-medium text-destructive')}>
+          {state.error.message}
+        </p>
+      )}
+    </div>
+  );
+};
@@ -0,0 +1,103 @@
+import { Label } from '@/components/ui/label';
+import { cn } from '@/lib/utils';
+import { useDispatch, useSelector } from '@/store';
+import { widgetsStore } from '@/store/modules/widgets';
+import { Check } from 'lucide-react';
+import { useId, type FC } from 'react';
+import { useDebouncedCallback } from 'use-debounce';
+
+export const WidgetColorInput: FC<{
+  widgetId: string;
+}> = ({ widgetId }) => {
+  const id = useId();
+  const dispatch = useDispatch();
+  const widget = useSelector((state) =>
+    widgetsStore.selector.getWidget(state, widgetId),
+  );
+  const state = useSelector((state) =>
+    widgetsStore.selector.getWidgetState(state, widgetId),
+  );
+  const isWidgetWaiting = useSelector((state) => state.widgets.isWidgetWaiting);
+
+  const colorInput = widget?.widget?.colorInput;
+  const value = state?.type === 'colorInput' ? (state.value ?? '') : '';
+  const disabled = colorInput?.disabled || isWidgetWaiting;
+
+  // The native picker reports every colour it passes through while
+  // dragging, so the rerun waits until it settles.
+  const handleChangeDebounce = useDebouncedCallback((value: string) => {
+    dispatch(
+      widgetsStore.actions.setWidgetValue({
+        widgetId,
+        widgetType: 'colorInput',
+        value,
+      }),
+    );
+  }, 300);
+
+  const handleChange = (next: string) => {
+    if (isWidgetWaiting) {
+      return;
+    }
+    const value = next.toUpperCase();
+    handleChangeDebounce(value);
+    dispatch(
+      widgetsStore.actions.setWidgetState({
+        widgetId,
+        widgetType: 'colorInput',
+        value,
+      }),
+    );
+  };
+
+  return (
+    widget &&
+    colorInput &&
+    state?.type === 'colorInput' && (
+      <div className="space-y-2">
+        {colorInput.label && (
+          <Label
+            className={cn('block', state.error && 'text-destructive')}
+            htmlFor={id}
+          >
+            {colorInput.label}
+          </Label>
+        )}
+        <div className="flex flex-wrap items-center gap-2">
+          <input
+            id={id}
+            type="color"
+            className="h-9 w-12 cursor-pointer rounded-md border bg-transparent p-1 disabled:cursor-not-allowed disabled:opacity-50"
+            value={value || '#000000'}
+            disabled={disabled}
+            onChange={(e) => handleChange(e.target.value)}
+          />
+          <span className="w-20 font-mono text-sm text-muted-foreground">
+            {value || 'None'}
+          </span>
+          {colorInput.swatches?.map((swatch) => (
+            <button
+              key={swatch}
+              type="button"
+              aria-label={swatch}
+              title={swatch}
+              className="flex size-7 items-center justify-center rounded-md border disabled:cursor-not-allowed disabled:opacity-50"
+              style={{ backgroundColor: swatch }}
+              disabled={disabled}
+              onClick={() => handleChange(swatch)}
+            >
+              {swatch === value && (
+                <Check className="size-4 text-white mix-blend-difference" />
+              )}
+            </button>
+          ))}
+        </div>
+        {state.error && (
+          <p className={cn('text-sm font-medium text-destructive')}>
+            {state.error.message}
+          </p>
+        )}
+      </div>
+    )
+  );
+};
@@ -0,0 +1,171 @@
+import { Button } from '@/components/ui/button';
+import { Calendar } from '@/components/ui/calendar';
+import { Label } from '@/components/ui/label';
+import {
+  Popover,
+  PopoverContent,
+  PopoverTrigger,
+} from '@/components/ui/popover';
+import { cn } from '@/lib/utils';
+import { useDispatch, useSelector } from '@/store';
+import { widgetsStore } from '@/store/modules/widgets';
+import dayjs from 'dayjs';
+import { CalendarIcon } from 'lucide-react';
+import { useState, type FC } from 'react';
+import type { DateRange } from 'react-day-picker';
+
+type DateRangeValue = { startValue?: string; endValue?: string };
+
+const toDate = (value?: string) => (value ? dayjs(value).toDate() : undefined);
+
+const toValue = (date?: Date) =>
+  date ? dayjs(date).format('YYYY-MM-DD') : undefined;
+
+export const WidgetDateRangeInput: FC<{
+  widgetId: string;
+}> = ({ widgetId }) => {
+  const dispatch = useDispatch();
+  const widget = useSelector((state) =>
+    widgetsStore.selector.getWidget(state, widgetId),
+  );
+  const state = useSelector((state) =>
+    widgetsStore.selector.getWidgetState(state, widgetId),
+  );
+  const isWidgetWaiting = useSelector((state) => state.widgets.isWidgetWaiting);
+  const [open, setOpen] = useState(false);
+
+  const dateRangeInput = widget?.widget?.dateRangeInput;
+  const value: DateRangeValue =
+    state?.type === 'dateRangeInput' ? state.value : {};
+
+  const handleChange = (next: DateRangeValue) => {
+    if (isWidgetWaiting) {
+      return;
+    }
+    dispatch(
+      widgetsStore.actions.setWidgetState({
+        widgetId,
+        widgetType: 'dateRangeInput',
+        value: next,
+      }),
+    );
+    // The first click only picks the start, so the page reruns once the
+    // range is complete or cleared.
+    if (!next.startValue !== !next.endValue) {
+      return;
+    }
+    dispatch(
+      widgetsStore.actions.setWidgetValue({
+        widgetId,
+        widgetType: 'dateRangeInput',
+        value: next,
+      }),
+    );
+    setOpen(false);
+  };
+
+  const handleSelect = (range: DateRange | undefined) =>
+    handleChange({
+      startValue: toValue(range?.from),
+      endValue: toValue(range?.to),
+    });
+
+  const format = (date: string) =>
+    dayjs(date).format(dateRangeInput?.format || 'YYYY/MM/DD');
+
+  const minDate = toDate(dateRangeInput?.minValue);
+  const maxDate = toDate(dateRangeInput?.maxValue);
+
+  return (
+    widget &&
+    dateRangeInput &&
+    state?.type === 'dateRangeInput' && (
+      <div className="space-y-2">
+        {dateRangeInput.label && (
+          <Label className={cn('block', state.error && 'text-destructive')}>
+            {dateRangeInput.label}
+          </Label>
+        )}
+        <Popover open={open} onOpenChange={setOpen}>
+          <PopoverTrigger
+            asChild
+            disabled={dateRangeInput.disabled || isWidgetWaiting}
+          >
+            <Button
+              variant={'outline'}
+              className={cn(
+                'w-full justify-start text-left font-normal',
+                !value.startValue && 'text-muted-foreground',
+              )}
+            >
+              <CalendarIcon className="mr-2 size-4" />
+              {value.startValue ? (
+                <>
+                  {format(value.startValue)} –{' '}
+                  {value.endValue ? format(value.endValue) : ''}
+                </>
+              ) : (
+                <span>Pick a date range</span>
+              )}
+            </Button>
+          </PopoverTrigger>
+          <PopoverContent className="flex w-auto p-0" align="start">
+            {dateRangeInput.presets && dateRangeInput.presets.length > 0 && (
+              <div className="flex flex-col gap-1 border-r p-3">
+                {dateRangeInput.presets.map((preset) => (
+                  <Button
+                    key={preset.label}
+                    variant={'ghost'}
+                    size="sm"
+                    className="justify-start font-normal"
+                    type="button"
+                    onClick={() =>
+                      handleChange({
+                        startValue: preset.startValue,
+                        endValue: preset.endValue,
+                      })
+                    }
+                  >
+                    {preset.label}
+                  </Button>
+                ))}
+              </div>
+            )}
+            <div>
+              <Calendar
+                mode="range"
+                numberOfMonths={2}
+                defaultMonth={toDate(value.startValue)}
+                selected={{
+                  from: toDate(value.startValue),
+                  to: toDate(value.endValue),
+                }}
+                onSelect={handleSelect}
+                disabled={[
+                  ...(minDate ? [{ before: minDate }] : []),
+                  ...(maxDate ? [{ after: maxDate }] : []),
+                ]}
+                initialFocus
+              />
+              {value.startValue && (
+                <Button
+                  variant={'ghost'}
+                  className="w-full cursor-pointer font-normal text-muted-foreground"
+                  type="button"
+                  onClick={() => handleChange({})}
+                >
+                  Clear
+                </Button>
+              )}
+            </div>
+          </PopoverContent>
+        </Popover>
+        {state.error && (
+          <p className={cn('text-sm font-medium text-destructive')}>
+            {state.error.message}
+          </p>
+        )}
+      </div>
+    )
+  );
+};
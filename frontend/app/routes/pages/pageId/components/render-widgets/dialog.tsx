@@ -0,0 +1,45 @@
+import {
+  Dialog,
+  DialogContent,
+  DialogHeader,
+  DialogTitle,
+} from '@/components/ui/dialog';
+import { useDispatch, useSelector } from '@/store';
+import { widgetsStore } from '@/store/modules/widgets';
+import { type FC } from 'react';
+
+export const WidgetDialog: FC<{
+  widgetId: string;
+  children?: React.ReactNode;
+}> = ({ widgetId, children }) => {
+  const dispatch = useDispatch();
+  const widget = useSelector((state) =>
+    widgetsStore.selector.getWidget(state, widgetId),
+  );
+  const isWidgetWaiting = useSelector((state) => state.widgets.isWidgetWaiting);
+
+  // Opening is controlled from Go; the browser only reports dismissals.
+  const handleOpenChange = (open: boolean) => {
+    if (open || isWidgetWaiting) {
+      return;
+    }
+    dispatch(widgetsStore.actions.dismissDialog({ widgetId }));
+  };
+
+  return (
+    widget &&
+    widget.widget?.dialog && (
+      <Dialog
+        open={widget.widget.dialog.open ?? false}
+        onOpenChange={handleOpenChange}
+      >
+        <DialogContent>
+          <DialogHeader>
+            <DialogTitle>{widget.widget.dialog.title}</DialogTitle>
+          </DialogHeader>
+          <div className="flex flex-col gap-6">{children}</div>
+        </DialogContent>
+      </Dialog>
+    )
+  );
+};
@@ -0,0 +1,14 @@
+import { Separator } from '@/components/ui/separator';
+import { useSelector } from '@/store';
+import { widgetsStore } from '@/store/modules/widgets';
+import { type FC } from 'react';
+
+export const WidgetDivider: FC<{
+  widgetId: string;
+}> = ({ widgetId }) => {
+  const widget = useSelector((state) =>
+    widgetsStore.selector.getWidget(state, widgetId),
+  );
+
+  return widget && widget.widget?.divider && <Separator className="my-2" />;
+};
@@ -0,0 +1,61 @@
+import { Button } from '@/components/ui/button';
+import { requestDownload, saveFile } from '@/lib/fileTransfer';
+import { useSelector } from '@/store';
+import { widgetsStore } from '@/store/modules/widgets';
+import { Download, Loader2 } from 'lucide-react';
+import { useState, type FC } from 'react';
+
+export const WidgetDownloadButton: FC<{
+  widgetId: string;
+}> = ({ widgetId }) => {
+  const widget = useSelector((state) =>
+    widgetsStore.selector.getWidget(state, widgetId),
+  );
+  const isWidgetWaiting = useSelector((state) => state.widgets.isWidgetWaiting);
+  const [isDownloading, setIsDownloading] = useState(false);
+  const [error, setError] = useState<string | null>(null);
+
+  // Clicking does not rerun the page; the SDK streams the bytes it was given
+  // on the last run.
+  const handleClick = async () => {
+    if (isWidgetWaiting || isDownloading) {
+      return;
+    }
+    setIsDownloading(true);
+    setError(null);
+    try {
+      saveFile(await requestDownload(widgetId));
+    } catch (e) {
+      setError(e instanceof Error ? e.message : String(e));
+    } finally {
+      setIsDownloading(false);
+    }
+  };
+
+  return (
+    widget &&
+    widget.widget?.downloadButton && (
+      <div className="space-y-2">
+        <Button
+          variant="outline"
+          disabled={
+            widget.widget.downloadButton.disabled ||
+            isWidgetWaiting ||
+            isDownloading
+          }
+          onClick={handleClick}
+        >
+          {isDownloading ? (
+            <Loader2 className="size-4 animate-spin" />
+          ) : (
+            <Download className="size-4" />
+          )}
+          {widget.widget.downloadButton.label}
+        </Button>
+        {error && (
+          <p className="text-sm font-medium text-destructive">{error}</p>
+        )}
+      </div>
+    )
+  );
+};
@@ -0,0 +1,56 @@
+import {
+  Collapsible,
+  CollapsibleContent,
+  CollapsibleTrigger,
+} from '@/components/ui/collapsible';
+import { cn } from '@/lib/utils';
+import { useDispatch, useSelector } from '@/store';
+import { widgetsStore } from '@/store/modules/widgets';
+import { ChevronDown } from 'lucide-react';
+import { type FC } from 'react';
+
+export const WidgetExpander: FC<{
+  widgetId: string;
+  children?: React.ReactNode;
+}> = ({ widgetId, children }) => {
+  const dispatch = useDispatch();
+  const widget = useSelector((state) =>
+    widgetsStore.selector.getWidget(state, widgetId),
+  );
+
+  // Toggling does not rerun the page; the state is sent with the next rerun
+  // so that the SDK keeps the user's choice.
+  const handleOpenChange = (open: boolean) => {
+    dispatch(
+      widgetsStore.actions.setWidgetValueWithoutRerun({
+        widgetId,
+        widgetType: 'expander',
+        value: open,
+      }),
+    );
+  };
+
+  return (
+    widget &&
+    widget.widget?.expander && (
+      <Collapsible
+        open={widget.widget.expander.value ?? false}
+        onOpenChange={handleOpenChange}
+        className="rounded-md border"
+      >
+        <CollapsibleTrigger className="flex w-full items-center justify-between px-4 py-3 text-sm font-medium">
+          {widget.widget.expander.label}
+          <ChevronDown
+            className={cn(
+              'size-4 transition-transform',
+              widget.widget.expander.value && 'rotate-180',
+            )}
+          />
+        </CollapsibleTrigger>
+        <CollapsibleContent className="flex flex-col gap-6 border-t p-4">
+          {children}
+        </CollapsibleContent>
+      </Collapsible>
+    )
+  );
+};
@@ -0,0 +1,188 @@
+import { Button } from '@/components/ui/button';
+import { Input } from '@/components/ui/input';
+import { Label } from '@/components/ui/label';
+import { uploadFile } from '@/lib/fileTransfer';
+import { cn } from '@/lib/utils';
+import type { FileInputFileJson } from '@/pb/ts/widget/v1/widget_pb';
+import { useDispatch, useSelector } from '@/store';
+import { widgetsStore } from '@/store/modules/widgets';
+import { FileIcon, X } from 'lucide-react';
+import { useId, useState, type ChangeEvent, type FC } from 'react';
+
+const formatFileSize = (size: number) => {
+  if (size < 1024) {
+    return `${size} B`;
+  }
+  if (size < 1024 * 1024) {
+    return `${(size / 1024).toFixed(1)} KB`;
+  }
+  return `${(size / 1024 / 1024).toFixed(1)} MB`;
+};
+
+const isAccepted = (accept: string[], file: File) => {
+  if (accept.length === 0) {
+    return true;
+  }
+  const name = file.name.toLowerCase();
+  return accept.some((pattern) => {
+    const p = pattern.trim().toLowerCase();
+    if (p.startsWith('.')) {
+      return name.endsWith(p);
+    }
+    if (p.endsWith('/*')) {
+      return file.type.startsWith(p.slice(0, -1));
+    }
+    return file.type === p;
+  });
+};
+
+export const WidgetFileInput: FC<{
+  widgetId: string;
+}> = ({ widgetId }) => {
+  const id = useId();
+  const dispatch = useDispatch();
+  const widget = useSelector((state) =>
+    widgetsStore.selector.getWidget(state, widgetId),
+  );
+  const state = useSelector((state) =>
+    widgetsStore.selector.getWidgetState(state, widgetId),
+  );
+  const isWidgetWaiting = useSelector((state) => state.widgets.isWidgetWaiting);
+  const [progress, setProgress] = useState<{ sent: number; total: number }>();
+  const [uploadError, setUploadError] = useState<string | null>(null);
+
+  const fileInput = widget?.widget?.fileInput;
+  const files = state?.type === 'fileInput' ? (state.value ?? []) : [];
+
+  const handleValue = (value: FileInputFileJson[]) => {
+    dispatch(
+      widgetsStore.actions.setWidgetValue({
+        widgetId,
+        widgetType: 'fileInput',
+        value,
+      }),
+    );
+    dispatch(
+      widgetsStore.actions.setWidgetState({
+        widgetId,
+        widgetType: 'fileInput',
+        value,
+      }),
+    );
+  };
+
+  const handleChange = async (e: ChangeEvent<HTMLInputElement>) => {
+    const selected = Array.from(e.target.files ?? []);
+    e.target.value = '';
+    if (isWidgetWaiting || !fileInput || selected.length === 0) {
+      return;
+    }
+
+    const maxFileSize = fileInput.maxFileSize
+      ? Number(fileInput.maxFileSize)
+      : undefined;
+    for (const file of selected) {
+      if (!isAccepted(fileInput.accept ?? [], file)) {
+        setUploadError(`${file.name} is not an accepted file type`);
+        return;
+      }
+      if (maxFileSize !== undefined && file.size > maxFileSize) {
+        setUploadError(
+          `${file.name} exceeds the maximum size of ${formatFileSize(maxFileSize)}`,
+        );
+        return;
+      }
+    }
+    setUploadError(null);
+
+    // Chunks go over the same WebSocket as the rerun, so the SDK has every
+    // file by the time it sees the new value.
+    const total = selected.reduce((sum, file) => sum + file.size, 0);
+    let done = 0;
+    const uploaded: FileInputFileJson[] = [];
+    try {
+      setProgress({ sent: 0, total });
+      for (const file of selected) {
+        uploaded.push(
+          await uploadFile({
+            widgetId,
+            file,
+            onProgress: (sent) => setProgress({ sent: done + sent, total }),
+          }),
+        );
+        done += file.size;
+      }
+    } catch (error) {
+      setUploadError(error instanceof Error ? error.message : String(error));
+      return;
+    } finally {
+      setProgress(undefined);
+    }
+
+    handleValue(fileInput.multiple ? [...files, ...uploaded] : uploaded);
+  };
+
+  return (
+    widget &&
+    fileInput &&
+    state?.type === 'fileInput' && (
+      <div className="space-y-2">
+        {fileInput.label && (
+          <Label
+            className={cn('block', state.error && 'text-destructive')}
+            htmlFor={id}
+          >
+            {fileInput.label}
+          </Label>
+        )}
+        <Input
+          id={id}
+          type="file"
+          accept={fileInput.accept?.join(',')}
+          multiple={fileInput.multiple}
+          disabled={fileInput.disabled || isWidgetWaiting || !!progress}
+          onChange={handleChange}
+        />
+        {progress && (
+          <p className="text-sm text-muted-foreground">
+            Uploading… {formatFileSize(progress.sent)} /{' '}
+            {formatFileSize(progress.total)}
+          </p>
+        )}
+        {files.length > 0 && (
+          <ul className="space-y-1">
+            {files.map((file) => (
+              <li
+                key={file.id}
+                className="flex items-center gap-2 rounded-md border px-3 py-1.5 text-sm"
+              >
+                <FileIcon className="size-4 shrink-0 text-muted-foreground" />
+                <span className="truncate">{file.name}</span>
+                <span className="shrink-0 text-muted-foreground">
+                  {formatFileSize(Number(file.size ?? 0))}
+                </span>
+                <Button
+                  type="button"
+                  variant="ghost"
+                  size="icon"
+                  className="ml-auto size-6"
+                  disabled={fileInput.disabled || isWidgetWaiting}
+                  onClick={() =>
+                    handleValue(files.filter((f) => f.id !== file.id))
+                  }
+                >
+                  <X className="size-4" />
+                </Button>
+              </li>
+            ))}
+          </ul>
+        )}
+        {(uploadError || state.error) && (
+          <p className={cn('text-sm font-medium text-destructive')}>
+            {uploadError ?? state.error?.message}
+          </p>
+        )}
+      </div>
+    )
+  );
+};
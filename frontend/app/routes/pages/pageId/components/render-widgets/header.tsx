@@ -0,0 +1,20 @@
+import { useSelector } from '@/store';
+import { widgetsStore } from '@/store/modules/widgets';
+import { type FC } from 'react';
+
+export const WidgetHeader: FC<{
+  widgetId: string;
+}> = ({ widgetId }) => {
+  const widget = useSelector((state) =>
+    widgetsStore.selector.getWidget(state, widgetId),
+  );
+
+  return (
+    widget &&
+    widget.widget?.header && (
+      <h1 className="text-3xl font-bold tracking-tight">
+        {widget.widget.header.text}
+      </h1>
+    )
+  );
+};
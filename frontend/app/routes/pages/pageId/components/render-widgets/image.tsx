@@ -0,0 +1,86 @@
+import { requestDownload } from '@/lib/fileTransfer';
+import { useSelector } from '@/store';
+import { widgetsStore } from '@/store/modules/widgets';
+import { ImageOff } from 'lucide-react';
+import { useEffect, useState, type FC } from 'react';
+
+export const WidgetImage: FC<{
+  widgetId: string;
+}> = ({ widgetId }) => {
+  const widget = useSelector((state) =>
+    widgetsStore.selector.getWidget(state, widgetId),
+  );
+  const image = widget?.widget?.image;
+  const [objectUrl, setObjectUrl] = useState<string>();
+  const [error, setError] = useState<string | null>(null);
+
+  // Bytes are fetched after every run, since the page may have replaced
+  // them without changing the MIME type or size.
+  const hasBytes = !!image && !image.url && Number(image.size ?? 0) > 0;
+  useEffect(() => {
+    if (!hasBytes) {
+      return;
+    }
+    let cancelled = false;
+    requestDownload(widgetId)
+      .then(({ blob }) => {
+        if (cancelled) {
+          return;
+        }
+        setError(null);
+        setObjectUrl(URL.createObjectURL(blob));
+      })
+      .catch((e) => {
+        if (!cancelled) {
+          setError(e instanceof Error ? e.message : String(e));
+        }
+      });
+    return () => {
+      cancelled = true;
+    };
+  }, [widgetId, hasBytes, image]);
+
+  useEffect(
+    () => () => {
+      if (objectUrl) {
+        URL.revokeObjectURL(objectUrl);
+      }
+    },
+    [objectUrl],
+  );
+
+  if (!widget || !image) {
+    return null;
+  }
+
+  const src = image.url || (hasBytes ? objectUrl : undefined);
+  const style = image.width ? { width: image.width } : undefined;
+
+  return (
+    <figure className="space-y-2">
+      {src ? (
+        <img
+          src={src}
+          alt={image.caption ?? ''}
+          className="max-w-full"
+          style={style}
+        />
+      ) : (
+        // Unsupported sources render an empty placeholder so the caption
+        // can explain what went wrong; bytes show it until they arrive.
+        <div
+          className="flex h-32 max-w-full items-center justify-center rounded-md border border-dashed text-muted-foreground"
+          style={style}
+        >
+          {!hasBytes && <ImageOff className="size-6" />}
+        </div>
+      )}
+      {image.caption && (
+        <figcaption className="text-sm text-muted-foreground">
+          {image.caption}
+        </figcaption>
+      )}
+      {error && <p className="text-sm font-medium text-destructive">{error}</p>}
+    </figure>
+  );
+};
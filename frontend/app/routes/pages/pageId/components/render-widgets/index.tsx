@@ -17,6 +17,33 @@ import { WidgetCheckbox } from './checkbox';
 import { WidgetForm } from './form';
 import { WidgetCheckboxGroup } from './checkbox-group';
 import { WidgetRadio } from './radio';
+import { WidgetFileInput } from './file-input';
+import { WidgetDownloadButton } from './download-button';
+import { WidgetChart } from './chart';
+import { WidgetTabs } from './tabs';
+import { WidgetTabItem } from './tabitem';
+import { WidgetExpander } from './expander';
+import { WidgetDialog } from './dialog';
+import { WidgetAlert } from './alert';
+import { WidgetMetric } from './metric';
+import { WidgetProgress } from './progress';
+import { WidgetSpinner } from './spinner';
+import { WidgetSlider } from './slider';
+import { WidgetRangeSlider } from './range-slider';
+import { WidgetToggle } from './toggle';
+import { WidgetDateRangeInput } from './date-range-input';
+import { WidgetJson } from './json';
+import { WidgetCodeEditor } from './code-editor';
+import { WidgetImage } from './image';
+import { WidgetLink } from './link';
+import { WidgetPageLink } from './page-link';
+import { WidgetHeader } from './header';
+import { WidgetSubheader } from './subheader';
+import { WidgetCaption } from './caption';
+import { WidgetDivider } from './divider';
+import { WidgetSpacer } from './spacer';
+import { WidgetTagInput } from './tag-input';
+import { WidgetColorInput } from './color-input';
 
 export const RenderWidgets = ({
   parentPath,
@@ -46,6 +73,9 @@ export const RenderWidgets = ({
     if (widgetType === 'dateTimeInput') {
       return <WidgetDateTimeInput key={id} widgetId={id} />;
     }
+    if (widgetType === 'dateRangeInput') {
+      return <WidgetDateRangeInput key={id} widgetId={id} />;
+    }
     if (widgetType === 'timeInput') {
       return <WidgetTimeInput key={id} widgetId={id} />;
     }
@@ -55,18 +85,57 @@ export const RenderWidgets = ({
     if (widgetType === 'textArea') {
       return <WidgetTextarea key={id} widgetId={id} />;
     }
+    if (widgetType === 'codeEditor') {
+      return <WidgetCodeEditor key={id} widgetId={id} />;
+    }
     if (widgetType === 'selectbox') {
       return <WidgetSelectbox key={id} widgetId={id} />;
     }
     if (widgetType === 'multiSelect') {
       return <WidgetMultiSelect key={id} widgetId={id} />;
     }
+    if (widgetType === 'tagInput') {
+      return <WidgetTagInput key={id} widgetId={id} />;
+    }
+    if (widgetType === 'colorInput') {
+      return <WidgetColorInput key={id} widgetId={id} />;
+    }
     if (widgetType === 'checkbox') {
       return <WidgetCheckbox key={id} widgetId={id} />;
     }
     if (widgetType === 'checkboxGroup') {
       return <WidgetCheckboxGroup key={id} widgetId={id} />;
     }
+    if (widgetType === 'slider') {
+      return <WidgetSlider key={id} widgetId={id} />;
+    }
+    if (widgetType === 'rangeSlider') {
+      return <WidgetRangeSlider key={id} widgetId={id} />;
+    }
+    if (widgetType === 'toggle') {
+      return <WidgetToggle key={id} widgetId={id} />;
+    }
+    if (widgetType === 'fileInput') {
+      return <WidgetFileInput key={id} widgetId={id} />;
+    }
+    if (widgetType === 'downloadButton') {
+      return <WidgetDownloadButton key={id} widgetId={id} />;
+    }
+    if (widgetType === 'chart') {
+      return <WidgetChart key={id} widgetId={id} />;
+    }
+    if (widgetType === 'json') {
+      return <WidgetJson key={id} widgetId={id} />;
+    }
+    if (widgetType === 'image') {
+      return <WidgetImage key={id} widgetId={id} />;
+    }
+    if (widgetType === 'link') {
+      return <WidgetLink key={id} widgetId={id} />;
+    }
+    if (widgetType === 'pageLink') {
+      return <WidgetPageLink key={id} widgetId={id} />;
+    }
     if (widgetType === 'table') {
       return <WidgetTable key={id} widgetId={id} />;
     }
@@ -76,6 +145,33 @@ export const RenderWidgets = ({
     if (widgetType === 'markdown') {
       return <WidgetMarkdown key={id} widgetId={id} />;
     }
+    if (widgetType === 'header') {
+      return <WidgetHeader key={id} widgetId={id} />;
+    }
+    if (widgetType === 'subheader') {
+      return <WidgetSubheader key={id} widgetId={id} />;
+    }
+    if (widgetType === 'caption') {
+      return <WidgetCaption key={id} widgetId={id} />;
+    }
+    if (widgetType === 'divider') {
+      return <WidgetDivider key={id} widgetId={id} />;
+    }
+    if (widgetType === 'spacer') {
+      return <WidgetSpacer key={id} widgetId={id} />;
+    }
+    if (widgetType === 'alert') {
+      return <WidgetAlert key={id} widgetId={id} />;
+    }
+    if (widgetType === 'metric') {
+      return <WidgetMetric key={id} widgetId={id} />;
+    }
+    if (widgetType === 'progress') {
+      return <WidgetProgress key={id} widgetId={id} />;
+    }
+    if (widgetType === 'spinner') {
+      return <WidgetSpinner key={id} widgetId={id} />;
+    }
     if (widgetType === 'columns') {
       return (
         <WidgetColumns key={id} widgetId={id}>
@@ -96,6 +192,46 @@ export const RenderWidgets = ({
         </WidgetColumnItem>
       );
     }
+    if (widgetType === 'tabs') {
+      return (
+        <WidgetTabs key={id} widgetId={id}>
+          <RenderWidgets
+            parentPath={[...parentPath, index]}
+            parentWidgetId={id}
+          />
+        </WidgetTabs>
+      );
+    }
+    if (widgetType === 'tabItem') {
+      return (
+        <WidgetTabItem key={id} widgetId={id} index={index}>
+          <RenderWidgets
+            parentPath={[...parentPath, index]}
+            parentWidgetId={id}
+          />
+        </WidgetTabItem>
+      );
+    }
+    if (widgetType === 'expander') {
+      return (
+        <WidgetExpander key={id} widgetId={id}>
+          <RenderWidgets
+            parentPath={[...parentPath, index]}
+            parentWidgetId={id}
+          />
+        </WidgetExpander>
+      );
+    }
+    if (widgetType === 'dialog') {
+      return (
+        <WidgetDialog key={id} widgetId={id}>
+          <RenderWidgets
+            parentPath={[...parentPath, index]}
+            parentWidgetId={id}
+          />
+        </WidgetDialog>
+      );
+    }
     if (widgetType === 'form') {
       return (
         <WidgetForm key={id} widgetId={id}>
@@ -0,0 +1,130 @@
+import { cn, parseJsonBytes } from '@/lib/utils';
+import { useSelector } from '@/store';
+import { widgetsStore } from '@/store/modules/widgets';
+import { ChevronRight } from 'lucide-react';
+import { useMemo, useState, type FC } from 'react';
+
+const JsonValue: FC<{ value: unknown }> = ({ value }) => {
+  if (value === null) {
+    return <span className="text-muted-foreground">null</span>;
+  }
+  if (typeof value === 'string') {
+    return (
+      <span className="break-all text-green-700 dark:text-green-400">
+        {JSON.stringify(value)}
+      </span>
+    );
+  }
+  if (typeof value === 'number') {
+    return <span className="text-blue-700 dark:text-blue-400">{value}</span>;
+  }
+  return (
+    <span className="text-purple-700 dark:text-purple-400">
+      {String(value)}
+    </span>
+  );
+};
+
+// JsonNode renders one key and its value. Objects and arrays start expanded
+// while depth is below expandedDepth; a negative expandedDepth expands all.
+const JsonNode: FC<{
+  name?: string;
+  value: unknown;
+  depth: number;
+  expandedDepth: number;
+}> = ({ name, value, depth, expandedDepth }) => {
+  const [open, setOpen] = useState(
+    expandedDepth < 0 || depth < expandedDepth,
+  );
+  const label = name !== undefined && (
+    <span className="text-foreground">{name}: </span>
+  );
+
+  if (value === null || typeof value !== 'object') {
+    return (
+      <div className="pl-5">
+        {label}
+        <JsonValue value={value} />
+      </div>
+    );
+  }
+
+  const isArray = Array.isArray(value);
+  const entries = Object.entries(value);
+  const [openBracket, closeBracket] = isArray ? ['[', ']'] : ['{', '}'];
+
+  return (
+    <div>
+      <button
+        type="button"
+        className="flex items-center gap-1 hover:bg-muted"
+        onClick={() => setOpen(!open)}
+      >
+        <ChevronRight
+          className={cn('size-4 transition-transform', open && 'rotate-90')}
+        />
+        {label}
+        <span className="text-muted-foreground">
+          {open
+            ? openBracket
+            : `${openBracket} ${entries.length} ${isArray ? 'items' : 'keys'} ${closeBracket}`}
+        </span>
+      </button>
+      {open && (
+        <>
+          <div className="ml-2 border-l pl-2">
+            {entries.map(([key, child]) => (
+              <JsonNode
+                key={key}
+                name={isArray ? undefined : key}
+                value={child}
+                depth={depth + 1}
+                expandedDepth={expandedDepth}
+              />
+            ))}
+          </div>
+          <div className="pl-5 text-muted-foreground">{closeBracket}</div>
+        </>
+      )}
+    </div>
+  );
+};
+
+export const WidgetJson: FC<{
+  widgetId: string;
+}> = ({ widgetId }) => {
+  const widget = useSelector((state) =>
+    widgetsStore.selector.getWidget(state, widgetId),
+  );
+  const json = widget?.widget?.json;
+
+  const { data, parseError } = useMemo(() => {
+    try {
+      return { data: parseJsonBytes(json?.data), parseError: null };
+    } catch (e) {
+      return {
+        data: null,
+        parseError: e instanceof Error ? e.message : String(e),
+      };
+    }
+  }, [json?.data]);
+
+  if (!widget || !json) {
+    return null;
+  }
+
+  const error = json.error || parseError;
+  if (error) {
+    return <p className="text-sm font-medium text-destructive">{error}</p>;
+  }
+
+  return (
+    <div className="overflow-x-auto rounded-md border bg-muted/30 p-3 font-mono text-sm">
+      <JsonNode
+        value={data}
+        depth={0}
+        expandedDepth={json.expandedDepth ?? 0}
+      />
+    </div>
+  );
+};
@@ -0,0 +1,27 @@
+import { useSelector } from '@/store';
+import { widgetsStore } from '@/store/modules/widgets';
+import { ExternalLink } from 'lucide-react';
+import { type FC } from 'react';
+
+export const WidgetLink: FC<{
+  widgetId: string;
+}> = ({ widgetId }) => {
+  const widget = useSelector((state) =>
+    widgetsStore.selector.getWidget(state, widgetId),
+  );
+
+  return (
+    widget &&
+    widget.widget?.link && (
+      <a
+        href={widget.widget.link.url}
+        target="_blank"
+        rel="noopener noreferrer"
+        className="inline-flex items-center gap-1 text-sm font-medium text-primary underline-offset-4 hover:underline"
+      >
+        {widget.widget.link.label}
+        <ExternalLink className="size-3.5" />
+      </a>
+    )
+  );
+};
@@ -0,0 +1,49 @@
+import { cn } from '@/lib/utils';
+import { useSelector } from '@/store';
+import { widgetsStore } from '@/store/modules/widgets';
+import { ArrowDown, ArrowUp } from 'lucide-react';
+import { type FC } from 'react';
+
+// deltaClassName colours a delta by its direction. With the inverse colour
+// a rise is bad, e.g. for latency.
+const deltaClassName = (direction?: string, color?: string) => {
+  if (color === 'off' || direction === 'none') {
+    return 'text-muted-foreground';
+  }
+  const good = (direction === 'up') !== (color === 'inverse');
+  return good ? 'text-green-600' : 'text-destructive';
+};
+
+export const WidgetMetric: FC<{
+  widgetId: string;
+}> = ({ widgetId }) => {
+  const widget = useSelector((state) =>
+    widgetsStore.selector.getWidget(state, widgetId),
+  );
+
+  if (!widget || !widget.widget?.metric) {
+    return null;
+  }
+
+  const { label, value, delta, deltaDirection, deltaColor } =
+    widget.widget.metric;
+
+  return (
+    <div className="space-y-1">
+      <p className="text-sm text-muted-foreground">{label}</p>
+      <p className="text-3xl font-semibold tracking-tight">{value}</p>
+      {delta && (
+        <p
+          className={cn(
+            'flex items-center gap-1 text-sm font-medium',
+            deltaClassName(deltaDirection, deltaColor),
+          )}
+        >
+          {deltaDirection === 'up' && <ArrowUp className="size-4" />}
+          {deltaDirection === 'down' && <ArrowDown className="size-4" />}
+          {delta}
+        </p>
+      )}
+    </div>
+  );
+};
@@ -0,0 +1,50 @@
+import { useSelector } from '@/store';
+import { widgetsStore } from '@/store/modules/widgets';
+import { useNavigate } from '@tanstack/react-router';
+import { type FC, type MouseEvent } from 'react';
+
+export const WidgetPageLink: FC<{
+  widgetId: string;
+}> = ({ widgetId }) => {
+  const navigate = useNavigate();
+  const widget = useSelector((state) =>
+    widgetsStore.selector.getWidget(state, widgetId),
+  );
+  const pageLink = widget?.widget?.pageLink;
+
+  if (!widget || !pageLink) {
+    return null;
+  }
+
+  // Links to unknown routes are rendered disabled so that typos show.
+  if (pageLink.disabled) {
+    return (
+      <span className="cursor-not-allowed text-sm font-medium text-muted-foreground">
+        {pageLink.label}
+      </span>
+    );
+  }
+
+  // The query string is passed through as is, so the destination page sees
+  // exactly the parameters the SDK encoded.
+  const href = pageLink.query
+    ? `/pages${pageLink.route}?${pageLink.query}`
+    : `/pages${pageLink.route}`;
+  const handleClick = (e: MouseEvent<HTMLAnchorElement>) => {
+    if (e.metaKey || e.ctrlKey || e.shiftKey || e.button !== 0) {
+      return;
+    }
+    e.preventDefault();
+    navigate({ href });
+  };
+
+  return (
+    <a
+      href={href}
+      onClick={handleClick}
+      className="text-sm font-medium text-primary underline-offset-4 hover:underline"
+    >
+      {pageLink.label}
+    </a>
+  );
+};
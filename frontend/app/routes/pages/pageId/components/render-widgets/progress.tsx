@@ -0,0 +1,29 @@
+import { Progress } from '@/components/ui/progress';
+import { useSelector } from '@/store';
+import { widgetsStore } from '@/store/modules/widgets';
+import { type FC } from 'react';
+
+export const WidgetProgress: FC<{
+  widgetId: string;
+}> = ({ widgetId }) => {
+  const widget = useSelector((state) =>
+    widgetsStore.selector.getWidget(state, widgetId),
+  );
+
+  if (!widget || !widget.widget?.progress) {
+    return null;
+  }
+
+  const { label, value, text } = widget.widget.progress;
+  const percent = Math.round(Number(value ?? 0) * 100);
+
+  return (
+    <div className="space-y-2">
+      <div className="flex items-center justify-between text-sm">
+        {label && <span className="font-medium">{label}</span>}
+        <span className="text-muted-foreground">{text || `${percent}%`}</span>
+      </div>
+      <Progress value={percent} />
+    </div>
+  );
+};
@@ -0,0 +1,68 @@
+import { Label } from '@/components/ui/label';
+import { Slider } from '@/components/ui/slider';
+import { toNumber } from '@/lib/utils';
+import { useDispatch, useSelector } from '@/store';
+import { widgetsStore } from '@/store/modules/widgets';
+import { useEffect, useId, useState, type FC } from 'react';
+
+export const WidgetRangeSlider: FC<{
+  widgetId: string;
+}> = ({ widgetId }) => {
+  const id = useId();
+  const dispatch = useDispatch();
+  const widget = useSelector((state) =>
+    widgetsStore.selector.getWidget(state, widgetId),
+  );
+  const isWidgetWaiting = useSelector((state) => state.widgets.isWidgetWaiting);
+
+  const rangeSlider = widget?.widget?.rangeSlider;
+  const low = toNumber(rangeSlider?.low);
+  const high = toNumber(rangeSlider?.high);
+  // The handles move locally while dragging; the pair is only sent once a
+  // handle is released.
+  const [dragValue, setDragValue] = useState([low, high]);
+  useEffect(() => {
+    setDragValue([low, high]);
+  }, [low, high]);
+
+  const handleCommit = ([nextLow, nextHigh]: number[]) => {
+    if (isWidgetWaiting || (nextLow === low && nextHigh === high)) {
+      return;
+    }
+    dispatch(
+      widgetsStore.actions.setWidgetValue({
+        widgetId,
+        widgetType: 'rangeSlider',
+        value: { low: nextLow, high: nextHigh },
+      }),
+    );
+  };
+
+  return (
+    widget &&
+    rangeSlider && (
+      <div className="space-y-3">
+        <div className="flex items-center justify-between gap-2">
+          {rangeSlider.label && (
+            <Label className="block" htmlFor={id}>
+              {rangeSlider.label}
+            </Label>
+          )}
+          <span className="text-sm text-muted-foreground tabular-nums">
+            {dragValue[0]} – {dragValue[1]}
+          </span>
+        </div>
+        <Slider
+          id={id}
+          value={dragValue}
+          min={toNumber(rangeSlider.minValue)}
+          max={toNumber(rangeSlider.maxValue, 100)}
+          step={toNumber(rangeSlider.step, 1)}
+          disabled={rangeSlider.disabled || isWidgetWaiting}
+          onValueChange={setDragValue}
+          onValueCommit={handleCommit}
+        />
+      </div>
+    )
+  );
+};
@@ -0,0 +1,67 @@
+import { Label } from '@/components/ui/label';
+import { Slider } from '@/components/ui/slider';
+import { toNumber } from '@/lib/utils';
+import { useDispatch, useSelector } from '@/store';
+import { widgetsStore } from '@/store/modules/widgets';
+import { useEffect, useId, useState, type FC } from 'react';
+
+export const WidgetSlider: FC<{
+  widgetId: string;
+}> = ({ widgetId }) => {
+  const id = useId();
+  const dispatch = useDispatch();
+  const widget = useSelector((state) =>
+    widgetsStore.selector.getWidget(state, widgetId),
+  );
+  const isWidgetWaiting = useSelector((state) => state.widgets.isWidgetWaiting);
+
+  const slider = widget?.widget?.slider;
+  const value = toNumber(slider?.value);
+  // The handle moves locally while dragging; the value is only sent once it
+  // is released.
+  const [dragValue, setDragValue] = useState(value);
+  useEffect(() => {
+    setDragValue(value);
+  }, [value]);
+
+  const handleCommit = ([next]: number[]) => {
+    if (isWidgetWaiting || next === value) {
+      return;
+    }
+    dispatch(
+      widgetsStore.actions.setWidgetValue({
+        widgetId,
+        widgetType: 'slider',
+        value: next,
+      }),
+    );
+  };
+
+  return (
+    widget &&
+    slider && (
+      <div className="space-y-3">
+        <div className="flex items-center justify-between gap-2">
+          {slider.label && (
+            <Label className="block" htmlFor={id}>
+              {slider.label}
+            </Label>
+          )}
+          <span className="text-sm text-muted-foreground tabular-nums">
+            {dragValue}
+          </span>
+        </div>
+        <Slider
+          id={id}
+          value={[dragValue]}
+          min={toNumber(slider.minValue)}
+          max={toNumber(slider.maxValue, 100)}
+          step={toNumber(slider.step, 1)}
+          disabled={slider.disabled || isWidgetWaiting}
+          onValueChange={([next]) => setDragValue(next)}
+          onValueCommit={handleCommit}
+        />
+      </div>
+    )
+  );
+};
@@ -0,0 +1,15 @@
+import { useSelector } from '@/store';
+import { widgetsStore } from '@/store/modules/widgets';
+import { type FC } from 'react';
+
+export const WidgetSpacer: FC<{
+  widgetId: string;
+}> = ({ widgetId }) => {
+  const widget = useSelector((state) =>
+    widgetsStore.selector.getWidget(state, widgetId),
+  );
+
+  return (
+    widget && widget.widget?.spacer && <div aria-hidden className="h-6" />
+  );
+};
@@ -0,0 +1,22 @@
+import { useSelector } from '@/store';
+import { widgetsStore } from '@/store/modules/widgets';
+import { Loader2 } from 'lucide-react';
+import { type FC } from 'react';
+
+export const WidgetSpinner: FC<{
+  widgetId: string;
+}> = ({ widgetId }) => {
+  const widget = useSelector((state) =>
+    widgetsStore.selector.getWidget(state, widgetId),
+  );
+
+  return (
+    widget &&
+    widget.widget?.spinner?.active && (
+      <div className="flex items-center gap-2 text-sm text-muted-foreground">
+        <Loader2 className="size-4 animate-spin" />
+        {widget.widget.spinner.text}
+      </div>
+    )
+  );
+};
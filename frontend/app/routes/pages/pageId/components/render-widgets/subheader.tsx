@@ -0,0 +1,20 @@
+import { useSelector } from '@/store';
+import { widgetsStore } from '@/store/modules/widgets';
+import { type FC } from 'react';
+
+export const WidgetSubheader: FC<{
+  widgetId: string;
+}> = ({ widgetId }) => {
+  const widget = useSelector((state) =>
+    widgetsStore.selector.getWidget(state, widgetId),
+  );
+
+  return (
+    widget &&
+    widget.widget?.subheader && (
+      <h2 className="text-xl font-semibold tracking-tight">
+        {widget.widget.subheader.text}
+      </h2>
+    )
+  );
+};
@@ -0,0 +1,23 @@
+import { TabsContent } from '@/components/ui/tabs';
+import { useSelector } from '@/store';
+import { widgetsStore } from '@/store/modules/widgets';
+import { type FC } from 'react';
+
+export const WidgetTabItem: FC<{
+  widgetId: string;
+  index: number;
+  children?: React.ReactNode;
+}> = ({ widgetId, index, children }) => {
+  const widget = useSelector((state) =>
+    widgetsStore.selector.getWidget(state, widgetId),
+  );
+
+  return (
+    widget &&
+    widget.widget?.tabItem && (
+      <TabsContent value={String(index)} className="flex flex-col gap-6">
+        {children}
+      </TabsContent>
+    )
+  );
+};
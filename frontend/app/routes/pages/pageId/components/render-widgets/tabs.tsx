@@ -0,0 +1,55 @@
+import { Tabs, TabsList, TabsTrigger } from '@/components/ui/tabs';
+import { useDispatch, useSelector } from '@/store';
+import { widgetsStore } from '@/store/modules/widgets';
+import { type FC } from 'react';
+
+export const WidgetTabs: FC<{
+  widgetId: string;
+  children?: React.ReactNode;
+}> = ({ widgetId, children }) => {
+  const dispatch = useDispatch();
+  const widget = useSelector((state) =>
+    widgetsStore.selector.getWidget(state, widgetId),
+  );
+  const widgetEntities = useSelector((state) =>
+    widgetsStore.selector.getWidgetEntities(state),
+  );
+  const tabItems = useSelector((state) =>
+    widgetsStore.selector.getPathWidgetIdAndTypes(
+      state,
+      widget?.path ?? [],
+      widgetId,
+    ),
+  ).filter(({ widgetType }) => widgetType === 'tabItem');
+
+  // Switching tabs does not rerun the page; the selected tab is sent with
+  // the next rerun so that the SDK keeps it.
+  const handleValueChange = (value: string) => {
+    dispatch(
+      widgetsStore.actions.setWidgetValueWithoutRerun({
+        widgetId,
+        widgetType: 'tabs',
+        value: Number(value),
+      }),
+    );
+  };
+
+  return (
+    widget &&
+    widget.widget?.tabs && (
+      <Tabs
+        value={String(widget.widget.tabs.value ?? 0)}
+        onValueChange={handleValueChange}
+      >
+        <TabsList>
+          {tabItems.map(({ id }, index) => (
+            <TabsTrigger key={id} value={String(index)}>
+              {widgetEntities[id]?.widget?.tabItem?.label}
+            </TabsTrigger>
+          ))}
+        </TabsList>
+        {children}
+      </Tabs>
+    )
+  );
+};
@@ -0,0 +1,132 @@
+import { Badge } from '@/components/ui/badge';
+import { Label } from '@/components/ui/label';
+import { cn } from '@/lib/utils';
+import { useDispatch, useSelector } from '@/store';
+import { widgetsStore } from '@/store/modules/widgets';
+import { X } from 'lucide-react';
+import { useId, useState, type FC, type KeyboardEvent } from 'react';
+
+export const WidgetTagInput: FC<{
+  widgetId: string;
+}> = ({ widgetId }) => {
+  const id = useId();
+  const dispatch = useDispatch();
+  const widget = useSelector((state) =>
+    widgetsStore.selector.getWidget(state, widgetId),
+  );
+  const state = useSelector((state) =>
+    widgetsStore.selector.getWidgetState(state, widgetId),
+  );
+  const isWidgetWaiting = useSelector((state) => state.widgets.isWidgetWaiting);
+  const [draft, setDraft] = useState('');
+
+  const tagInput = widget?.widget?.tagInput;
+  const tags = state?.type === 'tagInput' ? (state.value ?? []) : [];
+  const maxTags = tagInput?.maxTags ?? 0;
+  const isFull = maxTags > 0 && tags.length >= maxTags;
+  const disabled = tagInput?.disabled || isWidgetWaiting;
+
+  const handleValue = (value: string[]) => {
+    if (isWidgetWaiting) {
+      return;
+    }
+    dispatch(
+      widgetsStore.actions.setWidgetValue({
+        widgetId,
+        widgetType: 'tagInput',
+        value,
+      }),
+    );
+    dispatch(
+      widgetsStore.actions.setWidgetState({
+        widgetId,
+        widgetType: 'tagInput',
+        value,
+      }),
+    );
+  };
+
+  // Values are trimmed and deduplicated here as well as in the SDK, so the
+  // chips match what the page gets back.
+  const addTag = (value: string) => {
+    const tag = value.trim();
+    setDraft('');
+    if (!tag || isFull || tags.includes(tag)) {
+      return;
+    }
+    handleValue([...tags, tag]);
+  };
+
+  const handleKeyDown = (e: KeyboardEvent<HTMLInputElement>) => {
+    if (e.key === 'Enter' || e.key === ',') {
+      e.preventDefault();
+      addTag(draft);
+    } else if (e.key === 'Backspace' && !draft && tags.length > 0) {
+      handleValue(tags.slice(0, -1));
+    }
+  };
+
+  const suggestions = (tagInput?.suggestions ?? []).filter(
+    (suggestion) => !tags.includes(suggestion),
+  );
+
+  return (
+    widget &&
+    tagInput &&
+    state?.type === 'tagInput' && (
+      <div className="space-y-2">
+        {tagInput.label && (
+          <Label
+            className={cn('block', state.error && 'text-destructive')}
+            htmlFor={id}
+          >
+            {tagInput.label}
+          </Label>
+        )}
+        <div
+          className={cn(
+            'flex min-h-9 flex-wrap items-center gap-1 rounded-md border border-input px-2 py-1 shadow-xs focus-within:ring-[3px] focus-within:ring-ring/50',
+            disabled && 'cursor-not-allowed opacity-50',
+          )}
+        >
+          {tags.map((tag) => (
+            <Badge key={tag} variant="secondary" className="gap-1">
+              {tag}
+              <button
+                type="button"
+                aria-label={`Remove ${tag}`}
+                disabled={disabled}
+                onClick={() => handleValue(tags.filter((t) => t !== tag))}
+              >
+                <X className="size-3" />
+              </button>
+            </Badge>
+          ))}
+          <input
+            id={id}
+            className="min-w-24 flex-1 bg-transparent text-sm outline-none placeholder:text-muted-foreground"
+            list={suggestions.length > 0 ? `${id}-suggestions` : undefined}
+            value={draft}
+            placeholder={tags.length === 0 ? tagInput.placeholder : undefined}
+            disabled={disabled || isFull}
+            onChange={(e) => setDraft(e.target.value)}
+            onKeyDown={handleKeyDown}
+            onBlur={() => addTag(draft)}
+          />
+          {suggestions.length > 0 && (
+            <datalist id={`${id}-suggestions`}>
+              {suggestions.map((suggestion) => (
+                <option key={suggestion} value={suggestion} />
+              ))}
+            </datalist>
+          )}
+        </div>
+        {state.error && (
+          <p className={cn('text-sm font-medium text-destructive')}>
+            {state.error.message}
+          </p>
+        )}
+      </div>
+    )
+  );
+};
@@ -0,0 +1,45 @@
+import { Label } from '@/components/ui/label';
+import { Switch } from '@/components/ui/switch';
+import { useDispatch, useSelector } from '@/store';
+import { widgetsStore } from '@/store/modules/widgets';
+import { useId, type FC } from 'react';
+
+export const WidgetToggle: FC<{
+  widgetId: string;
+}> = ({ widgetId }) => {
+  const id = useId();
+  const dispatch = useDispatch();
+  const widget = useSelector((state) =>
+    widgetsStore.selector.getWidget(state, widgetId),
+  );
+  const isWidgetWaiting = useSelector((state) => state.widgets.isWidgetWaiting);
+
+  // Without rerunOnChange the value waits for the next rerun, like a
+  // checkbox.
+  const handleChange = (value: boolean) => {
+    if (isWidgetWaiting) {
+      return;
+    }
+    const action = widget?.widget?.toggle?.rerunOnChange
+      ? widgetsStore.actions.setWidgetValue
+      : widgetsStore.actions.setWidgetValueWithoutRerun;
+    dispatch(action({ widgetId, widgetType: 'toggle', value }));
+  };
+
+  return (
+    widget &&
+    widget.widget?.toggle && (
+      <div className="flex items-center space-x-2">
+        <Switch
+          id={id}
+          checked={widget.widget.toggle.value ?? false}
+          onCheckedChange={handleChange}
+          disabled={widget.widget.toggle.disabled || isWidgetWaiting}
+        />
+        {widget.widget.toggle.label && (
+          <Label htmlFor={id}>{widget.widget.toggle.label}</Label>
+        )}
+      </div>
+    )
+  );
+};
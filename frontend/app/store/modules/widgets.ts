@@ -13,17 +13,27 @@ import type {
   ButtonJson,
   CheckboxGroupJson,
   CheckboxJson,
+  CodeEditorJson,
+  ColorInputJson,
   DateInputJson,
+  DateRangeInputJson,
   DateTimeInputJson,
+  ExpanderJson,
+  FileInputJson,
   FormJson,
   MultiSelectJson,
   NumberInputJson,
   RadioJson,
+  RangeSliderJson,
   SelectboxJson,
+  SliderJson,
   TableJson,
+  TagInputJson,
+  TabsJson,
   TextAreaJson,
   TextInputJson,
   TimeInputJson,
+  ToggleJson,
   WidgetJson,
 } from '@/pb/ts/widget/v1/widget_pb';
 import dayjs from 'dayjs';
@@ -45,6 +55,18 @@ export const inputWidgetTypes = [
   'checkbox',
   'radio',
   'checkboxGroup',
+  'codeEditor',
+  'colorInput',
+] as const;
+
+// formItemWidgetTypes are the widgets a form validates and clears on submit.
+// Date ranges keep their value in two fields and tag inputs have an array
+// default that setWidgetData would compare by reference, so neither is an
+// input widget.
+const formItemWidgetTypes = [
+  ...inputWidgetTypes,
+  'dateRangeInput',
+  'tagInput',
 ] as const;
 
 export type Widget = RenderWidgetJson;
@@ -58,7 +80,9 @@ export const getChildFormItemWidgetIds = (
       if (
         !widget ||
         widget.widget?.form ||
-        !inputWidgetTypes.some((type) => widget.widget && type in widget.widget)
+        !formItemWidgetTypes.some(
+          (type) => widget.widget && type in widget.widget,
+        )
       ) {
         return false;
       }
@@ -83,6 +107,52 @@ export const checkParentForm = (
   );
 };
 
+// closeParentDialogs closes the dialogs around path that are set to close
+// when a button or form inside them is submitted.
+const closeParentDialogs = (state: State, path: number[]) => {
+  state.widgets.ids.forEach((id) => {
+    const widget = state.widgets.entities[id];
+    const dialogPath = widget?.path ?? [];
+    if (
+      widget?.widget?.dialog?.closeOnSubmit &&
+      dialogPath.length < path.length &&
+      dialogPath.every((p, index) => p === path[index])
+    ) {
+      widget.widget.dialog.open = false;
+    }
+  });
+};
+
+// assignWidgetValue stores a value on the widget sent with the next rerun.
+// Range sliders and date ranges keep their value in two fields instead of
+// one.
+const assignWidgetValue = (
+  widget: WidgetJson,
+  payload: SetWidgetStatePayload,
+) => {
+  if (!Object.keys(widget).includes(payload.widgetType)) {
+    return;
+  }
+  if (payload.widgetType === 'rangeSlider') {
+    if (widget.rangeSlider) {
+      widget.rangeSlider.low = payload.value.low;
+      widget.rangeSlider.high = payload.value.high;
+    }
+    return;
+  }
+  if (payload.widgetType === 'dateRangeInput') {
+    if (widget.dateRangeInput) {
+      widget.dateRangeInput.startValue = payload.value.startValue;
+      widget.dateRangeInput.endValue = payload.value.endValue;
+    }
+    return;
+  }
+  const target = widget[payload.widgetType];
+  if (target) {
+    target.value = payload.value;
+  }
+};
+
 export type SetWidgetStatePayload = {
   widgetId: string;
 } & (
@@ -98,6 +168,10 @@ export type SetWidgetStatePayload = {
       widgetType: Extract<WidgetType, 'dateInput'>;
       value: DateInputJson['value'];
     }
+  | {
+      widgetType: Extract<WidgetType, 'dateRangeInput'>;
+      value: Pick<DateRangeInputJson, 'startValue' | 'endValue'>;
+    }
   | {
       widgetType: Extract<WidgetType, 'dateTimeInput'>;
       value: DateTimeInputJson['value'];
@@ -114,6 +188,14 @@ export type SetWidgetStatePayload = {
       widgetType: Extract<WidgetType, 'textArea'>;
       value: TextAreaJson['value'];
     }
+  | {
+      widgetType: Extract<WidgetType, 'codeEditor'>;
+      value: CodeEditorJson['value'];
+    }
+  | {
+      widgetType: Extract<WidgetType, 'colorInput'>;
+      value: ColorInputJson['value'];
+    }
   | {
       widgetType: Extract<WidgetType, 'multiSelect'>;
       value: MultiSelectJson['value'];
@@ -130,6 +212,34 @@ export type SetWidgetStatePayload = {
       widgetType: Extract<WidgetType, 'checkboxGroup'>;
       value: CheckboxGroupJson['value'];
     }
+  | {
+      widgetType: Extract<WidgetType, 'tagInput'>;
+      value: TagInputJson['value'];
+    }
+  | {
+      widgetType: Extract<WidgetType, 'fileInput'>;
+      value: FileInputJson['value'];
+    }
+  | {
+      widgetType: Extract<WidgetType, 'slider'>;
+      value: SliderJson['value'];
+    }
+  | {
+      widgetType: Extract<WidgetType, 'rangeSlider'>;
+      value: Pick<RangeSliderJson, 'low' | 'high'>;
+    }
+  | {
+      widgetType: Extract<WidgetType, 'toggle'>;
+      value: ToggleJson['value'];
+    }
+  | {
+      widgetType: Extract<WidgetType, 'tabs'>;
+      value: TabsJson['value'];
+    }
+  | {
+      widgetType: Extract<WidgetType, 'expander'>;
+      value: ExpanderJson['value'];
+    }
   | {
       widgetType: Extract<WidgetType, 'button'>;
       value: ButtonJson['value'];
@@ -166,6 +276,13 @@ export type WidgetState = { id: string } & (
         message: string;
       } | null;
     }
+  | {
+      type: Extract<WidgetType, 'dateRangeInput'>;
+      value: Pick<DateRangeInputJson, 'startValue' | 'endValue'>;
+      error: {
+        message: string;
+      } | null;
+    }
   | {
       type: Extract<WidgetType, 'dateTimeInput'>;
       value: DateTimeInputJson['value'];
@@ -194,6 +311,20 @@ export type WidgetState = { id: string } & (
         message: string;
       } | null;
     }
+  | {
+      type: Extract<WidgetType, 'codeEditor'>;
+      value: CodeEditorJson['value'];
+      error: {
+        message: string;
+      } | null;
+    }
+  | {
+      type: Extract<WidgetType, 'colorInput'>;
+      value: ColorInputJson['value'];
+      error: {
+        message: string;
+      } | null;
+    }
   | {
       type: Extract<WidgetType, 'multiSelect'>;
       value: MultiSelectJson['value'];
@@ -222,6 +353,20 @@ export type WidgetState = { id: string } & (
         message: string;
       } | null;
     }
+  | {
+      type: Extract<WidgetType, 'tagInput'>;
+      value: TagInputJson['value'];
+      error: {
+        message: string;
+      } | null;
+    }
+  | {
+      type: Extract<WidgetType, 'fileInput'>;
+      value: FileInputJson['value'];
+      error: {
+        message: string;
+      } | null;
+    }
   | {
       type: Extract<WidgetType, 'form'>;
       value: FormJson['value'];
@@ -318,6 +463,12 @@ const slice = createSlice({
     },
     renderWidgetCompleted: (state) => {
       const widgets = state.widgets.ids.map((id) => state.widgets.entities[id]);
+      // Spinners only show while the page is running.
+      widgets.forEach((widget) => {
+        if (widget?.widget?.spinner) {
+          widget.widget.spinner.active = false;
+        }
+      });
       const formIds = state.widgets.ids.filter((id) => {
         const widget = state.widgets.entities[id];
         return widget?.widget?.form;
@@ -343,6 +494,18 @@ const slice = createSlice({
                 (key) => key !== 'id',
               )[0] as WidgetType;
 
+              const dateRangeInput = childWidget.widget.dateRangeInput;
+              if (dateRangeInput) {
+                dateRangeInput.startValue = dateRangeInput.defaultStartValue;
+                dateRangeInput.endValue = dateRangeInput.defaultEndValue;
+                if (childWidgetState) {
+                  childWidgetState.value = {
+                    startValue: dateRangeInput.defaultStartValue,
+                    endValue: dateRangeInput.defaultEndValue,
+                  };
+                  childWidgetState.error = null;
+                }
+              }
               if ('value' in (childWidget?.widget?.[childWidgetType] ?? {})) {
                 (childWidget.widget[childWidgetType] as any).value = (
                   childWidget.widget[childWidgetType] as any
@@ -396,6 +559,30 @@ const slice = createSlice({
         }
       }
     },
+    // dismissDialog closes a dialog the user dismissed and reruns the page
+    // so that Go sees the dismissal.
+    dismissDialog: (state, action: PayloadAction<{ widgetId: string }>) => {
+      const dialog =
+        state.widgets.entities[action.payload.widgetId]?.widget?.dialog;
+      if (!dialog) {
+        return;
+      }
+      dialog.open = false;
+      dialog.value = true;
+      state.updateAt = dayjs().valueOf();
+      state.isWidgetWaiting = true;
+    },
+    // setWidgetValueWithoutRerun keeps a value for the next rerun, for
+    // widgets such as tabs whose changes do not rerun the page themselves.
+    setWidgetValueWithoutRerun: (
+      state,
+      action: PayloadAction<SetWidgetStatePayload>,
+    ) => {
+      const widget = state.widgets.entities[action.payload.widgetId];
+      if (widget?.widget) {
+        assignWidgetValue(widget.widget, action.payload);
+      }
+    },
     setWidgetValue: (state, action: PayloadAction<SetWidgetStatePayload>) => {
       const widget = state.widgets.entities[action.payload.widgetId];
       const widgets = state.widgets.ids.map((id) => state.widgets.entities[id]);
@@ -429,6 +616,7 @@ const slice = createSlice({
 
           if (!hasError) {
             widget.widget.form.value = true;
+            closeParentDialogs(state, widget.path ?? []);
             state.updateAt = dayjs().valueOf();
             state.isWidgetWaiting = true;
           }
@@ -442,14 +630,9 @@ const slice = createSlice({
           if (validateResult.success) {
             const hasParentForm = checkParentForm(widgets, widget.path ?? []);
 
-            if (
-              widget.widget &&
-              Object.keys(widget.widget).includes(action.payload.widgetType)
-            ) {
-              const target = widget.widget[action.payload.widgetType];
-              if (target) {
-                target.value = action.payload.value;
-              }
+            assignWidgetValue(widget.widget, action.payload);
+            if (action.payload.widgetType === 'button') {
+              closeParentDialogs(state, widget.path ?? []);
             }
             if (!hasParentForm) {
               state.updateAt = dayjs().valueOf();
@@ -0,0 +1,79 @@
+package sourcetool
+
+import (
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/alert"
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+)
+
+func (b *uiBuilder) Alert(level alert.Level, body string) {
+	alertOpts := &options.AlertOptions{
+		Level: level.String(),
+		Body:  body,
+	}
+
+	switch level {
+	case alert.LevelInfo, alert.LevelSuccess, alert.LevelWarning, alert.LevelError:
+	default:
+		alertOpts.Level = alert.LevelInfo.String()
+	}
+
+	sess := b.session
+	if sess == nil {
+		return
+	}
+	page := b.page
+	if page == nil {
+		return
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return
+	}
+	path := cursor.getPath()
+
+	widgetID := b.generatePageID(state.WidgetTypeAlert, path)
+	alertState := sess.State.GetAlert(widgetID)
+	if alertState == nil {
+		alertState = &state.AlertState{
+			ID: widgetID,
+		}
+	}
+	alertState.Level = alertOpts.Level
+	alertState.Body = alertOpts.Body
+	sess.State.Set(widgetID, alertState)
+
+	alert := convertStateToAlertProto(alertState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_Alert{
+				Alert: alert,
+			},
+		},
+	})
+
+	cursor.next()
+}
+
+func convertStateToAlertProto(state *state.AlertState) *widgetv1.Alert {
+	return &widgetv1.Alert{
+		Level: state.Level,
+		Body:  state.Body,
+	}
+}
+
+func convertAlertProtoToState(id uuid.UUID, data *widgetv1.Alert) *state.AlertState {
+	return &state.AlertState{
+		ID:    id,
+		Level: data.Level,
+		Body:  data.Body,
+	}
+}
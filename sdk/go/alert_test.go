@@ -0,0 +1,116 @@
+package sourcetool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/alert"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+)
+
+func TestConvertStateToAlertProto(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	alertState := &state.AlertState{
+		ID:    id,
+		Level: alert.LevelError.String(),
+		Body:  "**Invalid** amount",
+	}
+
+	data := convertStateToAlertProto(alertState)
+
+	if data == nil {
+		t.Fatal("convertStateToAlertProto returned nil")
+	}
+
+	if data.Level != alertState.Level {
+		t.Errorf("Level = %v, want %v", data.Level, alertState.Level)
+	}
+	if data.Body != alertState.Body {
+		t.Errorf("Body = %v, want %v", data.Body, alertState.Body)
+	}
+}
+
+func TestConvertAlertProtoToState(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	data := &widgetv1.Alert{
+		Level: alert.LevelWarning.String(),
+		Body:  "Careful",
+	}
+
+	state := convertAlertProtoToState(id, data)
+
+	if state == nil {
+		t.Fatal("convertAlertProtoToState returned nil")
+	}
+
+	if state.ID != id {
+		t.Errorf("ID = %v, want %v", state.ID, id)
+	}
+	if state.Level != data.Level {
+		t.Errorf("Level = %v, want %v", state.Level, data.Level)
+	}
+	if state.Body != data.Body {
+		t.Errorf("Body = %v, want %v", state.Body, data.Body)
+	}
+}
+
+func TestAlert(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	tests := []struct {
+		name      string
+		level     alert.Level
+		wantLevel string
+	}{
+		{"Info", alert.LevelInfo, "info"},
+		{"Success", alert.LevelSuccess, "success"},
+		{"Warning", alert.LevelWarning, "warning"},
+		{"Error", alert.LevelError, "error"},
+		{"Unknown level", alert.Level("critical"), "info"},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := "Alert " + tt.name
+			builder.Alert(tt.level, body)
+
+			widgetID := builder.generatePageID(state.WidgetTypeAlert, []int{i})
+			alertState := sess.State.GetAlert(widgetID)
+			if alertState == nil {
+				t.Fatal("Alert state not found")
+			}
+			if alertState.Level != tt.wantLevel {
+				t.Errorf("Level = %v, want %v", alertState.Level, tt.wantLevel)
+			}
+			if alertState.Body != body {
+				t.Errorf("Body = %v, want %v", alertState.Body, body)
+			}
+		})
+	}
+
+	messages := mockWS.Messages()
+	if len(messages) != len(tests) {
+		t.Errorf("WebSocket messages count = %d, want %d", len(messages), len(tests))
+	}
+}
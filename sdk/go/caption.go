@@ -0,0 +1,69 @@
+package sourcetool
+
+import (
+	"github.com/gofrs/uuid/v5"
+
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+)
+
+func (b *uiBuilder) Caption(text string) {
+	sess := b.session
+	if sess == nil {
+		return
+	}
+	page := b.page
+	if page == nil {
+		return
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return
+	}
+	path := cursor.getPath()
+
+	widgetID := b.generatePageID(state.WidgetTypeCaption, path)
+	captionState := sess.State.GetCaption(widgetID)
+	if captionState == nil {
+		captionState = &state.CaptionState{
+			ID: widgetID,
+		}
+	}
+	captionState.Text = text
+	sess.State.Set(widgetID, captionState)
+
+	captionProto := convertStateToCaptionProto(captionState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_Caption{
+				Caption: captionProto,
+			},
+		},
+	})
+
+	cursor.next()
+}
+
+func convertStateToCaptionProto(state *state.CaptionState) *widgetv1.Caption {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.Caption{
+		Text: state.Text,
+	}
+}
+
+func convertCaptionProtoToState(id uuid.UUID, data *widgetv1.Caption) *state.CaptionState {
+	if data == nil {
+		return nil
+	}
+	return &state.CaptionState{
+		ID:   id,
+		Text: data.Text,
+	}
+}
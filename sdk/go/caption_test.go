@@ -0,0 +1,96 @@
+package sourcetool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+)
+
+func TestConvertStateToCaptionProto(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	captionState := &state.CaptionState{
+		ID:   id,
+		Text: "Updated hourly",
+	}
+
+	data := convertStateToCaptionProto(captionState)
+
+	if data == nil {
+		t.Fatal("convertStateToCaptionProto returned nil")
+	}
+
+	if data.Text != captionState.Text {
+		t.Errorf("Text = %v, want %v", data.Text, captionState.Text)
+	}
+}
+
+func TestConvertCaptionProtoToState(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	data := &widgetv1.Caption{
+		Text: "Updated hourly",
+	}
+
+	state := convertCaptionProtoToState(id, data)
+
+	if state == nil {
+		t.Fatal("convertCaptionProtoToState returned nil")
+	}
+
+	if state.ID != id {
+		t.Errorf("ID = %v, want %v", state.ID, id)
+	}
+	if state.Text != data.Text {
+		t.Errorf("Text = %v, want %v", state.Text, data.Text)
+	}
+}
+
+func TestCaption(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	text := "Updated hourly"
+	builder.Caption(text)
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Fatalf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	renderWidget := messages[0].GetRenderWidget()
+	if renderWidget == nil {
+		t.Fatal("WebSocket message type = nil, want RenderWidget")
+	}
+	if renderWidget.GetWidget().GetCaption() == nil {
+		t.Fatal("Widget type = nil, want Caption")
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeCaption, []int{0})
+	state := sess.State.GetCaption(widgetID)
+	if state == nil {
+		t.Fatal("Caption state not found")
+	}
+
+	if state.Text != text {
+		t.Errorf("Text = %v, want %v", state.Text, text)
+	}
+}
@@ -0,0 +1,117 @@
+package sourcetool
+
+import (
+	"encoding/json"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/chart"
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+)
+
+func (b *uiBuilder) Chart(data any, opts ...chart.Option) {
+	chartOpts := &options.ChartOptions{
+		Type: chart.TypeLine.String(),
+	}
+
+	for _, o := range opts {
+		o.Apply(chartOpts)
+	}
+
+	sess := b.session
+	if sess == nil {
+		return
+	}
+	page := b.page
+	if page == nil {
+		return
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return
+	}
+	path := cursor.getPath()
+
+	widgetID := b.generatePageID(state.WidgetTypeChart, path)
+	chartState := sess.State.GetChart(widgetID)
+	if chartState == nil {
+		chartState = &state.ChartState{
+			ID: widgetID,
+		}
+	}
+	chartState.Data = data
+	chartState.Type = chartOpts.Type
+	chartState.Title = chartOpts.Title
+	chartState.Description = chartOpts.Description
+	chartState.XField = chartOpts.XField
+	chartState.YFields = chartOpts.YFields
+	chartState.Height = chartOpts.Height
+	chartState.Stacked = chartOpts.Stacked
+	sess.State.Set(widgetID, chartState)
+
+	// Data that cannot be encoded is shown as an error on the chart rather
+	// than dropping the widget.
+	chartProto, err := convertStateToChartProto(chartState)
+	if err != nil {
+		chartProto = &widgetv1.Chart{
+			Type:        chartState.Type,
+			Title:       chartState.Title,
+			Description: chartState.Description,
+			Height:      chartState.Height,
+			Error:       err.Error(),
+		}
+	}
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_Chart{
+				Chart: chartProto,
+			},
+		},
+	})
+
+	cursor.next()
+}
+
+func convertStateToChartProto(state *state.ChartState) (*widgetv1.Chart, error) {
+	if state == nil {
+		return nil, nil
+	}
+	dataBytes, err := json.Marshal(state.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &widgetv1.Chart{
+		Data:        dataBytes,
+		Type:        state.Type,
+		Title:       state.Title,
+		Description: state.Description,
+		XField:      state.XField,
+		YFields:     state.YFields,
+		Height:      state.Height,
+		Stacked:     state.Stacked,
+	}, nil
+}
+
+func convertChartProtoToState(id uuid.UUID, data *widgetv1.Chart) *state.ChartState {
+	if data == nil {
+		return nil
+	}
+	return &state.ChartState{
+		ID:          id,
+		Data:        data.Data,
+		Type:        data.Type,
+		Title:       data.Title,
+		Description: data.Description,
+		XField:      data.XField,
+		YFields:     data.YFields,
+		Height:      data.Height,
+		Stacked:     data.Stacked,
+	}
+}
@@ -0,0 +1,80 @@
+package chart
+
+import "github.com/trysourcetool/sourcetool-go/internal/options"
+
+type Option interface {
+	Apply(*options.ChartOptions)
+}
+
+type typeOption Type
+
+func (t typeOption) Apply(opts *options.ChartOptions) {
+	opts.Type = Type(t).String()
+}
+
+func WithType(chartType Type) Option {
+	return typeOption(chartType)
+}
+
+type titleOption string
+
+func (t titleOption) Apply(opts *options.ChartOptions) {
+	opts.Title = string(t)
+}
+
+func WithTitle(title string) Option {
+	return titleOption(title)
+}
+
+type descriptionOption string
+
+func (d descriptionOption) Apply(opts *options.ChartOptions) {
+	opts.Description = string(d)
+}
+
+func WithDescription(description string) Option {
+	return descriptionOption(description)
+}
+
+type xFieldOption string
+
+func (x xFieldOption) Apply(opts *options.ChartOptions) {
+	opts.XField = string(x)
+}
+
+// WithX sets the field used for the X axis, or for the slice labels of a pie chart.
+func WithX(field string) Option {
+	return xFieldOption(field)
+}
+
+type yFieldsOption []string
+
+func (y yFieldsOption) Apply(opts *options.ChartOptions) {
+	opts.YFields = []string(y)
+}
+
+// WithY sets the fields plotted as series. A pie chart uses only the first field.
+func WithY(fields ...string) Option {
+	return yFieldsOption(fields)
+}
+
+type heightOption int32
+
+func (h heightOption) Apply(opts *options.ChartOptions) {
+	opts.Height = (*int32)(&h)
+}
+
+func WithHeight(height int32) Option {
+	return heightOption(height)
+}
+
+type stackedOption bool
+
+func (s stackedOption) Apply(opts *options.ChartOptions) {
+	opts.Stacked = bool(s)
+}
+
+// WithStacked stacks the series of a bar or area chart.
+func WithStacked(stacked bool) Option {
+	return stackedOption(stacked)
+}
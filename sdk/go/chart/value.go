@@ -0,0 +1,15 @@
+package chart
+
+type Type string
+
+const (
+	TypeLine    Type = "line"
+	TypeBar     Type = "bar"
+	TypeArea    Type = "area"
+	TypePie     Type = "pie"
+	TypeScatter Type = "scatter"
+)
+
+func (t Type) String() string {
+	return string(t)
+}
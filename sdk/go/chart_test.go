@@ -0,0 +1,287 @@
+package sourcetool
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/chart"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+)
+
+type testChartData struct {
+	Date    string  `json:"date"`
+	Revenue float64 `json:"revenue"`
+	Cost    float64 `json:"cost"`
+}
+
+func TestConvertStateToChartProto(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	data := []testChartData{
+		{Date: "2025-01-01", Revenue: 100, Cost: 80},
+		{Date: "2025-01-02", Revenue: 120, Cost: 90},
+	}
+	height := int32(300)
+
+	chartState := &state.ChartState{
+		ID:          id,
+		Data:        data,
+		Type:        chart.TypeBar.String(),
+		Title:       "Test Chart",
+		Description: "Test Description",
+		XField:      "date",
+		YFields:     []string{"revenue", "cost"},
+		Height:      &height,
+		Stacked:     true,
+	}
+
+	chartData, err := convertStateToChartProto(chartState)
+	if err != nil {
+		t.Fatalf("convertStateToChartProto returned error: %v", err)
+	}
+
+	if chartData == nil {
+		t.Fatal("convertStateToChartProto returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Type", chartData.Type, chartState.Type},
+		{"Title", chartData.Title, chartState.Title},
+		{"Description", chartData.Description, chartState.Description},
+		{"XField", chartData.XField, chartState.XField},
+		{"YFields", chartData.YFields, chartState.YFields},
+		{"Height", *chartData.Height, *chartState.Height},
+		{"Stacked", chartData.Stacked, chartState.Stacked},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+
+	dataBytes, err := json.Marshal(data)
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+	if !reflect.DeepEqual(chartData.Data, dataBytes) {
+		t.Errorf("Data = %v, want %v", chartData.Data, data)
+	}
+}
+
+func TestConvertChartProtoToState(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	data := []testChartData{
+		{Date: "2025-01-01", Revenue: 100, Cost: 80},
+	}
+	height := int32(300)
+
+	dataBytes, err := json.Marshal(data)
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+
+	chartData := &widgetv1.Chart{
+		Data:        dataBytes,
+		Type:        chart.TypePie.String(),
+		Title:       "Test Chart",
+		Description: "Test Description",
+		XField:      "date",
+		YFields:     []string{"revenue"},
+		Height:      &height,
+		Stacked:     false,
+	}
+
+	state := convertChartProtoToState(id, chartData)
+
+	if state == nil {
+		t.Fatal("convertChartProtoToState returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"ID", state.ID, id},
+		{"Data", state.Data, dataBytes},
+		{"Type", state.Type, chartData.Type},
+		{"Title", state.Title, chartData.Title},
+		{"Description", state.Description, chartData.Description},
+		{"XField", state.XField, chartData.XField},
+		{"YFields", state.YFields, chartData.YFields},
+		{"Height", *state.Height, *chartData.Height},
+		{"Stacked", state.Stacked, chartData.Stacked},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChart(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	data := []testChartData{
+		{Date: "2025-01-01", Revenue: 100, Cost: 80},
+		{Date: "2025-01-02", Revenue: 120, Cost: 90},
+	}
+	height := int32(400)
+
+	builder.Chart(data,
+		chart.WithType(chart.TypeArea),
+		chart.WithTitle("Revenue"),
+		chart.WithDescription("Daily revenue and cost"),
+		chart.WithX("date"),
+		chart.WithY("revenue", "cost"),
+		chart.WithHeight(height),
+		chart.WithStacked(true),
+	)
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Errorf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	msg := messages[0]
+	if v := msg.GetRenderWidget(); v == nil {
+		t.Fatal("WebSocket message type = nil, want RenderWidget")
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeChart, []int{0})
+	state := sess.State.GetChart(widgetID)
+	if state == nil {
+		t.Fatal("Chart state not found")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Data", state.Data, data},
+		{"Type", state.Type, chart.TypeArea.String()},
+		{"Title", state.Title, "Revenue"},
+		{"Description", state.Description, "Daily revenue and cost"},
+		{"XField", state.XField, "date"},
+		{"YFields", state.YFields, []string{"revenue", "cost"}},
+		{"Height", *state.Height, height},
+		{"Stacked", state.Stacked, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChart_DefaultValues(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mock.NewClient(),
+		},
+	}
+
+	builder.Chart([]testChartData{})
+
+	widgetID := builder.generatePageID(state.WidgetTypeChart, []int{0})
+	state := sess.State.GetChart(widgetID)
+	if state == nil {
+		t.Fatal("Chart state not found")
+	}
+
+	if state.Type != chart.TypeLine.String() {
+		t.Errorf("Default Type = %v, want %v", state.Type, chart.TypeLine)
+	}
+	if state.Height != nil {
+		t.Errorf("Default Height = %v, want nil", *state.Height)
+	}
+	if state.Stacked {
+		t.Error("Default Stacked = true, want false")
+	}
+}
+
+func TestChart_MarshalError(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	builder.Chart([]testChartData{{Date: "2025-01", Revenue: math.Inf(1)}}, chart.WithTitle("Revenue"))
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Fatalf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	chartProto := messages[0].GetRenderWidget().GetWidget().GetChart()
+	if chartProto == nil {
+		t.Fatal("Widget type = nil, want Chart")
+	}
+	if chartProto.Error == "" {
+		t.Error("Error is empty, want the encoding error")
+	}
+	if chartProto.Title != "Revenue" {
+		t.Errorf("Title = %q, want %q", chartProto.Title, "Revenue")
+	}
+	if builder.cursor.index != 1 {
+		t.Errorf("cursor index = %d, want 1", builder.cursor.index)
+	}
+}
@@ -0,0 +1,120 @@
+package sourcetool
+
+import (
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/codeeditor"
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/ptrconv"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+)
+
+func (b *uiBuilder) CodeEditor(label string, opts ...codeeditor.Option) string {
+	codeEditorOpts := &options.CodeEditorOptions{
+		Label:        label,
+		Placeholder:  "",
+		DefaultValue: nil,
+		Required:     false,
+		Disabled:     false,
+		Language:     codeeditor.LanguagePlainText.String(),
+		LineNumbers:  true,
+		ReadOnly:     false,
+		MaxHeight:    nil,
+	}
+
+	for _, o := range opts {
+		o.Apply(codeEditorOpts)
+	}
+
+	sess := b.session
+	if sess == nil {
+		return ""
+	}
+	page := b.page
+	if page == nil {
+		return ""
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return ""
+	}
+	path := cursor.getPath()
+
+	widgetID := b.generatePageID(state.WidgetTypeCodeEditor, path)
+	codeEditorState := sess.State.GetCodeEditor(widgetID)
+	if codeEditorState == nil {
+		codeEditorState = &state.CodeEditorState{
+			ID:    widgetID,
+			Value: codeEditorOpts.DefaultValue,
+		}
+	}
+	if codeEditorOpts.ReadOnly {
+		codeEditorState.Value = codeEditorOpts.DefaultValue
+	}
+	codeEditorState.Label = codeEditorOpts.Label
+	codeEditorState.Placeholder = codeEditorOpts.Placeholder
+	codeEditorState.DefaultValue = codeEditorOpts.DefaultValue
+	codeEditorState.Required = codeEditorOpts.Required
+	codeEditorState.Disabled = codeEditorOpts.Disabled
+	codeEditorState.Language = codeEditorOpts.Language
+	codeEditorState.LineNumbers = codeEditorOpts.LineNumbers
+	codeEditorState.ReadOnly = codeEditorOpts.ReadOnly
+	codeEditorState.MaxHeight = codeEditorOpts.MaxHeight
+	sess.State.Set(widgetID, codeEditorState)
+
+	codeEditorProto := convertStateToCodeEditorProto(codeEditorState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_CodeEditor{
+				CodeEditor: codeEditorProto,
+			},
+		},
+	})
+
+	cursor.next()
+
+	return ptrconv.StringValue(codeEditorState.Value)
+}
+
+func convertStateToCodeEditorProto(state *state.CodeEditorState) *widgetv1.CodeEditor {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.CodeEditor{
+		Value:        state.Value,
+		Label:        state.Label,
+		Placeholder:  state.Placeholder,
+		DefaultValue: state.DefaultValue,
+		Required:     state.Required,
+		Disabled:     state.Disabled,
+		Language:     state.Language,
+		LineNumbers:  state.LineNumbers,
+		ReadOnly:     state.ReadOnly,
+		MaxHeight:    state.MaxHeight,
+	}
+}
+
+func convertCodeEditorProtoToState(id uuid.UUID, data *widgetv1.CodeEditor) *state.CodeEditorState {
+	if data == nil {
+		return nil
+	}
+	return &state.CodeEditorState{
+		ID:           id,
+		Value:        data.Value,
+		Label:        data.Label,
+		Placeholder:  data.Placeholder,
+		DefaultValue: data.DefaultValue,
+		Required:     data.Required,
+		Disabled:     data.Disabled,
+		Language:     data.Language,
+		LineNumbers:  data.LineNumbers,
+		ReadOnly:     data.ReadOnly,
+		MaxHeight:    data.MaxHeight,
+	}
+}
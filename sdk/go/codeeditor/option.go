@@ -0,0 +1,91 @@
+package codeeditor
+
+import "github.com/trysourcetool/sourcetool-go/internal/options"
+
+type Option interface {
+	Apply(*options.CodeEditorOptions)
+}
+
+type placeholderOption string
+
+func (p placeholderOption) Apply(opts *options.CodeEditorOptions) {
+	opts.Placeholder = string(p)
+}
+
+func WithPlaceholder(placeholder string) Option {
+	return placeholderOption(placeholder)
+}
+
+type defaultValueOption string
+
+func (d defaultValueOption) Apply(opts *options.CodeEditorOptions) {
+	opts.DefaultValue = (*string)(&d)
+}
+
+func WithDefaultValue(value string) Option {
+	return defaultValueOption(value)
+}
+
+type requiredOption bool
+
+func (r requiredOption) Apply(opts *options.CodeEditorOptions) {
+	opts.Required = bool(r)
+}
+
+func WithRequired(required bool) Option {
+	return requiredOption(required)
+}
+
+type disabledOption bool
+
+func (d disabledOption) Apply(opts *options.CodeEditorOptions) {
+	opts.Disabled = bool(d)
+}
+
+func WithDisabled(disabled bool) Option {
+	return disabledOption(disabled)
+}
+
+type languageOption Language
+
+func (l languageOption) Apply(opts *options.CodeEditorOptions) {
+	opts.Language = Language(l).String()
+}
+
+func WithLanguage(language Language) Option {
+	return languageOption(language)
+}
+
+type lineNumbersOption bool
+
+func (l lineNumbersOption) Apply(opts *options.CodeEditorOptions) {
+	opts.LineNumbers = bool(l)
+}
+
+func WithLineNumbers(lineNumbers bool) Option {
+	return lineNumbersOption(lineNumbers)
+}
+
+type readOnlyOption bool
+
+func (r readOnlyOption) Apply(opts *options.CodeEditorOptions) {
+	opts.ReadOnly = bool(r)
+}
+
+// WithReadOnly shows the code without letting the user edit it. Unlike
+// WithDisabled, the editor keeps its normal look and text can be selected
+// and copied. A read-only editor always shows the default value.
+func WithReadOnly(readOnly bool) Option {
+	return readOnlyOption(readOnly)
+}
+
+type maxHeightOption int32
+
+func (m maxHeightOption) Apply(opts *options.CodeEditorOptions) {
+	opts.MaxHeight = (*int32)(&m)
+}
+
+// WithMaxHeight sets the height in pixels after which the editor scrolls.
+func WithMaxHeight(height int32) Option {
+	return maxHeightOption(height)
+}
@@ -0,0 +1,15 @@
+package codeeditor
+
+type Language string
+
+const (
+	LanguagePlainText Language = "plaintext"
+	LanguageSQL       Language = "sql"
+	LanguageJSON      Language = "json"
+	LanguageYAML      Language = "yaml"
+	LanguageGo        Language = "go"
+)
+
+func (l Language) String() string {
+	return string(l)
+}
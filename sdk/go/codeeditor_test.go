@@ -0,0 +1,262 @@
+package sourcetool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/codeeditor"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/ptrconv"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+)
+
+func TestConvertStateToCodeEditorProto(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	maxHeight := int32(400)
+
+	codeEditorState := &state.CodeEditorState{
+		ID:           id,
+		Label:        "Test CodeEditor",
+		Value:        ptrconv.StringPtr("SELECT 1"),
+		Placeholder:  "Enter query",
+		DefaultValue: ptrconv.StringPtr("SELECT * FROM users"),
+		Required:     true,
+		Disabled:     false,
+		Language:     codeeditor.LanguageSQL.String(),
+		LineNumbers:  true,
+		ReadOnly:     false,
+		MaxHeight:    &maxHeight,
+	}
+
+	data := convertStateToCodeEditorProto(codeEditorState)
+
+	if data == nil {
+		t.Fatal("convertStateToCodeEditorProto returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", data.Label, codeEditorState.Label},
+		{"Value", ptrconv.StringValue(data.Value), ptrconv.StringValue(codeEditorState.Value)},
+		{"Placeholder", data.Placeholder, codeEditorState.Placeholder},
+		{"DefaultValue", ptrconv.StringValue(data.DefaultValue), ptrconv.StringValue(codeEditorState.DefaultValue)},
+		{"Required", data.Required, codeEditorState.Required},
+		{"Disabled", data.Disabled, codeEditorState.Disabled},
+		{"Language", data.Language, codeEditorState.Language},
+		{"LineNumbers", data.LineNumbers, codeEditorState.LineNumbers},
+		{"ReadOnly", data.ReadOnly, codeEditorState.ReadOnly},
+		{"MaxHeight", *data.MaxHeight, *codeEditorState.MaxHeight},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertCodeEditorProtoToState(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	maxHeight := int32(400)
+
+	data := &widgetv1.CodeEditor{
+		Label:        "Test CodeEditor",
+		Value:        ptrconv.StringPtr("SELECT 1"),
+		Placeholder:  "Enter query",
+		DefaultValue: ptrconv.StringPtr("SELECT * FROM users"),
+		Required:     true,
+		Disabled:     false,
+		Language:     codeeditor.LanguageSQL.String(),
+		LineNumbers:  true,
+		ReadOnly:     false,
+		MaxHeight:    &maxHeight,
+	}
+
+	state := convertCodeEditorProtoToState(id, data)
+
+	if state == nil {
+		t.Fatal("convertCodeEditorProtoToState returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"ID", state.ID, id},
+		{"Label", state.Label, data.Label},
+		{"Value", ptrconv.StringValue(state.Value), ptrconv.StringValue(data.Value)},
+		{"Placeholder", state.Placeholder, data.Placeholder},
+		{"DefaultValue", ptrconv.StringValue(state.DefaultValue), ptrconv.StringValue(data.DefaultValue)},
+		{"Required", state.Required, data.Required},
+		{"Disabled", state.Disabled, data.Disabled},
+		{"Language", state.Language, data.Language},
+		{"LineNumbers", state.LineNumbers, data.LineNumbers},
+		{"ReadOnly", state.ReadOnly, data.ReadOnly},
+		{"MaxHeight", *state.MaxHeight, *data.MaxHeight},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCodeEditor(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	label := "Test CodeEditor"
+	defaultValue := "SELECT * FROM users"
+	placeholder := "Enter query"
+	maxHeight := int32(400)
+
+	value := builder.CodeEditor(label,
+		codeeditor.WithDefaultValue(defaultValue),
+		codeeditor.WithPlaceholder(placeholder),
+		codeeditor.WithRequired(true),
+		codeeditor.WithDisabled(true),
+		codeeditor.WithLanguage(codeeditor.LanguageSQL),
+		codeeditor.WithLineNumbers(false),
+		codeeditor.WithMaxHeight(maxHeight),
+	)
+
+	if value != defaultValue {
+		t.Errorf("CodeEditor value = %v, want %v", value, defaultValue)
+	}
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Errorf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	msg := messages[0]
+	if v := msg.GetRenderWidget(); v == nil {
+		t.Fatal("WebSocket message type = nil, want RenderWidget")
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeCodeEditor, []int{0})
+	state := sess.State.GetCodeEditor(widgetID)
+	if state == nil {
+		t.Fatal("CodeEditor state not found")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", state.Label, label},
+		{"Value", ptrconv.StringValue(state.Value), defaultValue},
+		{"Placeholder", state.Placeholder, placeholder},
+		{"DefaultValue", ptrconv.StringValue(state.DefaultValue), defaultValue},
+		{"Required", state.Required, true},
+		{"Disabled", state.Disabled, true},
+		{"Language", state.Language, "sql"},
+		{"LineNumbers", state.LineNumbers, false},
+		{"ReadOnly", state.ReadOnly, false},
+		{"MaxHeight", *state.MaxHeight, maxHeight},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCodeEditor_ReadOnly(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mock.NewClient(),
+		},
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeCodeEditor, []int{0})
+	sess.State.Set(widgetID, &state.CodeEditorState{
+		ID:    widgetID,
+		Value: ptrconv.StringPtr("edited"),
+	})
+
+	value := builder.CodeEditor("Generated SQL",
+		codeeditor.WithDefaultValue("SELECT 2"),
+		codeeditor.WithReadOnly(true),
+	)
+
+	if value != "SELECT 2" {
+		t.Errorf("CodeEditor value = %q, want %q", value, "SELECT 2")
+	}
+}
+
+func TestCodeEditor_Defaults(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mock.NewClient(),
+		},
+	}
+
+	builder.CodeEditor("Config")
+
+	widgetID := builder.generatePageID(state.WidgetTypeCodeEditor, []int{0})
+	state := sess.State.GetCodeEditor(widgetID)
+	if state == nil {
+		t.Fatal("CodeEditor state not found")
+	}
+	if state.Language != codeeditor.LanguagePlainText.String() {
+		t.Errorf("Language = %q, want %q", state.Language, codeeditor.LanguagePlainText)
+	}
+	if !state.LineNumbers {
+		t.Error("LineNumbers = false, want true")
+	}
+	if state.MaxHeight != nil {
+		t.Errorf("MaxHeight = %v, want nil", *state.MaxHeight)
+	}
+}
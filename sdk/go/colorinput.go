@@ -0,0 +1,138 @@
+package sourcetool
+
+import (
+	"strings"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/colorinput"
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/ptrconv"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+)
+
+func (b *uiBuilder) ColorInput(label string, opts ...colorinput.Option) string {
+	colorInputOpts := &options.ColorInputOptions{
+		Label:        label,
+		DefaultValue: nil,
+		Required:     false,
+		Disabled:     false,
+		Swatches:     nil,
+	}
+
+	for _, o := range opts {
+		o.Apply(colorInputOpts)
+	}
+
+	sess := b.session
+	if sess == nil {
+		return ""
+	}
+	page := b.page
+	if page == nil {
+		return ""
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return ""
+	}
+	path := cursor.getPath()
+
+	defaultValue := ptrconv.StringPtr(normalizeHexColor(ptrconv.StringValue(colorInputOpts.DefaultValue)))
+	swatches := make([]string, 0, len(colorInputOpts.Swatches))
+	for _, s := range colorInputOpts.Swatches {
+		if c := normalizeHexColor(s); c != "" {
+			swatches = append(swatches, c)
+		}
+	}
+
+	widgetID := b.generatePageID(state.WidgetTypeColorInput, path)
+	colorInputState := sess.State.GetColorInput(widgetID)
+	if colorInputState == nil {
+		colorInputState = &state.ColorInputState{
+			ID:    widgetID,
+			Value: defaultValue,
+		}
+	}
+	if colorInputState.Value != nil {
+		value := normalizeHexColor(*colorInputState.Value)
+		if value == "" {
+			colorInputState.Value = defaultValue
+		} else {
+			colorInputState.Value = &value
+		}
+	}
+	colorInputState.Label = colorInputOpts.Label
+	colorInputState.DefaultValue = defaultValue
+	colorInputState.Required = colorInputOpts.Required
+	colorInputState.Disabled = colorInputOpts.Disabled
+	colorInputState.Swatches = swatches
+	sess.State.Set(widgetID, colorInputState)
+
+	colorInputProto := convertStateToColorInputProto(colorInputState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_ColorInput{
+				ColorInput: colorInputProto,
+			},
+		},
+	})
+
+	cursor.next()
+
+	return ptrconv.StringValue(colorInputState.Value)
+}
+
+// normalizeHexColor returns color as an upper-case "#RRGGBB" code. It
+// accepts the "#RGB" shorthand and a missing "#". Anything else yields "".
+func normalizeHexColor(color string) string {
+	color = strings.TrimPrefix(strings.TrimSpace(color), "#")
+	for _, c := range color {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+			return ""
+		}
+	}
+	switch len(color) {
+	case 3:
+		color = string([]byte{color[0], color[0], color[1], color[1], color[2], color[2]})
+	case 6:
+	default:
+		return ""
+	}
+	return "#" + strings.ToUpper(color)
+}
+
+func convertStateToColorInputProto(state *state.ColorInputState) *widgetv1.ColorInput {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.ColorInput{
+		Value:        state.Value,
+		Label:        state.Label,
+		DefaultValue: state.DefaultValue,
+		Required:     state.Required,
+		Disabled:     state.Disabled,
+		Swatches:     state.Swatches,
+	}
+}
+
+func convertColorInputProtoToState(id uuid.UUID, data *widgetv1.ColorInput) *state.ColorInputState {
+	if data == nil {
+		return nil
+	}
+	return &state.ColorInputState{
+		ID:           id,
+		Value:        data.Value,
+		Label:        data.Label,
+		DefaultValue: data.DefaultValue,
+		Required:     data.Required,
+		Disabled:     data.Disabled,
+		Swatches:     data.Swatches,
+	}
+}
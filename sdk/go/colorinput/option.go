@@ -0,0 +1,49 @@
+package colorinput
+
+import "github.com/trysourcetool/sourcetool-go/internal/options"
+
+type Option interface {
+	Apply(*options.ColorInputOptions)
+}
+
+type defaultValueOption string
+
+func (d defaultValueOption) Apply(opts *options.ColorInputOptions) {
+	opts.DefaultValue = (*string)(&d)
+}
+
+func WithDefaultValue(value string) Option {
+	return defaultValueOption(value)
+}
+
+type requiredOption bool
+
+func (r requiredOption) Apply(opts *options.ColorInputOptions) {
+	opts.Required = bool(r)
+}
+
+func WithRequired(required bool) Option {
+	return requiredOption(required)
+}
+
+type disabledOption bool
+
+func (d disabledOption) Apply(opts *options.ColorInputOptions) {
+	opts.Disabled = bool(d)
+}
+
+func WithDisabled(disabled bool) Option {
+	return disabledOption(disabled)
+}
+
+type swatchesOption []string
+
+func (s swatchesOption) Apply(opts *options.ColorInputOptions) {
+	opts.Swatches = []string(s)
+}
+
+// WithSwatches shows preset colours next to the picker. Colours that are
+// not valid hex codes are ignored.
+func WithSwatches(swatches ...string) Option {
+	return swatchesOption(swatches)
+}
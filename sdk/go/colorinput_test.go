@@ -0,0 +1,226 @@
+package sourcetool
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/colorinput"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+)
+
+func TestConvertStateToColorInputProto(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	value := "#9333EA"
+	defaultValue := "#2563EB"
+
+	colorInputState := &state.ColorInputState{
+		ID:           id,
+		Label:        "Test ColorInput",
+		Value:        &value,
+		DefaultValue: &defaultValue,
+		Required:     true,
+		Disabled:     false,
+		Swatches:     []string{"#9333EA", "#2563EB"},
+	}
+
+	data := convertStateToColorInputProto(colorInputState)
+
+	if data == nil {
+		t.Fatal("convertStateToColorInputProto returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", data.Label, colorInputState.Label},
+		{"Value", *data.Value, *colorInputState.Value},
+		{"DefaultValue", *data.DefaultValue, *colorInputState.DefaultValue},
+		{"Required", data.Required, colorInputState.Required},
+		{"Disabled", data.Disabled, colorInputState.Disabled},
+		{"Swatches", data.Swatches, colorInputState.Swatches},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertColorInputProtoToState(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	value := "#9333EA"
+	defaultValue := "#2563EB"
+
+	data := &widgetv1.ColorInput{
+		Label:        "Test ColorInput",
+		Value:        &value,
+		DefaultValue: &defaultValue,
+		Required:     true,
+		Disabled:     false,
+		Swatches:     []string{"#9333EA"},
+	}
+
+	state := convertColorInputProtoToState(id, data)
+
+	if state == nil {
+		t.Fatal("convertColorInputProtoToState returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"ID", state.ID, id},
+		{"Label", state.Label, data.Label},
+		{"Value", *state.Value, *data.Value},
+		{"DefaultValue", *state.DefaultValue, *data.DefaultValue},
+		{"Required", state.Required, data.Required},
+		{"Disabled", state.Disabled, data.Disabled},
+		{"Swatches", state.Swatches, data.Swatches},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestColorInput(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	label := "Test ColorInput"
+	value := builder.ColorInput(label,
+		colorinput.WithDefaultValue("9333ea"),
+		colorinput.WithSwatches("#fff", "not-a-color", "#2563EB"),
+		colorinput.WithRequired(true),
+		colorinput.WithDisabled(true),
+	)
+
+	if want := "#9333EA"; value != want {
+		t.Errorf("ColorInput value = %v, want %v", value, want)
+	}
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Errorf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	msg := messages[0]
+	if v := msg.GetRenderWidget(); v == nil {
+		t.Fatal("WebSocket message type = nil, want RenderWidget")
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeColorInput, []int{0})
+	state := sess.State.GetColorInput(widgetID)
+	if state == nil {
+		t.Fatal("ColorInput state not found")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", state.Label, label},
+		{"Value", *state.Value, "#9333EA"},
+		{"DefaultValue", *state.DefaultValue, "#9333EA"},
+		{"Required", state.Required, true},
+		{"Disabled", state.Disabled, true},
+		{"Swatches", state.Swatches, []string{"#FFFFFF", "#2563EB"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestColorInput_InvalidValue(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mock.NewClient(),
+		},
+	}
+
+	// An invalid value sent by the client falls back to the default
+	widgetID := builder.generatePageID(state.WidgetTypeColorInput, []int{0})
+	invalid := "purple"
+	sess.State.Set(widgetID, &state.ColorInputState{
+		ID:    widgetID,
+		Value: &invalid,
+	})
+
+	value := builder.ColorInput("Brand colour", colorinput.WithDefaultValue("#000000"))
+
+	if want := "#000000"; value != want {
+		t.Errorf("ColorInput value = %v, want %v", value, want)
+	}
+}
+
+func TestNormalizeHexColor(t *testing.T) {
+	tests := []struct {
+		name  string
+		color string
+		want  string
+	}{
+		{"Empty", "", ""},
+		{"Long form", "#9333ea", "#9333EA"},
+		{"Short form", "#abc", "#AABBCC"},
+		{"Without hash", "2563EB", "#2563EB"},
+		{"Surrounding spaces", " #000000 ", "#000000"},
+		{"Invalid characters", "#GGGGGG", ""},
+		{"Invalid length", "#12345", ""},
+		{"Colour name", "red", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizeHexColor(tt.color); got != tt.want {
+				t.Errorf("normalizeHexColor(%q) = %q, want %q", tt.color, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,251 @@
+package sourcetool
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/daterangeinput"
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/ptrconv"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+)
+
+func (b *uiBuilder) DateRangeInput(label string, opts ...daterangeinput.Option) (*time.Time, *time.Time) {
+	dateRangeInputOpts := &options.DateRangeInputOptions{
+		Label:        label,
+		DefaultStart: nil,
+		DefaultEnd:   nil,
+		Required:     false,
+		Disabled:     false,
+		Format:       "YYYY/MM/DD",
+		MaxValue:     nil,
+		MinValue:     nil,
+		Location:     time.Local,
+	}
+
+	for _, o := range opts {
+		o.Apply(dateRangeInputOpts)
+	}
+
+	sess := b.session
+	if sess == nil {
+		return nil, nil
+	}
+	page := b.page
+	if page == nil {
+		return nil, nil
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return nil, nil
+	}
+	path := cursor.getPath()
+
+	widgetID := b.generatePageID(state.WidgetTypeDateRangeInput, path)
+	dateRangeInputState := sess.State.GetDateRangeInput(widgetID)
+	if dateRangeInputState == nil {
+		dateRangeInputState = &state.DateRangeInputState{
+			ID:         widgetID,
+			StartValue: dateRangeInputOpts.DefaultStart,
+			EndValue:   dateRangeInputOpts.DefaultEnd,
+		}
+	}
+	dateRangeInputState.StartValue, dateRangeInputState.EndValue = orderDateRange(dateRangeInputState.StartValue, dateRangeInputState.EndValue)
+	dateRangeInputState.Label = dateRangeInputOpts.Label
+	dateRangeInputState.DefaultStart = dateRangeInputOpts.DefaultStart
+	dateRangeInputState.DefaultEnd = dateRangeInputOpts.DefaultEnd
+	dateRangeInputState.Required = dateRangeInputOpts.Required
+	dateRangeInputState.Disabled = dateRangeInputOpts.Disabled
+	dateRangeInputState.Format = dateRangeInputOpts.Format
+	dateRangeInputState.MaxValue = dateRangeInputOpts.MaxValue
+	dateRangeInputState.MinValue = dateRangeInputOpts.MinValue
+	dateRangeInputState.Location = dateRangeInputOpts.Location
+	dateRangeInputState.Presets = resolveDateRangePresets(dateRangeInputOpts.Presets, time.Now(), dateRangeInputOpts.Location)
+	sess.State.Set(widgetID, dateRangeInputState)
+
+	dateRangeInput := convertStateToDateRangeInputProto(dateRangeInputState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_DateRangeInput{
+				DateRangeInput: dateRangeInput,
+			},
+		},
+	})
+
+	cursor.next()
+
+	return dateRangeInputState.StartValue, dateRangeInputState.EndValue
+}
+
+// orderDateRange swaps start and end when start is after end, so pages
+// never see an inverted range.
+func orderDateRange(start, end *time.Time) (*time.Time, *time.Time) {
+	if start != nil && end != nil && start.After(*end) {
+		return end, start
+	}
+	return start, end
+}
+
+// resolveDateRangePresets evaluates each preset against today's date in
+// location.
+func resolveDateRangePresets(presets []options.DateRangeInputPreset, now time.Time, location *time.Location) []state.DateRangeInputStatePreset {
+	if len(presets) == 0 {
+		return nil
+	}
+	now = now.In(location)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, location)
+
+	resolved := make([]state.DateRangeInputStatePreset, 0, len(presets))
+	for _, p := range presets {
+		if p.Range == nil {
+			continue
+		}
+		start, end := p.Range(today)
+		if start.After(end) {
+			start, end = end, start
+		}
+		resolved = append(resolved, state.DateRangeInputStatePreset{
+			Label: p.Label,
+			Start: start,
+			End:   end,
+		})
+	}
+	return resolved
+}
+
+func convertDateRangeInputProtoToState(id uuid.UUID, data *widgetv1.DateRangeInput, location *time.Location) (*state.DateRangeInputState, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	parseDate := func(dateStr string) (*time.Time, error) {
+		if dateStr == "" {
+			return nil, nil
+		}
+		t, err := time.ParseInLocation(time.DateOnly, dateStr, location)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse date %q: %v", dateStr, err)
+		}
+		return &t, nil
+	}
+
+	startValue, err := parseDate(ptrconv.StringValue(data.StartValue))
+	if err != nil {
+		return nil, err
+	}
+
+	endValue, err := parseDate(ptrconv.StringValue(data.EndValue))
+	if err != nil {
+		return nil, err
+	}
+
+	defaultStart, err := parseDate(ptrconv.StringValue(data.DefaultStartValue))
+	if err != nil {
+		return nil, err
+	}
+
+	defaultEnd, err := parseDate(ptrconv.StringValue(data.DefaultEndValue))
+	if err != nil {
+		return nil, err
+	}
+
+	maxValue, err := parseDate(data.MaxValue)
+	if err != nil {
+		return nil, err
+	}
+
+	minValue, err := parseDate(data.MinValue)
+	if err != nil {
+		return nil, err
+	}
+
+	presets := make([]state.DateRangeInputStatePreset, 0, len(data.Presets))
+	for _, p := range data.Presets {
+		start, err := parseDate(p.StartValue)
+		if err != nil {
+			return nil, err
+		}
+		end, err := parseDate(p.EndValue)
+		if err != nil {
+			return nil, err
+		}
+		if start == nil || end == nil {
+			continue
+		}
+		presets = append(presets, state.DateRangeInputStatePreset{
+			Label: p.Label,
+			Start: *start,
+			End:   *end,
+		})
+	}
+
+	return &state.DateRangeInputState{
+		ID:           id,
+		StartValue:   startValue,
+		EndValue:     endValue,
+		Label:        data.Label,
+		DefaultStart: defaultStart,
+		DefaultEnd:   defaultEnd,
+		Required:     data.Required,
+		Disabled:     data.Disabled,
+		Format:       data.Format,
+		MaxValue:     maxValue,
+		MinValue:     minValue,
+		Location:     location,
+		Presets:      presets,
+	}, nil
+}
+
+func convertStateToDateRangeInputProto(state *state.DateRangeInputState) *widgetv1.DateRangeInput {
+	if state == nil {
+		return nil
+	}
+	var startValue, endValue, defaultStart, defaultEnd, maxValue, minValue string
+	if state.StartValue != nil {
+		startValue = state.StartValue.Format(time.DateOnly)
+	}
+	if state.EndValue != nil {
+		endValue = state.EndValue.Format(time.DateOnly)
+	}
+	if state.DefaultStart != nil {
+		defaultStart = state.DefaultStart.Format(time.DateOnly)
+	}
+	if state.DefaultEnd != nil {
+		defaultEnd = state.DefaultEnd.Format(time.DateOnly)
+	}
+	if state.MaxValue != nil {
+		maxValue = state.MaxValue.Format(time.DateOnly)
+	}
+	if state.MinValue != nil {
+		minValue = state.MinValue.Format(time.DateOnly)
+	}
+	presets := make([]*widgetv1.DateRangeInputPreset, 0, len(state.Presets))
+	for _, p := range state.Presets {
+		presets = append(presets, &widgetv1.DateRangeInputPreset{
+			Label:      p.Label,
+			StartValue: p.Start.Format(time.DateOnly),
+			EndValue:   p.End.Format(time.DateOnly),
+		})
+	}
+	return &widgetv1.DateRangeInput{
+		StartValue:        ptrconv.StringPtr(startValue),
+		EndValue:          ptrconv.StringPtr(endValue),
+		Label:             state.Label,
+		DefaultStartValue: ptrconv.StringPtr(defaultStart),
+		DefaultEndValue:   ptrconv.StringPtr(defaultEnd),
+		Required:          state.Required,
+		Disabled:          state.Disabled,
+		Format:            state.Format,
+		MaxValue:          maxValue,
+		MinValue:          minValue,
+		Presets:           presets,
+	}
+}
@@ -0,0 +1,98 @@
+package daterangeinput
+
+import (
+	"time"
+
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+)
+
+type Option interface {
+	Apply(*options.DateRangeInputOptions)
+}
+
+type defaultValueOption [2]time.Time
+
+func (d defaultValueOption) Apply(opts *options.DateRangeInputOptions) {
+	opts.DefaultStart = &d[0]
+	opts.DefaultEnd = &d[1]
+}
+
+func WithDefaultValue(start, end time.Time) Option {
+	return defaultValueOption{start, end}
+}
+
+type requiredOption bool
+
+func (r requiredOption) Apply(opts *options.DateRangeInputOptions) {
+	opts.Required = bool(r)
+}
+
+func WithRequired(required bool) Option {
+	return requiredOption(required)
+}
+
+type disabledOption bool
+
+func (d disabledOption) Apply(opts *options.DateRangeInputOptions) {
+	opts.Disabled = bool(d)
+}
+
+func WithDisabled(disabled bool) Option {
+	return disabledOption(disabled)
+}
+
+type formatOption string
+
+func (f formatOption) Apply(opts *options.DateRangeInputOptions) {
+	opts.Format = string(f)
+}
+
+func WithFormat(format string) Option {
+	return formatOption(format)
+}
+
+type maxValueOption time.Time
+
+func (m maxValueOption) Apply(opts *options.DateRangeInputOptions) {
+	opts.MaxValue = (*time.Time)(&m)
+}
+
+func WithMaxValue(value time.Time) Option {
+	return maxValueOption(value)
+}
+
+type minValueOption time.Time
+
+func (m minValueOption) Apply(opts *options.DateRangeInputOptions) {
+	opts.MinValue = (*time.Time)(&m)
+}
+
+func WithMinValue(value time.Time) Option {
+	return minValueOption(value)
+}
+
+type locationOption time.Location
+
+func (l locationOption) Apply(opts *options.DateRangeInputOptions) {
+	opts.Location = (*time.Location)(&l)
+}
+
+func WithLocation(location time.Location) Option {
+	return locationOption(location)
+}
+
+type presetsOption []Preset
+
+func (p presetsOption) Apply(opts *options.DateRangeInputOptions) {
+	for _, preset := range p {
+		opts.Presets = append(opts.Presets, options.DateRangeInputPreset{
+			Label: preset.Label,
+			Range: preset.Range,
+		})
+	}
+}
+
+// WithPresets adds one-click ranges next to the picker, in the given order.
+func WithPresets(presets ...Preset) Option {
+	return presetsOption(presets)
+}
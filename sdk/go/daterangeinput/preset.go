@@ -0,0 +1,65 @@
+package daterangeinput
+
+import (
+	"fmt"
+	"time"
+)
+
+// Preset is a named range the user can pick with one click. Range receives
+// today's date at midnight in the widget's location and returns the start
+// and end dates of the range, both inclusive.
+type Preset struct {
+	Label string
+	Range func(today time.Time) (start, end time.Time)
+}
+
+// Today is the range containing only the current date.
+func Today() Preset {
+	return Preset{
+		Label: "Today",
+		Range: func(today time.Time) (time.Time, time.Time) {
+			return today, today
+		},
+	}
+}
+
+// LastDays is the range of the last n days, including today.
+func LastDays(n int) Preset {
+	return Preset{
+		Label: fmt.Sprintf("Last %d days", n),
+		Range: func(today time.Time) (time.Time, time.Time) {
+			return today.AddDate(0, 0, -(n - 1)), today
+		},
+	}
+}
+
+// ThisMonth is the range from the first day of the current month to today.
+func ThisMonth() Preset {
+	return Preset{
+		Label: "This month",
+		Range: func(today time.Time) (time.Time, time.Time) {
+			return today.AddDate(0, 0, 1-today.Day()), today
+		},
+	}
+}
+
+// LastMonth is the whole previous calendar month.
+func LastMonth() Preset {
+	return Preset{
+		Label: "Last month",
+		Range: func(today time.Time) (time.Time, time.Time) {
+			firstOfMonth := today.AddDate(0, 0, 1-today.Day())
+			return firstOfMonth.AddDate(0, -1, 0), firstOfMonth.AddDate(0, 0, -1)
+		},
+	}
+}
+
+// ThisYear is the range from January 1st of the current year to today.
+func ThisYear() Preset {
+	return Preset{
+		Label: "This year",
+		Range: func(today time.Time) (time.Time, time.Time) {
+			return time.Date(today.Year(), time.January, 1, 0, 0, 0, 0, today.Location()), today
+		},
+	}
+}
@@ -0,0 +1,307 @@
+package sourcetool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/daterangeinput"
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/ptrconv"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+)
+
+func TestConvertStateToDateRangeInputProto(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	start := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC)
+	minDate := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	maxDate := time.Date(2025, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+	dateRangeInputState := &state.DateRangeInputState{
+		ID:           id,
+		StartValue:   &start,
+		EndValue:     &end,
+		Label:        "Test DateRangeInput",
+		DefaultStart: &start,
+		DefaultEnd:   &end,
+		Required:     true,
+		Disabled:     false,
+		Format:       "YYYY/MM/DD",
+		MaxValue:     &maxDate,
+		MinValue:     &minDate,
+		Location:     time.UTC,
+		Presets: []state.DateRangeInputStatePreset{
+			{Label: "March", Start: start, End: end},
+		},
+	}
+
+	data := convertStateToDateRangeInputProto(dateRangeInputState)
+
+	if data == nil {
+		t.Fatal("convertStateToDateRangeInputProto returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"StartValue", ptrconv.StringValue(data.StartValue), "2025-03-01"},
+		{"EndValue", ptrconv.StringValue(data.EndValue), "2025-03-31"},
+		{"Label", data.Label, dateRangeInputState.Label},
+		{"DefaultStartValue", ptrconv.StringValue(data.DefaultStartValue), "2025-03-01"},
+		{"DefaultEndValue", ptrconv.StringValue(data.DefaultEndValue), "2025-03-31"},
+		{"Required", data.Required, dateRangeInputState.Required},
+		{"Disabled", data.Disabled, dateRangeInputState.Disabled},
+		{"Format", data.Format, dateRangeInputState.Format},
+		{"MaxValue", data.MaxValue, "2025-12-31"},
+		{"MinValue", data.MinValue, "2024-01-01"},
+		{"Presets length", len(data.Presets), 1},
+		{"Preset label", data.Presets[0].Label, "March"},
+		{"Preset start", data.Presets[0].StartValue, "2025-03-01"},
+		{"Preset end", data.Presets[0].EndValue, "2025-03-31"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertDateRangeInputProtoToState(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	data := &widgetv1.DateRangeInput{
+		StartValue:        ptrconv.StringPtr("2025-03-01"),
+		EndValue:          ptrconv.StringPtr("2025-03-31"),
+		Label:             "Test DateRangeInput",
+		DefaultStartValue: ptrconv.StringPtr("2025-02-01"),
+		DefaultEndValue:   ptrconv.StringPtr("2025-02-28"),
+		Required:          true,
+		Disabled:          false,
+		Format:            "YYYY/MM/DD",
+		MaxValue:          "2025-12-31",
+		MinValue:          "2024-01-01",
+		Presets: []*widgetv1.DateRangeInputPreset{
+			{Label: "March", StartValue: "2025-03-01", EndValue: "2025-03-31"},
+		},
+	}
+
+	state, err := convertDateRangeInputProtoToState(id, data, time.UTC)
+	if err != nil {
+		t.Fatalf("convertDateRangeInputProtoToState returned error: %v", err)
+	}
+	if state == nil {
+		t.Fatal("convertDateRangeInputProtoToState returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"ID", state.ID, id},
+		{"StartValue", state.StartValue.Format(time.DateOnly), "2025-03-01"},
+		{"EndValue", state.EndValue.Format(time.DateOnly), "2025-03-31"},
+		{"Label", state.Label, data.Label},
+		{"DefaultStart", state.DefaultStart.Format(time.DateOnly), "2025-02-01"},
+		{"DefaultEnd", state.DefaultEnd.Format(time.DateOnly), "2025-02-28"},
+		{"Required", state.Required, data.Required},
+		{"Disabled", state.Disabled, data.Disabled},
+		{"Format", state.Format, data.Format},
+		{"MaxValue", state.MaxValue.Format(time.DateOnly), data.MaxValue},
+		{"MinValue", state.MinValue.Format(time.DateOnly), data.MinValue},
+		{"Location", state.Location, time.UTC},
+		{"Presets length", len(state.Presets), 1},
+		{"Preset label", state.Presets[0].Label, "March"},
+		{"Preset start", state.Presets[0].Start.Format(time.DateOnly), "2025-03-01"},
+		{"Preset end", state.Presets[0].End.Format(time.DateOnly), "2025-03-31"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertDateRangeInputProtoToState_InvalidDate(t *testing.T) {
+	data := &widgetv1.DateRangeInput{
+		StartValue: ptrconv.StringPtr("not a date"),
+	}
+
+	if _, err := convertDateRangeInputProtoToState(uuid.Must(uuid.NewV4()), data, time.UTC); err == nil {
+		t.Error("convertDateRangeInputProtoToState error = nil, want error")
+	}
+}
+
+func TestDateRangeInput(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	label := "Test DateRangeInput"
+	start := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC)
+	minDate := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	maxDate := time.Date(2025, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+	// Defaults passed in reverse order are returned in order
+	gotStart, gotEnd := builder.DateRangeInput(label,
+		daterangeinput.WithDefaultValue(end, start),
+		daterangeinput.WithRequired(true),
+		daterangeinput.WithFormat("DD/MM/YYYY"),
+		daterangeinput.WithMinValue(minDate),
+		daterangeinput.WithMaxValue(maxDate),
+		daterangeinput.WithLocation(*time.UTC),
+		daterangeinput.WithPresets(daterangeinput.LastDays(7), daterangeinput.ThisMonth()),
+	)
+
+	if gotStart == nil || !gotStart.Equal(start) {
+		t.Errorf("DateRangeInput start = %v, want %v", gotStart, start)
+	}
+	if gotEnd == nil || !gotEnd.Equal(end) {
+		t.Errorf("DateRangeInput end = %v, want %v", gotEnd, end)
+	}
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Errorf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	msg := messages[0]
+	if v := msg.GetRenderWidget(); v == nil {
+		t.Fatal("WebSocket message type = nil, want RenderWidget")
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeDateRangeInput, []int{0})
+	state := sess.State.GetDateRangeInput(widgetID)
+	if state == nil {
+		t.Fatal("DateRangeInput state not found")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", state.Label, label},
+		{"Required", state.Required, true},
+		{"Disabled", state.Disabled, false},
+		{"Format", state.Format, "DD/MM/YYYY"},
+		{"MinValue", state.MinValue.Equal(minDate), true},
+		{"MaxValue", state.MaxValue.Equal(maxDate), true},
+		{"Presets length", len(state.Presets), 2},
+		{"Preset label", state.Presets[0].Label, "Last 7 days"},
+		{"Preset location", state.Presets[0].Start.Location().String(), "UTC"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateRangeInput_OrdersStoredValue(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mock.NewClient(),
+		},
+	}
+
+	start := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC)
+	widgetID := builder.generatePageID(state.WidgetTypeDateRangeInput, []int{0})
+	sess.State.Set(widgetID, &state.DateRangeInputState{
+		ID:         widgetID,
+		StartValue: &end,
+		EndValue:   &start,
+	})
+
+	gotStart, gotEnd := builder.DateRangeInput("Period")
+	if gotStart == nil || !gotStart.Equal(start) || gotEnd == nil || !gotEnd.Equal(end) {
+		t.Errorf("DateRangeInput = (%v, %v), want (%v, %v)", gotStart, gotEnd, start, end)
+	}
+}
+
+func TestResolveDateRangePresets(t *testing.T) {
+	now := time.Date(2025, time.March, 15, 23, 30, 0, 0, time.UTC)
+	presets := []options.DateRangeInputPreset{}
+	for _, p := range []daterangeinput.Preset{
+		daterangeinput.Today(),
+		daterangeinput.LastDays(7),
+		daterangeinput.ThisMonth(),
+		daterangeinput.LastMonth(),
+		daterangeinput.ThisYear(),
+	} {
+		presets = append(presets, options.DateRangeInputPreset{Label: p.Label, Range: p.Range})
+	}
+
+	resolved := resolveDateRangePresets(presets, now, time.UTC)
+
+	tests := []struct {
+		label string
+		start string
+		end   string
+	}{
+		{"Today", "2025-03-15", "2025-03-15"},
+		{"Last 7 days", "2025-03-09", "2025-03-15"},
+		{"This month", "2025-03-01", "2025-03-15"},
+		{"Last month", "2025-02-01", "2025-02-28"},
+		{"This year", "2025-01-01", "2025-03-15"},
+	}
+
+	if len(resolved) != len(tests) {
+		t.Fatalf("resolved presets = %d, want %d", len(resolved), len(tests))
+	}
+	for i, tt := range tests {
+		t.Run(tt.label, func(t *testing.T) {
+			got := resolved[i]
+			if got.Label != tt.label {
+				t.Errorf("Label = %q, want %q", got.Label, tt.label)
+			}
+			if s := got.Start.Format(time.DateOnly); s != tt.start {
+				t.Errorf("Start = %s, want %s", s, tt.start)
+			}
+			if e := got.End.Format(time.DateOnly); e != tt.end {
+				t.Errorf("End = %s, want %s", e, tt.end)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package sourcetool
+
+import (
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/dialog"
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+)
+
+func (b *uiBuilder) Dialog(title string, opts ...dialog.Option) (UIBuilder, bool) {
+	dialogOpts := &options.DialogOptions{
+		Title:         title,
+		Open:          false,
+		Close:         false,
+		CloseOnSubmit: false,
+	}
+
+	for _, o := range opts {
+		o.Apply(dialogOpts)
+	}
+
+	sess := b.session
+	if sess == nil {
+		return b, false
+	}
+	page := b.page
+	if page == nil {
+		return b, false
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return b, false
+	}
+	path := cursor.getPath()
+
+	widgetID := b.generatePageID(state.WidgetTypeDialog, path)
+	dialogState := sess.State.GetDialog(widgetID)
+	if dialogState == nil {
+		dialogState = &state.DialogState{
+			ID:    widgetID,
+			Value: false,
+			Open:  false,
+		}
+	}
+	if dialogOpts.Open {
+		dialogState.Open = true
+	}
+	if dialogOpts.Close {
+		dialogState.Open = false
+	}
+	dialogState.Title = dialogOpts.Title
+	dialogState.CloseOnSubmit = dialogOpts.CloseOnSubmit
+	sess.State.Set(widgetID, dialogState)
+
+	dialog := convertStateToDialogProto(dialogState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_Dialog{
+				Dialog: dialog,
+			},
+		},
+	})
+
+	cursor.next()
+
+	childCursor := newCursor()
+	childCursor.parentPath = path
+
+	childBuilder := &uiBuilder{
+		runtime: b.runtime,
+		context: b.context,
+		session: sess,
+		page:    page,
+		cursor:  childCursor,
+	}
+
+	return childBuilder, dialogState.Value
+}
+
+func convertStateToDialogProto(state *state.DialogState) *widgetv1.Dialog {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.Dialog{
+		Value:         state.Value,
+		Title:         state.Title,
+		Open:          state.Open,
+		CloseOnSubmit: state.CloseOnSubmit,
+	}
+}
+
+func convertDialogProtoToState(id uuid.UUID, data *widgetv1.Dialog) *state.DialogState {
+	if data == nil {
+		return nil
+	}
+	return &state.DialogState{
+		ID:            id,
+		Value:         data.Value,
+		Title:         data.Title,
+		Open:          data.Open,
+		CloseOnSubmit: data.CloseOnSubmit,
+	}
+}
@@ -0,0 +1,42 @@
+package dialog
+
+import "github.com/trysourcetool/sourcetool-go/internal/options"
+
+type Option interface {
+	Apply(*options.DialogOptions)
+}
+
+type openOption bool
+
+func (o openOption) Apply(opts *options.DialogOptions) {
+	opts.Open = bool(o)
+}
+
+// WithOpen opens the dialog when open is true. Passing false leaves the
+// dialog as it is, so the result of a button can be passed directly.
+func WithOpen(open bool) Option {
+	return openOption(open)
+}
+
+type closeOption bool
+
+func (c closeOption) Apply(opts *options.DialogOptions) {
+	opts.Close = bool(c)
+}
+
+// WithClose closes the dialog when close is true. Like WithOpen, passing
+// false leaves the dialog as it is. If both are true, the dialog is closed.
+func WithClose(close bool) Option {
+	return closeOption(close)
+}
+
+type closeOnSubmitOption bool
+
+func (c closeOnSubmitOption) Apply(opts *options.DialogOptions) {
+	opts.CloseOnSubmit = bool(c)
+}
+
+// WithCloseOnSubmit closes the dialog when a button or form inside it is submitted.
+func WithCloseOnSubmit(closeOnSubmit bool) Option {
+	return closeOnSubmitOption(closeOnSubmit)
+}
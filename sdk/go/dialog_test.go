@@ -0,0 +1,220 @@
+package sourcetool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/dialog"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+)
+
+func TestConvertStateToDialogProto(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	dialogState := &state.DialogState{
+		ID:            id,
+		Value:         true,
+		Title:         "Confirm refund?",
+		Open:          true,
+		CloseOnSubmit: true,
+	}
+
+	data := convertStateToDialogProto(dialogState)
+
+	if data == nil {
+		t.Fatal("convertStateToDialogProto returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Value", data.Value, dialogState.Value},
+		{"Title", data.Title, dialogState.Title},
+		{"Open", data.Open, dialogState.Open},
+		{"CloseOnSubmit", data.CloseOnSubmit, dialogState.CloseOnSubmit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertDialogProtoToState(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	data := &widgetv1.Dialog{
+		Value:         true,
+		Title:         "Confirm refund?",
+		Open:          false,
+		CloseOnSubmit: true,
+	}
+
+	state := convertDialogProtoToState(id, data)
+
+	if state == nil {
+		t.Fatal("convertDialogProtoToState returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"ID", state.ID, id},
+		{"Value", state.Value, data.Value},
+		{"Title", state.Title, data.Title},
+		{"Open", state.Open, data.Open},
+		{"CloseOnSubmit", state.CloseOnSubmit, data.CloseOnSubmit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDialog(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	title := "Confirm refund?"
+	childBuilder, dismissed := builder.Dialog(title)
+
+	if childBuilder == nil {
+		t.Fatal("Dialog returned nil builder")
+	}
+	if dismissed {
+		t.Error("Dialog returned true for dismissed, want false")
+	}
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Errorf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	msg := messages[0]
+	if v := msg.GetRenderWidget(); v == nil {
+		t.Fatal("WebSocket message type = nil, want RenderWidget")
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeDialog, []int{0})
+	state := sess.State.GetDialog(widgetID)
+	if state == nil {
+		t.Fatal("Dialog state not found")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Title", state.Title, title},
+		{"Open", state.Open, false},                   // default value
+		{"CloseOnSubmit", state.CloseOnSubmit, false}, // default value
+		{"Value", state.Value, false},                 // default value
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDialog_OpenAndDismiss(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mock.NewClient(),
+		},
+	}
+	widgetID := builder.generatePageID(state.WidgetTypeDialog, []int{0})
+
+	// Opening from Go
+	builder.Dialog("Confirm", dialog.WithOpen(true), dialog.WithCloseOnSubmit(true))
+	dialogState := sess.State.GetDialog(widgetID)
+	if !dialogState.Open {
+		t.Fatal("Open = false, want true")
+	}
+	if !dialogState.CloseOnSubmit {
+		t.Error("CloseOnSubmit = false, want true")
+	}
+
+	// WithOpen(false) keeps the dialog open
+	builder.cursor = newCursor()
+	builder.Dialog("Confirm", dialog.WithOpen(false))
+	if !sess.State.GetDialog(widgetID).Open {
+		t.Error("Open after WithOpen(false) = false, want true")
+	}
+
+	// Closing from Go
+	builder.cursor = newCursor()
+	builder.Dialog("Confirm", dialog.WithClose(true))
+	if sess.State.GetDialog(widgetID).Open {
+		t.Error("Open after WithClose(true) = true, want false")
+	}
+
+	// WithClose(false) keeps the dialog closed, and it can be opened again
+	builder.cursor = newCursor()
+	builder.Dialog("Confirm", dialog.WithClose(false))
+	if sess.State.GetDialog(widgetID).Open {
+		t.Error("Open after WithClose(false) = true, want false")
+	}
+	builder.cursor = newCursor()
+	builder.Dialog("Confirm", dialog.WithOpen(true))
+	if !sess.State.GetDialog(widgetID).Open {
+		t.Error("Open after reopening = false, want true")
+	}
+
+	// Dismissed by the client
+	sess.State.Set(widgetID, &state.DialogState{
+		ID:    widgetID,
+		Value: true,
+		Open:  false,
+	})
+	builder.cursor = newCursor()
+	_, dismissed := builder.Dialog("Confirm")
+	if !dismissed {
+		t.Error("dismissed = false, want true")
+	}
+	if sess.State.GetDialog(widgetID).Open {
+		t.Error("Open after dismiss = true, want false")
+	}
+}
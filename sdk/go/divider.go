@@ -0,0 +1,65 @@
+package sourcetool
+
+import (
+	"github.com/gofrs/uuid/v5"
+
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+)
+
+func (b *uiBuilder) Divider() {
+	sess := b.session
+	if sess == nil {
+		return
+	}
+	page := b.page
+	if page == nil {
+		return
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return
+	}
+	path := cursor.getPath()
+
+	widgetID := b.generatePageID(state.WidgetTypeDivider, path)
+	dividerState := sess.State.GetDivider(widgetID)
+	if dividerState == nil {
+		dividerState = &state.DividerState{
+			ID: widgetID,
+		}
+	}
+	sess.State.Set(widgetID, dividerState)
+
+	dividerProto := convertStateToDividerProto(dividerState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_Divider{
+				Divider: dividerProto,
+			},
+		},
+	})
+
+	cursor.next()
+}
+
+func convertStateToDividerProto(state *state.DividerState) *widgetv1.Divider {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.Divider{}
+}
+
+func convertDividerProtoToState(id uuid.UUID, data *widgetv1.Divider) *state.DividerState {
+	if data == nil {
+		return nil
+	}
+	return &state.DividerState{
+		ID: id,
+	}
+}
@@ -0,0 +1,72 @@
+package sourcetool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+)
+
+func TestConvertDividerProtoToState(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+
+	state := convertDividerProtoToState(id, &widgetv1.Divider{})
+
+	if state == nil {
+		t.Fatal("convertDividerProtoToState returned nil")
+	}
+
+	if state.ID != id {
+		t.Errorf("ID = %v, want %v", state.ID, id)
+	}
+}
+
+func TestDivider(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	builder.Divider()
+	builder.Divider()
+
+	messages := mockWS.Messages()
+	if len(messages) != 2 {
+		t.Fatalf("WebSocket messages count = %d, want 2", len(messages))
+	}
+	for _, msg := range messages {
+		renderWidget := msg.GetRenderWidget()
+		if renderWidget == nil {
+			t.Fatal("WebSocket message type = nil, want RenderWidget")
+		}
+		if renderWidget.GetWidget().GetDivider() == nil {
+			t.Fatal("Widget type = nil, want Divider")
+		}
+	}
+
+	// Each call takes its own position in the page
+	for _, path := range [][]int{{0}, {1}} {
+		widgetID := builder.generatePageID(state.WidgetTypeDivider, path)
+		if sess.State.GetDivider(widgetID) == nil {
+			t.Errorf("Divider state at %v not found", path)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package sourcetool
+
+import (
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/downloadbutton"
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+)
+
+// downloadChunkSize is the maximum number of bytes sent in a single
+// DownloadFileChunk message. It keeps each message well below the
+// websocket read limit of the server.
+const downloadChunkSize = 256 * 1024
+
+func (b *uiBuilder) DownloadButton(label string, data []byte, fileName, mimeType string, opts ...downloadbutton.Option) {
+	downloadButtonOpts := &options.DownloadButtonOptions{
+		Label:    label,
+		Data:     data,
+		FileName: fileName,
+		MimeType: mimeType,
+		Disabled: false,
+	}
+
+	for _, o := range opts {
+		o.Apply(downloadButtonOpts)
+	}
+
+	if downloadButtonOpts.MimeType == "" {
+		downloadButtonOpts.MimeType = "application/octet-stream"
+	}
+
+	sess := b.session
+	if sess == nil {
+		return
+	}
+	page := b.page
+	if page == nil {
+		return
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return
+	}
+	path := cursor.getPath()
+
+	widgetID := b.generatePageID(state.WidgetTypeDownloadButton, path)
+	downloadButtonState := sess.State.GetDownloadButton(widgetID)
+	if downloadButtonState == nil {
+		downloadButtonState = &state.DownloadButtonState{
+			ID: widgetID,
+		}
+	}
+	downloadButtonState.Label = downloadButtonOpts.Label
+	downloadButtonState.Data = downloadButtonOpts.Data
+	downloadButtonState.FileName = downloadButtonOpts.FileName
+	downloadButtonState.MimeType = downloadButtonOpts.MimeType
+	downloadButtonState.Disabled = downloadButtonOpts.Disabled
+	sess.State.Set(widgetID, downloadButtonState)
+
+	downloadButton := convertStateToDownloadButtonProto(downloadButtonState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_DownloadButton{
+				DownloadButton: downloadButton,
+			},
+		},
+	})
+
+	cursor.next()
+}
+
+func convertStateToDownloadButtonProto(state *state.DownloadButtonState) *widgetv1.DownloadButton {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.DownloadButton{
+		Label:    state.Label,
+		FileName: state.FileName,
+		MimeType: state.MimeType,
+		Size:     int64(len(state.Data)),
+		Disabled: state.Disabled,
+	}
+}
+
+func convertDownloadButtonProtoToState(id uuid.UUID, data *widgetv1.DownloadButton) *state.DownloadButtonState {
+	if data == nil {
+		return nil
+	}
+	return &state.DownloadButtonState{
+		ID:       id,
+		Label:    data.Label,
+		FileName: data.FileName,
+		MimeType: data.MimeType,
+		Disabled: data.Disabled,
+	}
+}
+
+// splitDownloadChunks splits data into chunks of at most downloadChunkSize
+// bytes. Empty data yields a single empty chunk so the client still
+// receives a message completing the download.
+func splitDownloadChunks(data []byte) [][]byte {
+	if len(data) == 0 {
+		return [][]byte{{}}
+	}
+	chunks := make([][]byte, 0, (len(data)+downloadChunkSize-1)/downloadChunkSize)
+	for offset := 0; offset < len(data); offset += downloadChunkSize {
+		end := min(offset+downloadChunkSize, len(data))
+		chunks = append(chunks, data[offset:end])
+	}
+	return chunks
+}
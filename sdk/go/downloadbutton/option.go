@@ -0,0 +1,17 @@
+package downloadbutton
+
+import "github.com/trysourcetool/sourcetool-go/internal/options"
+
+type Option interface {
+	Apply(*options.DownloadButtonOptions)
+}
+
+type disabledOption bool
+
+func (d disabledOption) Apply(opts *options.DownloadButtonOptions) {
+	opts.Disabled = bool(d)
+}
+
+func WithDisabled(disabled bool) Option {
+	return disabledOption(disabled)
+}
@@ -0,0 +1,209 @@
+package sourcetool
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/downloadbutton"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+)
+
+func TestConvertStateToDownloadButtonProto(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	downloadButtonState := &state.DownloadButtonState{
+		ID:       id,
+		Label:    "Test DownloadButton",
+		FileName: "report.csv",
+		MimeType: "text/csv",
+		Data:     []byte("a,b\n1,2\n"),
+		Disabled: true,
+	}
+
+	data := convertStateToDownloadButtonProto(downloadButtonState)
+
+	if data == nil {
+		t.Fatal("convertStateToDownloadButtonProto returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", data.Label, downloadButtonState.Label},
+		{"FileName", data.FileName, downloadButtonState.FileName},
+		{"MimeType", data.MimeType, downloadButtonState.MimeType},
+		{"Size", data.Size, int64(len(downloadButtonState.Data))},
+		{"Disabled", data.Disabled, downloadButtonState.Disabled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertDownloadButtonProtoToState(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	data := &widgetv1.DownloadButton{
+		Label:    "Test DownloadButton",
+		FileName: "report.csv",
+		MimeType: "text/csv",
+		Size:     8,
+		Disabled: true,
+	}
+
+	state := convertDownloadButtonProtoToState(id, data)
+
+	if state == nil {
+		t.Fatal("convertDownloadButtonProtoToState returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"ID", state.ID, id},
+		{"Label", state.Label, data.Label},
+		{"FileName", state.FileName, data.FileName},
+		{"MimeType", state.MimeType, data.MimeType},
+		{"Disabled", state.Disabled, data.Disabled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDownloadButton(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	label := "Test DownloadButton"
+	content := []byte("a,b\n1,2\n")
+
+	builder.DownloadButton(label, content, "report.csv", "text/csv", downloadbutton.WithDisabled(true))
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Errorf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	msg := messages[0]
+	renderWidget := msg.GetRenderWidget()
+	if renderWidget == nil {
+		t.Fatal("WebSocket message type = nil, want RenderWidget")
+	}
+	if size := renderWidget.Widget.GetDownloadButton().GetSize(); size != int64(len(content)) {
+		t.Errorf("DownloadButton size = %d, want %d", size, len(content))
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeDownloadButton, []int{0})
+	state := sess.State.GetDownloadButton(widgetID)
+	if state == nil {
+		t.Fatal("DownloadButton state not found")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", state.Label, label},
+		{"Data", string(state.Data), string(content)},
+		{"FileName", state.FileName, "report.csv"},
+		{"MimeType", state.MimeType, "text/csv"},
+		{"Disabled", state.Disabled, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDownloadButton_DefaultMimeType(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mock.NewClient(),
+		},
+	}
+
+	builder.DownloadButton("Download", []byte("data"), "data.bin", "")
+
+	widgetID := builder.generatePageID(state.WidgetTypeDownloadButton, []int{0})
+	state := sess.State.GetDownloadButton(widgetID)
+	if state == nil {
+		t.Fatal("DownloadButton state not found")
+	}
+	if state.MimeType != "application/octet-stream" {
+		t.Errorf("Default MimeType = %v, want application/octet-stream", state.MimeType)
+	}
+}
+
+func TestSplitDownloadChunks(t *testing.T) {
+	tests := []struct {
+		name       string
+		size       int
+		wantChunks int
+	}{
+		{"empty", 0, 1},
+		{"smaller than chunk", 10, 1},
+		{"exactly one chunk", downloadChunkSize, 1},
+		{"multiple chunks", downloadChunkSize*2 + 1, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := bytes.Repeat([]byte{'x'}, tt.size)
+			chunks := splitDownloadChunks(data)
+			if len(chunks) != tt.wantChunks {
+				t.Fatalf("chunks count = %d, want %d", len(chunks), tt.wantChunks)
+			}
+			if got := bytes.Join(chunks, nil); !bytes.Equal(got, data) {
+				t.Errorf("joined chunks length = %d, want %d", len(got), len(data))
+			}
+		})
+	}
+}
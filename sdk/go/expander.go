@@ -0,0 +1,96 @@
+package sourcetool
+
+import (
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/expander"
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+)
+
+func (b *uiBuilder) Expander(label string, opts ...expander.Option) UIBuilder {
+	expanderOpts := &options.ExpanderOptions{
+		Label:    label,
+		Expanded: false,
+	}
+
+	for _, o := range opts {
+		o.Apply(expanderOpts)
+	}
+
+	sess := b.session
+	if sess == nil {
+		return b
+	}
+	page := b.page
+	if page == nil {
+		return b
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return b
+	}
+	path := cursor.getPath()
+
+	widgetID := b.generatePageID(state.WidgetTypeExpander, path)
+	expanderState := sess.State.GetExpander(widgetID)
+	if expanderState == nil {
+		expanderState = &state.ExpanderState{
+			ID:    widgetID,
+			Value: expanderOpts.Expanded,
+		}
+	}
+	expanderState.Label = expanderOpts.Label
+	sess.State.Set(widgetID, expanderState)
+
+	expander := convertStateToExpanderProto(expanderState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_Expander{
+				Expander: expander,
+			},
+		},
+	})
+
+	cursor.next()
+
+	childCursor := newCursor()
+	childCursor.parentPath = path
+
+	childBuilder := &uiBuilder{
+		runtime: b.runtime,
+		context: b.context,
+		session: sess,
+		page:    page,
+		cursor:  childCursor,
+	}
+
+	return childBuilder
+}
+
+func convertStateToExpanderProto(state *state.ExpanderState) *widgetv1.Expander {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.Expander{
+		Value: state.Value,
+		Label: state.Label,
+	}
+}
+
+func convertExpanderProtoToState(id uuid.UUID, data *widgetv1.Expander) *state.ExpanderState {
+	if data == nil {
+		return nil
+	}
+	return &state.ExpanderState{
+		ID:    id,
+		Value: data.Value,
+		Label: data.Label,
+	}
+}
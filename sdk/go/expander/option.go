@@ -0,0 +1,17 @@
+package expander
+
+import "github.com/trysourcetool/sourcetool-go/internal/options"
+
+type Option interface {
+	Apply(*options.ExpanderOptions)
+}
+
+type expandedOption bool
+
+func (e expandedOption) Apply(opts *options.ExpanderOptions) {
+	opts.Expanded = bool(e)
+}
+
+func WithExpanded(expanded bool) Option {
+	return expandedOption(expanded)
+}
@@ -0,0 +1,182 @@
+package sourcetool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/expander"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+)
+
+func TestConvertStateToExpanderProto(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	expanderState := &state.ExpanderState{
+		ID:    id,
+		Value: true,
+		Label: "Advanced filters",
+	}
+
+	data := convertStateToExpanderProto(expanderState)
+
+	if data == nil {
+		t.Fatal("convertStateToExpanderProto returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Value", data.Value, expanderState.Value},
+		{"Label", data.Label, expanderState.Label},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertExpanderProtoToState(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	data := &widgetv1.Expander{
+		Value: true,
+		Label: "Advanced filters",
+	}
+
+	state := convertExpanderProtoToState(id, data)
+
+	if state == nil {
+		t.Fatal("convertExpanderProtoToState returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"ID", state.ID, id},
+		{"Value", state.Value, data.Value},
+		{"Label", state.Label, data.Label},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpander(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	label := "Advanced filters"
+	childBuilder := builder.Expander(label)
+
+	if childBuilder == nil {
+		t.Fatal("Expander returned nil builder")
+	}
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Errorf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	msg := messages[0]
+	if v := msg.GetRenderWidget(); v == nil {
+		t.Fatal("WebSocket message type = nil, want RenderWidget")
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeExpander, []int{0})
+	expanderState := sess.State.GetExpander(widgetID)
+	if expanderState == nil {
+		t.Fatal("Expander state not found")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", expanderState.Label, label},
+		{"Value", expanderState.Value, false}, // default value
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+
+	// Widgets inside the expander are placed under the expander's path
+	childBuilder.Markdown("content")
+	markdownID := builder.generatePageID(state.WidgetTypeMarkdown, []int{0, 0})
+	if sess.State.GetMarkdown(markdownID) == nil {
+		t.Error("Markdown state inside expander not found")
+	}
+}
+
+func TestExpander_WithExpanded(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mock.NewClient(),
+		},
+	}
+
+	builder.Expander("Debug", expander.WithExpanded(true))
+
+	widgetID := builder.generatePageID(state.WidgetTypeExpander, []int{0})
+	expanderState := sess.State.GetExpander(widgetID)
+	if expanderState == nil {
+		t.Fatal("Expander state not found")
+	}
+	if !expanderState.Value {
+		t.Error("Value = false, want true")
+	}
+
+	// A collapse by the user persists over the default
+	expanderState.Value = false
+	builder.cursor = newCursor()
+	builder.Expander("Debug", expander.WithExpanded(true))
+
+	if sess.State.GetExpander(widgetID).Value {
+		t.Error("Value after collapse = true, want false")
+	}
+}
@@ -0,0 +1,178 @@
+package sourcetool
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/fileinput"
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+)
+
+// defaultMaxFileSize limits uploads to file inputs without WithMaxFileSize.
+const defaultMaxFileSize int64 = 100 << 20
+
+func (b *uiBuilder) FileInput(label string, opts ...fileinput.Option) []fileinput.File {
+	fileInputOpts := &options.FileInputOptions{
+		Label:       label,
+		Accept:      nil,
+		MaxFileSize: nil,
+		Multiple:    false,
+		Required:    false,
+		Disabled:    false,
+	}
+
+	for _, o := range opts {
+		o.Apply(fileInputOpts)
+	}
+
+	sess := b.session
+	if sess == nil {
+		return nil
+	}
+	page := b.page
+	if page == nil {
+		return nil
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return nil
+	}
+	path := cursor.getPath()
+
+	widgetID := b.generatePageID(state.WidgetTypeFileInput, path)
+	fileInputState := sess.State.GetFileInput(widgetID)
+	if fileInputState == nil {
+		fileInputState = &state.FileInputState{
+			ID: widgetID,
+		}
+	}
+	fileInputState.Label = fileInputOpts.Label
+	fileInputState.Accept = fileInputOpts.Accept
+	fileInputState.MaxFileSize = fileInputOpts.MaxFileSize
+	fileInputState.Multiple = fileInputOpts.Multiple
+	fileInputState.Required = fileInputOpts.Required
+	fileInputState.Disabled = fileInputOpts.Disabled
+	sess.State.Set(widgetID, fileInputState)
+
+	fileInput := convertStateToFileInputProto(fileInputState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_FileInput{
+				FileInput: fileInput,
+			},
+		},
+	})
+
+	cursor.next()
+
+	var files []fileinput.File
+	fileIDs := make([]uuid.UUID, 0, len(fileInputState.Value))
+	for _, f := range fileInputState.Value {
+		fileIDs = append(fileIDs, f.ID)
+		if !fileInputState.Multiple && len(files) == 1 {
+			continue
+		}
+		upload := sess.Uploads.Get(f.ID)
+		if upload == nil || upload.WidgetID != widgetID || !upload.Completed() {
+			continue
+		}
+		files = append(files, fileinput.File{
+			Name:     upload.Name,
+			MimeType: upload.MimeType,
+			Size:     upload.Size,
+			Data:     upload.Data(),
+		})
+	}
+	sess.Uploads.Prune(widgetID, fileIDs)
+
+	return files
+}
+
+// acceptsFile reports whether a file matches the accept list of a file input.
+// Entries follow the HTML accept attribute: extensions (".csv"),
+// MIME types ("text/csv") and MIME wildcards ("image/*").
+func acceptsFile(accept []string, name, mimeType string) bool {
+	if len(accept) == 0 {
+		return true
+	}
+	name = strings.ToLower(name)
+	mimeType = strings.ToLower(mimeType)
+	for _, a := range accept {
+		a = strings.ToLower(strings.TrimSpace(a))
+		switch {
+		case strings.HasPrefix(a, "."):
+			if strings.HasSuffix(name, a) {
+				return true
+			}
+		case strings.HasSuffix(a, "/*"):
+			if strings.HasPrefix(mimeType, strings.TrimSuffix(a, "*")) {
+				return true
+			}
+		case a == mimeType:
+			return true
+		}
+	}
+	return false
+}
+
+func convertStateToFileInputProto(state *state.FileInputState) *widgetv1.FileInput {
+	if state == nil {
+		return nil
+	}
+	value := make([]*widgetv1.FileInputFile, len(state.Value))
+	for i, f := range state.Value {
+		value[i] = &widgetv1.FileInputFile{
+			Id:       f.ID.String(),
+			Name:     f.Name,
+			MimeType: f.MimeType,
+			Size:     f.Size,
+		}
+	}
+	return &widgetv1.FileInput{
+		Value:       value,
+		Label:       state.Label,
+		Accept:      state.Accept,
+		MaxFileSize: state.MaxFileSize,
+		Multiple:    state.Multiple,
+		Required:    state.Required,
+		Disabled:    state.Disabled,
+	}
+}
+
+func convertFileInputProtoToState(id uuid.UUID, data *widgetv1.FileInput) (*state.FileInputState, error) {
+	if data == nil {
+		return nil, nil
+	}
+	value := make([]state.FileInputStateFile, len(data.Value))
+	for i, f := range data.Value {
+		fileID, err := uuid.FromString(f.Id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse file id %q: %v", f.Id, err)
+		}
+		value[i] = state.FileInputStateFile{
+			ID:       fileID,
+			Name:     f.Name,
+			MimeType: f.MimeType,
+			Size:     f.Size,
+		}
+	}
+	return &state.FileInputState{
+		ID:          id,
+		Value:       value,
+		Label:       data.Label,
+		Accept:      data.Accept,
+		MaxFileSize: data.MaxFileSize,
+		Multiple:    data.Multiple,
+		Required:    data.Required,
+		Disabled:    data.Disabled,
+	}, nil
+}
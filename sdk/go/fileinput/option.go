@@ -0,0 +1,57 @@
+package fileinput
+
+import "github.com/trysourcetool/sourcetool-go/internal/options"
+
+type Option interface {
+	Apply(*options.FileInputOptions)
+}
+
+type acceptOption []string
+
+func (a acceptOption) Apply(opts *options.FileInputOptions) {
+	opts.Accept = []string(a)
+}
+
+func WithAccept(types ...string) Option {
+	return acceptOption(types)
+}
+
+type maxFileSizeOption int64
+
+func (m maxFileSizeOption) Apply(opts *options.FileInputOptions) {
+	opts.MaxFileSize = (*int64)(&m)
+}
+
+func WithMaxFileSize(size int64) Option {
+	return maxFileSizeOption(size)
+}
+
+type multipleOption bool
+
+func (m multipleOption) Apply(opts *options.FileInputOptions) {
+	opts.Multiple = bool(m)
+}
+
+func WithMultiple(multiple bool) Option {
+	return multipleOption(multiple)
+}
+
+type requiredOption bool
+
+func (r requiredOption) Apply(opts *options.FileInputOptions) {
+	opts.Required = bool(r)
+}
+
+func WithRequired(required bool) Option {
+	return requiredOption(required)
+}
+
+type disabledOption bool
+
+func (d disabledOption) Apply(opts *options.FileInputOptions) {
+	opts.Disabled = bool(d)
+}
+
+func WithDisabled(disabled bool) Option {
+	return disabledOption(disabled)
+}
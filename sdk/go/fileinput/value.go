@@ -0,0 +1,17 @@
+package fileinput
+
+import (
+	"bytes"
+	"io"
+)
+
+type File struct {
+	Name     string
+	MimeType string
+	Size     int64
+	Data     []byte
+}
+
+func (f File) Reader() io.Reader {
+	return bytes.NewReader(f.Data)
+}
@@ -0,0 +1,278 @@
+package sourcetool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/fileinput"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+)
+
+func TestConvertStateToFileInputProto(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	fileID := uuid.Must(uuid.NewV4())
+	maxFileSize := int64(1024)
+
+	fileInputState := &state.FileInputState{
+		ID: id,
+		Value: []state.FileInputStateFile{
+			{ID: fileID, Name: "report.csv", MimeType: "text/csv", Size: 512},
+		},
+		Label:       "Test FileInput",
+		Accept:      []string{".csv"},
+		MaxFileSize: &maxFileSize,
+		Multiple:    true,
+		Required:    true,
+		Disabled:    false,
+	}
+
+	data := convertStateToFileInputProto(fileInputState)
+
+	if data == nil {
+		t.Fatal("convertStateToFileInputProto returned nil")
+	}
+	if len(data.Value) != 1 {
+		t.Fatalf("Value length = %d, want 1", len(data.Value))
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", data.Label, fileInputState.Label},
+		{"Accept", data.Accept[0], fileInputState.Accept[0]},
+		{"MaxFileSize", *data.MaxFileSize, *fileInputState.MaxFileSize},
+		{"Multiple", data.Multiple, fileInputState.Multiple},
+		{"Required", data.Required, fileInputState.Required},
+		{"Disabled", data.Disabled, fileInputState.Disabled},
+		{"Value.Id", data.Value[0].Id, fileID.String()},
+		{"Value.Name", data.Value[0].Name, "report.csv"},
+		{"Value.MimeType", data.Value[0].MimeType, "text/csv"},
+		{"Value.Size", data.Value[0].Size, int64(512)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertFileInputProtoToState(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	fileID := uuid.Must(uuid.NewV4())
+	maxFileSize := int64(1024)
+
+	data := &widgetv1.FileInput{
+		Value: []*widgetv1.FileInputFile{
+			{Id: fileID.String(), Name: "report.csv", MimeType: "text/csv", Size: 512},
+		},
+		Label:       "Test FileInput",
+		Accept:      []string{".csv"},
+		MaxFileSize: &maxFileSize,
+		Multiple:    true,
+		Required:    true,
+		Disabled:    false,
+	}
+
+	state, err := convertFileInputProtoToState(id, data)
+	if err != nil {
+		t.Fatalf("convertFileInputProtoToState returned error: %v", err)
+	}
+	if state == nil {
+		t.Fatal("convertFileInputProtoToState returned nil")
+	}
+	if len(state.Value) != 1 {
+		t.Fatalf("Value length = %d, want 1", len(state.Value))
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"ID", state.ID, id},
+		{"Label", state.Label, data.Label},
+		{"Accept", state.Accept[0], data.Accept[0]},
+		{"MaxFileSize", *state.MaxFileSize, *data.MaxFileSize},
+		{"Multiple", state.Multiple, data.Multiple},
+		{"Required", state.Required, data.Required},
+		{"Disabled", state.Disabled, data.Disabled},
+		{"Value.ID", state.Value[0].ID, fileID},
+		{"Value.Name", state.Value[0].Name, "report.csv"},
+		{"Value.MimeType", state.Value[0].MimeType, "text/csv"},
+		{"Value.Size", state.Value[0].Size, int64(512)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+
+	if _, err := convertFileInputProtoToState(id, &widgetv1.FileInput{
+		Value: []*widgetv1.FileInputFile{{Id: "invalid"}},
+	}); err == nil {
+		t.Error("convertFileInputProtoToState with invalid file id returned nil error")
+	}
+}
+
+func TestFileInput(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	label := "Test FileInput"
+	maxFileSize := int64(1024)
+
+	files := builder.FileInput(label,
+		fileinput.WithAccept(".csv", "text/plain"),
+		fileinput.WithMaxFileSize(maxFileSize),
+		fileinput.WithMultiple(true),
+		fileinput.WithRequired(true),
+		fileinput.WithDisabled(true),
+	)
+
+	if len(files) != 0 {
+		t.Errorf("FileInput files count = %d, want 0", len(files))
+	}
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Errorf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	msg := messages[0]
+	if v := msg.GetRenderWidget(); v == nil {
+		t.Fatal("WebSocket message type = nil, want RenderWidget")
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeFileInput, []int{0})
+	state := sess.State.GetFileInput(widgetID)
+	if state == nil {
+		t.Fatal("FileInput state not found")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", state.Label, label},
+		{"Accept", len(state.Accept), 2},
+		{"MaxFileSize", *state.MaxFileSize, maxFileSize},
+		{"Multiple", state.Multiple, true},
+		{"Required", state.Required, true},
+		{"Disabled", state.Disabled, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFileInput_Uploads(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mock.NewClient(),
+		},
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeFileInput, []int{0})
+	completedID := uuid.Must(uuid.NewV4())
+	partialID := uuid.Must(uuid.NewV4())
+	staleID := uuid.Must(uuid.NewV4())
+
+	if err := sess.Uploads.AppendChunk(completedID, widgetID, "a.txt", "text/plain", 5, 0, []byte("hello")); err != nil {
+		t.Fatalf("AppendChunk returned error: %v", err)
+	}
+	if err := sess.Uploads.AppendChunk(partialID, widgetID, "b.txt", "text/plain", 10, 0, []byte("hello")); err != nil {
+		t.Fatalf("AppendChunk returned error: %v", err)
+	}
+	if err := sess.Uploads.AppendChunk(staleID, widgetID, "c.txt", "text/plain", 5, 0, []byte("stale")); err != nil {
+		t.Fatalf("AppendChunk returned error: %v", err)
+	}
+	sess.State.Set(widgetID, &state.FileInputState{
+		ID: widgetID,
+		Value: []state.FileInputStateFile{
+			{ID: completedID, Name: "a.txt", MimeType: "text/plain", Size: 5},
+			{ID: partialID, Name: "b.txt", MimeType: "text/plain", Size: 10},
+		},
+	})
+
+	files := builder.FileInput("Test FileInput", fileinput.WithMultiple(true))
+
+	if len(files) != 1 {
+		t.Fatalf("FileInput files count = %d, want 1", len(files))
+	}
+	if files[0].Name != "a.txt" || string(files[0].Data) != "hello" {
+		t.Errorf("FileInput file = %+v, want a.txt with data hello", files[0])
+	}
+	if sess.Uploads.Get(partialID) == nil {
+		t.Error("partial upload was pruned")
+	}
+	if sess.Uploads.Get(staleID) != nil {
+		t.Error("stale upload was not pruned")
+	}
+}
+
+func TestAcceptsFile(t *testing.T) {
+	tests := []struct {
+		name     string
+		accept   []string
+		fileName string
+		mimeType string
+		want     bool
+	}{
+		{"empty accept", nil, "a.bin", "application/octet-stream", true},
+		{"extension", []string{".csv"}, "Report.CSV", "text/csv", true},
+		{"mime wildcard", []string{"image/*"}, "a.png", "image/png", true},
+		{"exact mime", []string{"application/pdf"}, "a.pdf", "application/pdf", true},
+		{"no match", []string{".csv", "image/*"}, "a.pdf", "application/pdf", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := acceptsFile(tt.accept, tt.fileName, tt.mimeType); got != tt.want {
+				t.Errorf("acceptsFile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
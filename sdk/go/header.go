@@ -0,0 +1,69 @@
+package sourcetool
+
+import (
+	"github.com/gofrs/uuid/v5"
+
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+)
+
+func (b *uiBuilder) Header(text string) {
+	sess := b.session
+	if sess == nil {
+		return
+	}
+	page := b.page
+	if page == nil {
+		return
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return
+	}
+	path := cursor.getPath()
+
+	widgetID := b.generatePageID(state.WidgetTypeHeader, path)
+	headerState := sess.State.GetHeader(widgetID)
+	if headerState == nil {
+		headerState = &state.HeaderState{
+			ID: widgetID,
+		}
+	}
+	headerState.Text = text
+	sess.State.Set(widgetID, headerState)
+
+	headerProto := convertStateToHeaderProto(headerState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_Header{
+				Header: headerProto,
+			},
+		},
+	})
+
+	cursor.next()
+}
+
+func convertStateToHeaderProto(state *state.HeaderState) *widgetv1.Header {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.Header{
+		Text: state.Text,
+	}
+}
+
+func convertHeaderProtoToState(id uuid.UUID, data *widgetv1.Header) *state.HeaderState {
+	if data == nil {
+		return nil
+	}
+	return &state.HeaderState{
+		ID:   id,
+		Text: data.Text,
+	}
+}
@@ -0,0 +1,96 @@
+package sourcetool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+)
+
+func TestConvertStateToHeaderProto(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	headerState := &state.HeaderState{
+		ID:   id,
+		Text: "Monthly report",
+	}
+
+	data := convertStateToHeaderProto(headerState)
+
+	if data == nil {
+		t.Fatal("convertStateToHeaderProto returned nil")
+	}
+
+	if data.Text != headerState.Text {
+		t.Errorf("Text = %v, want %v", data.Text, headerState.Text)
+	}
+}
+
+func TestConvertHeaderProtoToState(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	data := &widgetv1.Header{
+		Text: "Monthly report",
+	}
+
+	state := convertHeaderProtoToState(id, data)
+
+	if state == nil {
+		t.Fatal("convertHeaderProtoToState returned nil")
+	}
+
+	if state.ID != id {
+		t.Errorf("ID = %v, want %v", state.ID, id)
+	}
+	if state.Text != data.Text {
+		t.Errorf("Text = %v, want %v", state.Text, data.Text)
+	}
+}
+
+func TestHeader(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	text := "Monthly report"
+	builder.Header(text)
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Fatalf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	renderWidget := messages[0].GetRenderWidget()
+	if renderWidget == nil {
+		t.Fatal("WebSocket message type = nil, want RenderWidget")
+	}
+	if renderWidget.GetWidget().GetHeader() == nil {
+		t.Fatal("Widget type = nil, want Header")
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeHeader, []int{0})
+	state := sess.State.GetHeader(widgetID)
+	if state == nil {
+		t.Fatal("Header state not found")
+	}
+
+	if state.Text != text {
+		t.Errorf("Text = %v, want %v", state.Text, text)
+	}
+}
@@ -0,0 +1,128 @@
+package sourcetool
+
+import (
+	"bytes"
+	"fmt"
+	stdimage "image"
+	"image/png"
+	"net/http"
+	"reflect"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/image"
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+)
+
+func (b *uiBuilder) Image(src any, opts ...image.Option) {
+	imageOpts := &options.ImageOptions{
+		Width:   nil,
+		Caption: "",
+	}
+
+	for _, o := range opts {
+		o.Apply(imageOpts)
+	}
+
+	sess := b.session
+	if sess == nil {
+		return
+	}
+	page := b.page
+	if page == nil {
+		return
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return
+	}
+	path := cursor.getPath()
+
+	// An unsupported source still renders, as an image without a source
+	// that shows the error as its caption, so the widget keeps its place.
+	url, data, mimeType, err := resolveImageSource(src)
+	if err != nil {
+		imageOpts.Caption = err.Error()
+	}
+
+	widgetID := b.generatePageID(state.WidgetTypeImage, path)
+	imageState := sess.State.GetImage(widgetID)
+	if imageState == nil {
+		imageState = &state.ImageState{
+			ID: widgetID,
+		}
+	}
+	imageState.URL = url
+	imageState.Data = data
+	imageState.MimeType = mimeType
+	imageState.Width = imageOpts.Width
+	imageState.Caption = imageOpts.Caption
+	sess.State.Set(widgetID, imageState)
+
+	imageProto := convertStateToImageProto(imageState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_Image{
+				Image: imageProto,
+			},
+		},
+	})
+
+	cursor.next()
+}
+
+// resolveImageSource turns the src argument of Image into either a URL or
+// encoded image bytes with their MIME type.
+func resolveImageSource(src any) (url string, data []byte, mimeType string, err error) {
+	switch s := src.(type) {
+	case string:
+		return s, nil, "", nil
+	case []byte:
+		return "", s, http.DetectContentType(s), nil
+	case stdimage.Image:
+		// A nil pointer to an image type would panic inside png.Encode.
+		if v := reflect.ValueOf(s); v.Kind() == reflect.Pointer && v.IsNil() {
+			return "", nil, "", fmt.Errorf("nil image: %T", src)
+		}
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, s); err != nil {
+			return "", nil, "", err
+		}
+		return "", buf.Bytes(), "image/png", nil
+	default:
+		return "", nil, "", fmt.Errorf("unsupported image source type: %T", src)
+	}
+}
+
+func convertStateToImageProto(state *state.ImageState) *widgetv1.Image {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.Image{
+		Url:      state.URL,
+		MimeType: state.MimeType,
+		Size:     int64(len(state.Data)),
+		Width:    state.Width,
+		Caption:  state.Caption,
+	}
+}
+
+func convertImageProtoToState(id uuid.UUID, data *widgetv1.Image) *state.ImageState {
+	if data == nil {
+		return nil
+	}
+	return &state.ImageState{
+		ID:       id,
+		URL:      data.Url,
+		MimeType: data.MimeType,
+		Width:    data.Width,
+		Caption:  data.Caption,
+	}
+}
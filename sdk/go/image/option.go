@@ -0,0 +1,29 @@
+package image
+
+import "github.com/trysourcetool/sourcetool-go/internal/options"
+
+type Option interface {
+	Apply(*options.ImageOptions)
+}
+
+type widthOption int32
+
+func (w widthOption) Apply(opts *options.ImageOptions) {
+	opts.Width = (*int32)(&w)
+}
+
+// WithWidth sets the display width in pixels. The height follows the
+// aspect ratio of the image.
+func WithWidth(width int32) Option {
+	return widthOption(width)
+}
+
+type captionOption string
+
+func (c captionOption) Apply(opts *options.ImageOptions) {
+	opts.Caption = string(c)
+}
+
+func WithCaption(caption string) Option {
+	return captionOption(caption)
+}
@@ -0,0 +1,247 @@
+package sourcetool
+
+import (
+	"bytes"
+	"context"
+	stdimage "image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/image"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+)
+
+func TestConvertStateToImageProto(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	width := int32(320)
+
+	imageState := &state.ImageState{
+		ID:       id,
+		Data:     []byte("image data"),
+		MimeType: "image/png",
+		Width:    &width,
+		Caption:  "Screenshot",
+	}
+
+	data := convertStateToImageProto(imageState)
+
+	if data == nil {
+		t.Fatal("convertStateToImageProto returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Url", data.Url, ""},
+		{"MimeType", data.MimeType, imageState.MimeType},
+		{"Size", data.Size, int64(len(imageState.Data))},
+		{"Width", *data.Width, width},
+		{"Caption", data.Caption, imageState.Caption},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertImageProtoToState(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	width := int32(320)
+
+	data := &widgetv1.Image{
+		Url:     "https://example.com/logo.png",
+		Width:   &width,
+		Caption: "Logo",
+	}
+
+	state := convertImageProtoToState(id, data)
+
+	if state == nil {
+		t.Fatal("convertImageProtoToState returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"ID", state.ID, id},
+		{"URL", state.URL, data.Url},
+		{"MimeType", state.MimeType, data.MimeType},
+		{"Width", *state.Width, width},
+		{"Caption", state.Caption, data.Caption},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveImageSource(t *testing.T) {
+	img := stdimage.NewRGBA(stdimage.Rect(0, 0, 2, 2))
+	img.Set(0, 0, color.Black)
+
+	var pngBuf bytes.Buffer
+	if err := png.Encode(&pngBuf, img); err != nil {
+		t.Fatalf("png.Encode returned error: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		src          any
+		wantURL      string
+		wantData     []byte
+		wantMimeType string
+		wantErr      bool
+	}{
+		{"URL", "https://example.com/logo.png", "https://example.com/logo.png", nil, "", false},
+		{"Bytes", pngBuf.Bytes(), "", pngBuf.Bytes(), "image/png", false},
+		{"Image", img, "", pngBuf.Bytes(), "image/png", false},
+		{"Unsupported", 42, "", nil, "", true},
+		{"Nil image", (*stdimage.RGBA)(nil), "", nil, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			url, data, mimeType, err := resolveImageSource(tt.src)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if url != tt.wantURL {
+				t.Errorf("url = %q, want %q", url, tt.wantURL)
+			}
+			if !bytes.Equal(data, tt.wantData) {
+				t.Errorf("data length = %d, want %d", len(data), len(tt.wantData))
+			}
+			if mimeType != tt.wantMimeType {
+				t.Errorf("mimeType = %q, want %q", mimeType, tt.wantMimeType)
+			}
+		})
+	}
+}
+
+func TestImage(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	img := stdimage.NewGray(stdimage.Rect(0, 0, 4, 4))
+	builder.Image(img, image.WithWidth(200), image.WithCaption("Barcode"))
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Fatalf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	renderWidget := messages[0].GetRenderWidget()
+	if renderWidget == nil {
+		t.Fatal("WebSocket message type = nil, want RenderWidget")
+	}
+	imageProto := renderWidget.GetWidget().GetImage()
+	if imageProto == nil {
+		t.Fatal("Widget type = nil, want Image")
+	}
+	if imageProto.Size == 0 {
+		t.Error("Image size = 0, want encoded image size")
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeImage, []int{0})
+	state := sess.State.GetImage(widgetID)
+	if state == nil {
+		t.Fatal("Image state not found")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"URL", state.URL, ""},
+		{"MimeType", state.MimeType, "image/png"},
+		{"Size", int64(len(state.Data)), imageProto.Size},
+		{"Width", *state.Width, int32(200)},
+		{"Caption", state.Caption, "Barcode"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestImage_UnsupportedSource(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	builder.Image(nil)
+	builder.Image(42)
+
+	messages := mockWS.Messages()
+	if len(messages) != 2 {
+		t.Fatalf("WebSocket messages count = %d, want 2", len(messages))
+	}
+	for i, msg := range messages {
+		imageProto := msg.GetRenderWidget().GetWidget().GetImage()
+		if imageProto == nil {
+			t.Fatal("RenderWidget image = nil")
+		}
+		if imageProto.Url != "" || imageProto.Size != 0 {
+			t.Errorf("image %d has a source, want none", i)
+		}
+		if imageProto.Caption == "" {
+			t.Errorf("image %d caption is empty, want the error", i)
+		}
+	}
+
+	// Each call takes its own place on the page
+	if got := builder.cursor.index; got != 2 {
+		t.Errorf("cursor index = %d, want 2", got)
+	}
+}
@@ -0,0 +1,6 @@
+package options
+
+type AlertOptions struct {
+	Level string
+	Body  string
+}
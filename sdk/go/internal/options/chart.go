@@ -0,0 +1,11 @@
+package options
+
+type ChartOptions struct {
+	Type        string
+	Title       string
+	Description string
+	XField      string
+	YFields     []string
+	Height      *int32
+	Stacked     bool
+}
@@ -0,0 +1,13 @@
+package options
+
+type CodeEditorOptions struct {
+	Label        string
+	Placeholder  string
+	DefaultValue *string
+	Required     bool
+	Disabled     bool
+	Language     string
+	LineNumbers  bool
+	ReadOnly     bool
+	MaxHeight    *int32
+}
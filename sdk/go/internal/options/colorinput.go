@@ -0,0 +1,9 @@
+package options
+
+type ColorInputOptions struct {
+	Label        string
+	DefaultValue *string
+	Required     bool
+	Disabled     bool
+	Swatches     []string
+}
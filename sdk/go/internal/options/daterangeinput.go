@@ -0,0 +1,21 @@
+package options
+
+import "time"
+
+type DateRangeInputOptions struct {
+	Label        string
+	DefaultStart *time.Time
+	DefaultEnd   *time.Time
+	Required     bool
+	Disabled     bool
+	Format       string
+	MaxValue     *time.Time
+	MinValue     *time.Time
+	Location     *time.Location
+	Presets      []DateRangeInputPreset
+}
+
+type DateRangeInputPreset struct {
+	Label string
+	Range func(today time.Time) (start, end time.Time)
+}
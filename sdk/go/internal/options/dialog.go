@@ -0,0 +1,8 @@
+package options
+
+type DialogOptions struct {
+	Title         string
+	Open          bool
+	Close         bool
+	CloseOnSubmit bool
+}
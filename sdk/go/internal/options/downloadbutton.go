@@ -0,0 +1,9 @@
+package options
+
+type DownloadButtonOptions struct {
+	Label    string
+	Data     []byte
+	FileName string
+	MimeType string
+	Disabled bool
+}
@@ -0,0 +1,6 @@
+package options
+
+type ExpanderOptions struct {
+	Label    string
+	Expanded bool
+}
@@ -0,0 +1,10 @@
+package options
+
+type FileInputOptions struct {
+	Label       string
+	Accept      []string
+	MaxFileSize *int64
+	Multiple    bool
+	Required    bool
+	Disabled    bool
+}
@@ -0,0 +1,6 @@
+package options
+
+type ImageOptions struct {
+	Width   *int32
+	Caption string
+}
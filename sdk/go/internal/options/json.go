@@ -0,0 +1,5 @@
+package options
+
+type JSONOptions struct {
+	ExpandedDepth int32
+}
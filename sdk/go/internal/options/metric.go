@@ -0,0 +1,8 @@
+package options
+
+type MetricOptions struct {
+	Label      string
+	Value      any
+	Delta      any
+	DeltaColor string
+}
@@ -0,0 +1,11 @@
+package options
+
+type RangeSliderOptions struct {
+	Label       string
+	DefaultLow  *float64
+	DefaultHigh *float64
+	MinValue    float64
+	MaxValue    float64
+	Step        float64
+	Disabled    bool
+}
@@ -1,10 +1,22 @@
 package options
 
 type TableOptions struct {
-	Header       string
-	Description  string
-	Height       *int32
-	ColumnOrder  []string
-	OnSelect     string
-	RowSelection string
+	Header          string
+	Description     string
+	Height          *int32
+	ColumnOrder     []string
+	OnSelect        string
+	RowSelection    string
+	PageSize        *int32
+	EditableColumns []string
+	RowActions      []string
+	ColumnFormats   []TableColumnFormat
+}
+
+type TableColumnFormat struct {
+	Column     string
+	Type       string
+	Decimals   *int32
+	Currency   string
+	DateFormat string
 }
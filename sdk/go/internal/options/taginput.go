@@ -0,0 +1,11 @@
+package options
+
+type TagInputOptions struct {
+	Label        string
+	Placeholder  string
+	DefaultValue []string
+	Required     bool
+	Disabled     bool
+	Suggestions  []string
+	MaxTags      *int32
+}
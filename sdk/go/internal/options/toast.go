@@ -0,0 +1,9 @@
+package options
+
+import "time"
+
+type ToastOptions struct {
+	Message  string
+	Level    string
+	Duration time.Duration
+}
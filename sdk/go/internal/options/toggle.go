@@ -0,0 +1,8 @@
+package options
+
+type ToggleOptions struct {
+	Label         string
+	DefaultValue  bool
+	Disabled      bool
+	RerunOnChange bool
+}
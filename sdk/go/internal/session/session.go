@@ -1,6 +1,8 @@
 package session
 
 import (
+	"net/url"
+	"slices"
 	"sync"
 	"time"
 
@@ -20,17 +22,41 @@ type disconnectedSession struct {
 }
 
 type Session struct {
-	ID     uuid.UUID
-	PageID uuid.UUID
-	State  *State
+	ID      uuid.UUID
+	PageID  uuid.UUID
+	State   *State
+	Uploads *Uploads
+
+	query   url.Values
+	queryMu sync.RWMutex
 }
 
 func New(id, pageID uuid.UUID) *Session {
 	return &Session{
-		ID:     id,
-		PageID: pageID,
-		State:  newState(),
+		ID:      id,
+		PageID:  pageID,
+		State:   newState(),
+		Uploads: newUploads(),
+		query:   url.Values{},
+	}
+}
+
+// Query returns a copy of the query parameters of the page URL. The session
+// keeps its own copy, which is replaced on every rerun.
+func (s *Session) Query() url.Values {
+	s.queryMu.RLock()
+	defer s.queryMu.RUnlock()
+	query := make(url.Values, len(s.query))
+	for k, v := range s.query {
+		query[k] = slices.Clone(v)
 	}
+	return query
+}
+
+func (s *Session) SetQuery(query url.Values) {
+	s.queryMu.Lock()
+	defer s.queryMu.Unlock()
+	s.query = query
 }
 
 type SessionManager struct {
@@ -76,6 +102,7 @@ func (s *SessionManager) SetSession(session *Session) {
 
 	if ds, ok := s.disconnectedSessions[session.ID]; ok {
 		session.State = ds.session.State
+		session.Uploads = ds.session.Uploads
 		delete(s.disconnectedSessions, session.ID)
 	}
 
@@ -1,6 +1,7 @@
 package session
 
 import (
+	"net/url"
 	"sync"
 	"testing"
 
@@ -24,6 +25,26 @@ func TestSession_New(t *testing.T) {
 	if session.State == nil {
 		t.Error("session.State is nil")
 	}
+	if session.Uploads == nil {
+		t.Error("session.Uploads is nil")
+	}
+}
+
+func TestSession_Query(t *testing.T) {
+	session := New(uuid.Must(uuid.NewV4()), uuid.Must(uuid.NewV4()))
+	session.SetQuery(url.Values{"id": {"42"}})
+
+	// Changing the returned values does not change the session
+	query := session.Query()
+	query["id"][0] = "1"
+	query.Set("tab", "orders")
+
+	if got := session.Query().Get("id"); got != "42" {
+		t.Errorf("Query().Get(\"id\") = %q, want %q", got, "42")
+	}
+	if got := session.Query().Has("tab"); got {
+		t.Error("Query().Has(\"tab\") = true, want false")
+	}
 }
 
 func TestSessionManager_GetSetDelete(t *testing.T) {
@@ -118,6 +139,8 @@ func TestState_ResetButtons(t *testing.T) {
 	s := newState()
 	buttonID := uuid.Must(uuid.NewV4())
 	formID := uuid.Must(uuid.NewV4())
+	dialogID := uuid.Must(uuid.NewV4())
+	tableID := uuid.Must(uuid.NewV4())
 
 	// Set initial states
 	buttonState := &state.ButtonState{
@@ -129,8 +152,25 @@ func TestState_ResetButtons(t *testing.T) {
 		Value: true,
 	}
 
+	dialogState := &state.DialogState{
+		ID:    dialogID,
+		Value: true,
+	}
+
 	s.Set(buttonID, buttonState)
 	s.Set(formID, formState)
+	tableState := &state.TableState{
+		ID: tableID,
+		Value: state.TableStateValue{
+			Edits: []state.TableStateValueEdit{
+				{Row: 0, Column: "name", OldValue: "a", NewValue: "b"},
+			},
+			Action: &state.TableStateValueAction{Row: 0, Name: "Approve"},
+		},
+	}
+
+	s.Set(dialogID, dialogState)
+	s.Set(tableID, tableState)
 
 	// Reset buttons
 	s.ResetButtons()
@@ -144,6 +184,19 @@ func TestState_ResetButtons(t *testing.T) {
 	if got := s.GetForm(formID); got.Value {
 		t.Error("form value after reset = true, want false")
 	}
+
+	// Check dialog state
+	if got := s.GetDialog(dialogID); got.Value {
+		t.Error("dialog value after reset = true, want false")
+	}
+
+	// Check table edits
+	if got := s.GetTable(tableID); got.Value.Edits != nil {
+		t.Errorf("table edits after reset = %v, want nil", got.Value.Edits)
+	}
+	if got := s.GetTable(tableID); got.Value.Action != nil {
+		t.Errorf("table action after reset = %v, want nil", got.Value.Action)
+	}
 }
 
 func TestState_SetStates(t *testing.T) {
@@ -201,3 +254,64 @@ func TestState_ConcurrentAccess(t *testing.T) {
 
 	wg.Wait()
 }
+
+func TestUploads_AppendChunk(t *testing.T) {
+	u := newUploads()
+	id := uuid.Must(uuid.NewV4())
+	widgetID := uuid.Must(uuid.NewV4())
+
+	if err := u.AppendChunk(id, widgetID, "users.csv", "text/csv", 6, 0, []byte("abc")); err != nil {
+		t.Fatalf("AppendChunk() error = %v", err)
+	}
+	if got := u.Get(id); got == nil || got.Completed() {
+		t.Fatal("upload completed after first chunk, want incomplete")
+	}
+
+	if err := u.AppendChunk(id, widgetID, "users.csv", "text/csv", 6, 1, []byte("def")); err == nil {
+		t.Error("AppendChunk() with wrong offset error = nil, want error")
+	}
+	if err := u.AppendChunk(uuid.Must(uuid.NewV4()), widgetID, "users.csv", "text/csv", -1, 0, []byte("abc")); err == nil {
+		t.Error("AppendChunk() with negative size error = nil, want error")
+	}
+	if err := u.AppendChunk(id, widgetID, "users.csv", "text/csv", 6, 3, []byte("defg")); err == nil {
+		t.Error("AppendChunk() past declared size error = nil, want error")
+	}
+	if err := u.AppendChunk(id, widgetID, "users.csv", "text/csv", 6, 3, []byte("def")); err != nil {
+		t.Fatalf("AppendChunk() error = %v", err)
+	}
+
+	got := u.Get(id)
+	if !got.Completed() {
+		t.Error("upload not completed after last chunk")
+	}
+	if string(got.Data()) != "abcdef" {
+		t.Errorf("Data() = %q, want %q", got.Data(), "abcdef")
+	}
+}
+
+func TestUploads_Prune(t *testing.T) {
+	u := newUploads()
+	widgetID := uuid.Must(uuid.NewV4())
+	otherWidgetID := uuid.Must(uuid.NewV4())
+	keepID := uuid.Must(uuid.NewV4())
+	dropID := uuid.Must(uuid.NewV4())
+	otherID := uuid.Must(uuid.NewV4())
+
+	for id, wid := range map[uuid.UUID]uuid.UUID{keepID: widgetID, dropID: widgetID, otherID: otherWidgetID} {
+		if err := u.AppendChunk(id, wid, "a.txt", "text/plain", 1, 0, []byte("a")); err != nil {
+			t.Fatalf("AppendChunk() error = %v", err)
+		}
+	}
+
+	u.Prune(widgetID, []uuid.UUID{keepID})
+
+	if u.Get(keepID) == nil {
+		t.Error("kept upload was pruned")
+	}
+	if u.Get(dropID) != nil {
+		t.Error("unreferenced upload was not pruned")
+	}
+	if u.Get(otherID) == nil {
+		t.Error("upload of another widget was pruned")
+	}
+}
@@ -311,6 +311,448 @@ func (s *State) GetForm(id uuid.UUID) *state.FormState {
 	return v
 }
 
+func (s *State) GetFileInput(id uuid.UUID) *state.FileInputState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.FileInputState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetDownloadButton(id uuid.UUID) *state.DownloadButtonState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.DownloadButtonState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetChart(id uuid.UUID) *state.ChartState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.ChartState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetTabs(id uuid.UUID) *state.TabsState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.TabsState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetExpander(id uuid.UUID) *state.ExpanderState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.ExpanderState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetDialog(id uuid.UUID) *state.DialogState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.DialogState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetAlert(id uuid.UUID) *state.AlertState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.AlertState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetMetric(id uuid.UUID) *state.MetricState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.MetricState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetProgress(id uuid.UUID) *state.ProgressState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.ProgressState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetSpinner(id uuid.UUID) *state.SpinnerState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.SpinnerState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetSlider(id uuid.UUID) *state.SliderState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.SliderState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetRangeSlider(id uuid.UUID) *state.RangeSliderState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.RangeSliderState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetToggle(id uuid.UUID) *state.ToggleState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.ToggleState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetDateRangeInput(id uuid.UUID) *state.DateRangeInputState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.DateRangeInputState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetJSON(id uuid.UUID) *state.JSONState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.JSONState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetCodeEditor(id uuid.UUID) *state.CodeEditorState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.CodeEditorState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetImage(id uuid.UUID) *state.ImageState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.ImageState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetLink(id uuid.UUID) *state.LinkState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.LinkState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetPageLink(id uuid.UUID) *state.PageLinkState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.PageLinkState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetHeader(id uuid.UUID) *state.HeaderState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.HeaderState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetSubheader(id uuid.UUID) *state.SubheaderState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.SubheaderState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetCaption(id uuid.UUID) *state.CaptionState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.CaptionState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetDivider(id uuid.UUID) *state.DividerState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.DividerState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetSpacer(id uuid.UUID) *state.SpacerState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.SpacerState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetTagInput(id uuid.UUID) *state.TagInputState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.TagInputState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetColorInput(id uuid.UUID) *state.ColorInputState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.ColorInputState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
 func (s *State) ResetStates() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -334,6 +776,19 @@ func (s *State) ResetButtons() {
 				formState.Value = false
 				s.data[id] = formState
 			}
+		case state.WidgetTypeDialog:
+			dialogState, ok := st.(*state.DialogState)
+			if ok {
+				dialogState.Value = false
+				s.data[id] = dialogState
+			}
+		case state.WidgetTypeTable:
+			tableState, ok := st.(*state.TableState)
+			if ok {
+				tableState.Value.Edits = nil
+				tableState.Value.Action = nil
+				s.data[id] = tableState
+			}
 		}
 	}
 }
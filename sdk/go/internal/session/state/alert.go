@@ -0,0 +1,14 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeAlert WidgetType = "alert"
+
+type AlertState struct {
+	ID    uuid.UUID
+	Level string
+	Body  string
+}
+
+func (s *AlertState) IsWidgetState()      {}
+func (s *AlertState) GetType() WidgetType { return WidgetTypeAlert }
@@ -0,0 +1,13 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeCaption WidgetType = "caption"
+
+type CaptionState struct {
+	ID   uuid.UUID
+	Text string
+}
+
+func (s *CaptionState) IsWidgetState()      {}
+func (s *CaptionState) GetType() WidgetType { return WidgetTypeCaption }
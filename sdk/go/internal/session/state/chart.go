@@ -0,0 +1,20 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeChart WidgetType = "chart"
+
+type ChartState struct {
+	ID          uuid.UUID
+	Data        any
+	Type        string
+	Title       string
+	Description string
+	XField      string
+	YFields     []string
+	Height      *int32
+	Stacked     bool
+}
+
+func (s *ChartState) IsWidgetState()      {}
+func (s *ChartState) GetType() WidgetType { return WidgetTypeChart }
@@ -0,0 +1,22 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeCodeEditor WidgetType = "codeEditor"
+
+type CodeEditorState struct {
+	ID           uuid.UUID
+	Value        *string
+	Label        string
+	Placeholder  string
+	DefaultValue *string
+	Required     bool
+	Disabled     bool
+	Language     string
+	LineNumbers  bool
+	ReadOnly     bool
+	MaxHeight    *int32
+}
+
+func (s *CodeEditorState) IsWidgetState()      {}
+func (s *CodeEditorState) GetType() WidgetType { return WidgetTypeCodeEditor }
@@ -0,0 +1,18 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeColorInput WidgetType = "colorInput"
+
+type ColorInputState struct {
+	ID           uuid.UUID
+	Value        *string
+	Label        string
+	DefaultValue *string
+	Required     bool
+	Disabled     bool
+	Swatches     []string
+}
+
+func (s *ColorInputState) IsWidgetState()      {}
+func (s *ColorInputState) GetType() WidgetType { return WidgetTypeColorInput }
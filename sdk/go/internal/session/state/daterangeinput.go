@@ -0,0 +1,34 @@
+package state
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+const WidgetTypeDateRangeInput WidgetType = "dateRangeInput"
+
+type DateRangeInputState struct {
+	ID           uuid.UUID
+	StartValue   *time.Time
+	EndValue     *time.Time
+	Label        string
+	DefaultStart *time.Time
+	DefaultEnd   *time.Time
+	Required     bool
+	Disabled     bool
+	Format       string
+	MaxValue     *time.Time
+	MinValue     *time.Time
+	Location     *time.Location
+	Presets      []DateRangeInputStatePreset
+}
+
+type DateRangeInputStatePreset struct {
+	Label string
+	Start time.Time
+	End   time.Time
+}
+
+func (s *DateRangeInputState) IsWidgetState()      {}
+func (s *DateRangeInputState) GetType() WidgetType { return WidgetTypeDateRangeInput }
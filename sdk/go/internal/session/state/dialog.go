@@ -0,0 +1,16 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeDialog WidgetType = "dialog"
+
+type DialogState struct {
+	ID            uuid.UUID
+	Value         bool
+	Title         string
+	Open          bool
+	CloseOnSubmit bool
+}
+
+func (s *DialogState) IsWidgetState()      {}
+func (s *DialogState) GetType() WidgetType { return WidgetTypeDialog }
@@ -0,0 +1,12 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeDivider WidgetType = "divider"
+
+type DividerState struct {
+	ID uuid.UUID
+}
+
+func (s *DividerState) IsWidgetState()      {}
+func (s *DividerState) GetType() WidgetType { return WidgetTypeDivider }
@@ -0,0 +1,17 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeDownloadButton WidgetType = "downloadButton"
+
+type DownloadButtonState struct {
+	ID       uuid.UUID
+	Label    string
+	FileName string
+	MimeType string
+	Data     []byte
+	Disabled bool
+}
+
+func (s *DownloadButtonState) IsWidgetState()      {}
+func (s *DownloadButtonState) GetType() WidgetType { return WidgetTypeDownloadButton }
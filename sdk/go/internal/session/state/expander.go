@@ -0,0 +1,14 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeExpander WidgetType = "expander"
+
+type ExpanderState struct {
+	ID    uuid.UUID
+	Value bool
+	Label string
+}
+
+func (s *ExpanderState) IsWidgetState()      {}
+func (s *ExpanderState) GetType() WidgetType { return WidgetTypeExpander }
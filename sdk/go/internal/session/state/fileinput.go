@@ -0,0 +1,26 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeFileInput WidgetType = "fileInput"
+
+type FileInputState struct {
+	ID          uuid.UUID
+	Value       []FileInputStateFile
+	Label       string
+	Accept      []string
+	MaxFileSize *int64
+	Multiple    bool
+	Required    bool
+	Disabled    bool
+}
+
+type FileInputStateFile struct {
+	ID       uuid.UUID
+	Name     string
+	MimeType string
+	Size     int64
+}
+
+func (s *FileInputState) IsWidgetState()      {}
+func (s *FileInputState) GetType() WidgetType { return WidgetTypeFileInput }
@@ -0,0 +1,13 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeHeader WidgetType = "header"
+
+type HeaderState struct {
+	ID   uuid.UUID
+	Text string
+}
+
+func (s *HeaderState) IsWidgetState()      {}
+func (s *HeaderState) GetType() WidgetType { return WidgetTypeHeader }
@@ -0,0 +1,17 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeImage WidgetType = "image"
+
+type ImageState struct {
+	ID       uuid.UUID
+	URL      string
+	Data     []byte
+	MimeType string
+	Width    *int32
+	Caption  string
+}
+
+func (s *ImageState) IsWidgetState()      {}
+func (s *ImageState) GetType() WidgetType { return WidgetTypeImage }
@@ -0,0 +1,14 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeLink WidgetType = "link"
+
+type LinkState struct {
+	ID    uuid.UUID
+	Label string
+	URL   string
+}
+
+func (s *LinkState) IsWidgetState()      {}
+func (s *LinkState) GetType() WidgetType { return WidgetTypeLink }
@@ -0,0 +1,17 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeMetric WidgetType = "metric"
+
+type MetricState struct {
+	ID             uuid.UUID
+	Label          string
+	Value          string
+	Delta          *string
+	DeltaDirection string
+	DeltaColor     string
+}
+
+func (s *MetricState) IsWidgetState()      {}
+func (s *MetricState) GetType() WidgetType { return WidgetTypeMetric }
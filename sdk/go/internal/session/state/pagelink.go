@@ -0,0 +1,17 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypePageLink WidgetType = "pageLink"
+
+type PageLinkState struct {
+	ID       uuid.UUID
+	Label    string
+	PageID   uuid.UUID
+	Route    string
+	Query    string
+	Disabled bool
+}
+
+func (s *PageLinkState) IsWidgetState()      {}
+func (s *PageLinkState) GetType() WidgetType { return WidgetTypePageLink }
@@ -0,0 +1,21 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeRangeSlider WidgetType = "rangeSlider"
+
+type RangeSliderState struct {
+	ID          uuid.UUID
+	Low         float64
+	High        float64
+	Label       string
+	DefaultLow  float64
+	DefaultHigh float64
+	MinValue    float64
+	MaxValue    float64
+	Step        float64
+	Disabled    bool
+}
+
+func (s *RangeSliderState) IsWidgetState()      {}
+func (s *RangeSliderState) GetType() WidgetType { return WidgetTypeRangeSlider }
@@ -0,0 +1,19 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeSlider WidgetType = "slider"
+
+type SliderState struct {
+	ID           uuid.UUID
+	Value        float64
+	Label        string
+	DefaultValue float64
+	MinValue     float64
+	MaxValue     float64
+	Step         float64
+	Disabled     bool
+}
+
+func (s *SliderState) IsWidgetState()      {}
+func (s *SliderState) GetType() WidgetType { return WidgetTypeSlider }
@@ -0,0 +1,12 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeSpacer WidgetType = "spacer"
+
+type SpacerState struct {
+	ID uuid.UUID
+}
+
+func (s *SpacerState) IsWidgetState()      {}
+func (s *SpacerState) GetType() WidgetType { return WidgetTypeSpacer }
@@ -0,0 +1,14 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeSpinner WidgetType = "spinner"
+
+type SpinnerState struct {
+	ID     uuid.UUID
+	Text   string
+	Active bool
+}
+
+func (s *SpinnerState) IsWidgetState()      {}
+func (s *SpinnerState) GetType() WidgetType { return WidgetTypeSpinner }
@@ -0,0 +1,13 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeSubheader WidgetType = "subheader"
+
+type SubheaderState struct {
+	ID   uuid.UUID
+	Text string
+}
+
+func (s *SubheaderState) IsWidgetState()      {}
+func (s *SubheaderState) GetType() WidgetType { return WidgetTypeSubheader }
@@ -0,0 +1,13 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeTabItem WidgetType = "tabItem"
+
+type TabItemState struct {
+	ID    uuid.UUID
+	Label string
+}
+
+func (s *TabItemState) IsWidgetState()      {}
+func (s *TabItemState) GetType() WidgetType { return WidgetTypeTabItem }
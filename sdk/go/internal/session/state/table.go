@@ -5,19 +5,43 @@ import "github.com/gofrs/uuid/v5"
 const WidgetTypeTable WidgetType = "table"
 
 type TableState struct {
-	ID           uuid.UUID
-	Data         any
-	Value        TableStateValue
-	Header       string
-	Description  string
-	Height       *int32
-	ColumnOrder  []string
-	OnSelect     string
-	RowSelection string
+	ID              uuid.UUID
+	Data            any
+	Value           TableStateValue
+	Header          string
+	Description     string
+	Height          *int32
+	ColumnOrder     []string
+	OnSelect        string
+	RowSelection    string
+	Paginated       bool
+	TotalRows       int64
+	EditableColumns []string
+	RowActions      []string
+	Columns         []TableStateColumn
+	Error           string
+}
+
+type TableStateColumn struct {
+	Name   string
+	Label  string
+	Format *TableStateColumnFormat
+}
+
+type TableStateColumnFormat struct {
+	Type       string
+	Decimals   *int32
+	Currency   string
+	DateFormat string
 }
 
 type TableStateValue struct {
-	Selection *TableStateValueSelection
+	Selection  *TableStateValueSelection
+	Pagination *TableStateValuePagination
+	Sort       *TableStateValueSort
+	Filters    map[string]string
+	Edits      []TableStateValueEdit
+	Action     *TableStateValueAction
 }
 
 type TableStateValueSelection struct {
@@ -25,5 +49,27 @@ type TableStateValueSelection struct {
 	Rows []int32
 }
 
+type TableStateValuePagination struct {
+	Page     int32
+	PageSize int32
+}
+
+type TableStateValueSort struct {
+	Column    string
+	Direction string
+}
+
+type TableStateValueEdit struct {
+	Row      int32
+	Column   string
+	OldValue string
+	NewValue string
+}
+
+type TableStateValueAction struct {
+	Row  int32
+	Name string
+}
+
 func (s *TableState) IsWidgetState()      {}
 func (s *TableState) GetType() WidgetType { return WidgetTypeTable }
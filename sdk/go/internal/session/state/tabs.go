@@ -0,0 +1,14 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeTabs WidgetType = "tabs"
+
+type TabsState struct {
+	ID    uuid.UUID
+	Value int
+	Tabs  int
+}
+
+func (s *TabsState) IsWidgetState()      {}
+func (s *TabsState) GetType() WidgetType { return WidgetTypeTabs }
@@ -0,0 +1,20 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeTagInput WidgetType = "tagInput"
+
+type TagInputState struct {
+	ID           uuid.UUID
+	Label        string
+	Value        []string
+	Placeholder  string
+	DefaultValue []string
+	Required     bool
+	Disabled     bool
+	Suggestions  []string
+	MaxTags      *int32
+}
+
+func (s *TagInputState) IsWidgetState()      {}
+func (s *TagInputState) GetType() WidgetType { return WidgetTypeTagInput }
@@ -0,0 +1,17 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeToggle WidgetType = "toggle"
+
+type ToggleState struct {
+	ID            uuid.UUID
+	Label         string
+	Value         bool
+	DefaultValue  bool
+	Disabled      bool
+	RerunOnChange bool
+}
+
+func (s *ToggleState) IsWidgetState()      {}
+func (s *ToggleState) GetType() WidgetType { return WidgetTypeToggle }
@@ -0,0 +1,96 @@
+package session
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+type Upload struct {
+	ID       uuid.UUID
+	WidgetID uuid.UUID
+	Name     string
+	MimeType string
+	Size     int64
+	data     []byte
+}
+
+func (u *Upload) Completed() bool {
+	return int64(len(u.data)) == u.Size
+}
+
+func (u *Upload) Data() []byte {
+	return u.data
+}
+
+type Uploads struct {
+	data map[uuid.UUID]*Upload // file ID -> upload
+	mu   sync.RWMutex
+}
+
+func newUploads() *Uploads {
+	return &Uploads{
+		data: make(map[uuid.UUID]*Upload),
+	}
+}
+
+func (u *Uploads) Get(id uuid.UUID) *Upload {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+	return u.data[id]
+}
+
+func (u *Uploads) AppendChunk(id, widgetID uuid.UUID, name, mimeType string, size, offset int64, chunk []byte) error {
+	if size < 0 || offset < 0 {
+		return fmt.Errorf("invalid size %d or offset %d for file %s", size, offset, id)
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	upload, ok := u.data[id]
+	if !ok || offset == 0 {
+		upload = &Upload{
+			ID:       id,
+			WidgetID: widgetID,
+			Name:     name,
+			MimeType: mimeType,
+			Size:     size,
+		}
+		u.data[id] = upload
+	}
+
+	if upload.WidgetID != widgetID {
+		return fmt.Errorf("file %s belongs to another widget", id)
+	}
+	if offset != int64(len(upload.data)) {
+		return fmt.Errorf("unexpected chunk offset %d for file %s, want %d", offset, id, len(upload.data))
+	}
+	if offset+int64(len(chunk)) > upload.Size {
+		return fmt.Errorf("chunk exceeds declared size of file %s", id)
+	}
+
+	upload.data = append(upload.data, chunk...)
+
+	return nil
+}
+
+// Prune drops the uploads of a widget that are no longer referenced by its value.
+func (u *Uploads) Prune(widgetID uuid.UUID, keep []uuid.UUID) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	kept := make(map[uuid.UUID]struct{}, len(keep))
+	for _, id := range keep {
+		kept[id] = struct{}{}
+	}
+	for id, upload := range u.data {
+		if upload.WidgetID != widgetID {
+			continue
+		}
+		if _, ok := kept[id]; !ok {
+			delete(u.data, id)
+		}
+	}
+}
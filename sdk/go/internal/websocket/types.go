@@ -41,6 +41,12 @@ func NewMessage(id string, payload proto.Message) (*websocketv1.Message, error)
 		msg.Type = &websocketv1.Message_CloseSession{CloseSession: p}
 	case *websocketv1.ScriptFinished:
 		msg.Type = &websocketv1.Message_ScriptFinished{ScriptFinished: p}
+	case *websocketv1.UploadFileChunk:
+		msg.Type = &websocketv1.Message_UploadFileChunk{UploadFileChunk: p}
+	case *websocketv1.DownloadFileChunk:
+		msg.Type = &websocketv1.Message_DownloadFileChunk{DownloadFileChunk: p}
+	case *websocketv1.Toast:
+		msg.Type = &websocketv1.Message_Toast{Toast: p}
 	case *exceptionv1.Exception:
 		msg.Type = &websocketv1.Message_Exception{Exception: p}
 	default:
@@ -0,0 +1,20 @@
+package json
+
+import "github.com/trysourcetool/sourcetool-go/internal/options"
+
+type Option interface {
+	Apply(*options.JSONOptions)
+}
+
+type expandedDepthOption int32
+
+func (e expandedDepthOption) Apply(opts *options.JSONOptions) {
+	opts.ExpandedDepth = int32(e)
+}
+
+// WithExpandedDepth sets how many levels of the tree are expanded when the
+// viewer is first shown. 0 collapses everything and a negative depth
+// expands every level.
+func WithExpandedDepth(depth int) Option {
+	return expandedDepthOption(depth)
+}
@@ -0,0 +1,236 @@
+package sourcetool
+
+import (
+	"bytes"
+	"context"
+	stdjson "encoding/json"
+	"math"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+	"github.com/trysourcetool/sourcetool-go/json"
+)
+
+type testJSONAddress struct {
+	City    string `json:"city"`
+	Country string `json:"country"`
+}
+
+type testJSONUser struct {
+	ID      int             `json:"id"`
+	Name    string          `json:"name"`
+	Tags    []string        `json:"tags"`
+	Address testJSONAddress `json:"address"`
+}
+
+func TestConvertStateToJSONProto(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	jsonState := &state.JSONState{
+		ID: id,
+		Data: testJSONUser{
+			ID:      1,
+			Name:    "Alice",
+			Tags:    []string{"admin"},
+			Address: testJSONAddress{City: "Tokyo", Country: "JP"},
+		},
+		ExpandedDepth: 2,
+	}
+
+	data, err := convertStateToJSONProto(jsonState)
+	if err != nil {
+		t.Fatalf("convertStateToJSONProto returned error: %v", err)
+	}
+
+	if data == nil {
+		t.Fatal("convertStateToJSONProto returned nil")
+	}
+
+	want := `{"id":1,"name":"Alice","tags":["admin"],"address":{"city":"Tokyo","country":"JP"}}`
+	if string(data.Data) != want {
+		t.Errorf("Data = %s, want %s", data.Data, want)
+	}
+	if data.ExpandedDepth != jsonState.ExpandedDepth {
+		t.Errorf("ExpandedDepth = %d, want %d", data.ExpandedDepth, jsonState.ExpandedDepth)
+	}
+}
+
+func TestConvertStateToJSONProto_RawMessage(t *testing.T) {
+	raw := stdjson.RawMessage(`{"status": "ok", "items": [1, 2]}`)
+	data, err := convertStateToJSONProto(&state.JSONState{Data: raw})
+	if err != nil {
+		t.Fatalf("convertStateToJSONProto returned error: %v", err)
+	}
+
+	want := `{"status":"ok","items":[1,2]}`
+	if string(data.Data) != want {
+		t.Errorf("Data = %s, want %s", data.Data, want)
+	}
+}
+
+func TestConvertStateToJSONProto_Error(t *testing.T) {
+	if _, err := convertStateToJSONProto(&state.JSONState{Data: make(chan int)}); err == nil {
+		t.Error("convertStateToJSONProto error = nil, want error")
+	}
+}
+
+func TestConvertJSONProtoToState(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	data := &widgetv1.Json{
+		Data:          []byte(`{"id":1}`),
+		ExpandedDepth: 3,
+	}
+
+	state := convertJSONProtoToState(id, data)
+
+	if state == nil {
+		t.Fatal("convertJSONProtoToState returned nil")
+	}
+
+	raw, ok := state.Data.(stdjson.RawMessage)
+	if !ok {
+		t.Fatalf("Data type = %T, want json.RawMessage", state.Data)
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"ID", state.ID, id},
+		{"Data", string(raw), string(data.Data)},
+		{"ExpandedDepth", state.ExpandedDepth, data.ExpandedDepth},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSON(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	payload := map[string]any{
+		"id":    1,
+		"items": []any{map[string]any{"sku": "A-1"}},
+	}
+	builder.JSON(payload, json.WithExpandedDepth(2))
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Fatalf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	renderWidget := messages[0].GetRenderWidget()
+	if renderWidget == nil {
+		t.Fatal("WebSocket message type = nil, want RenderWidget")
+	}
+	jsonProto := renderWidget.GetWidget().GetJson()
+	if jsonProto == nil {
+		t.Fatal("Widget type = nil, want Json")
+	}
+	if want := []byte(`{"id":1,"items":[{"sku":"A-1"}]}`); !bytes.Equal(jsonProto.Data, want) {
+		t.Errorf("Data = %s, want %s", jsonProto.Data, want)
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeJSON, []int{0})
+	state := sess.State.GetJSON(widgetID)
+	if state == nil {
+		t.Fatal("JSON state not found")
+	}
+	if state.ExpandedDepth != 2 {
+		t.Errorf("ExpandedDepth = %d, want 2", state.ExpandedDepth)
+	}
+}
+
+func TestJSON_DefaultExpandedDepth(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mock.NewClient(),
+		},
+	}
+
+	builder.JSON([]int{1, 2, 3})
+
+	widgetID := builder.generatePageID(state.WidgetTypeJSON, []int{0})
+	state := sess.State.GetJSON(widgetID)
+	if state == nil {
+		t.Fatal("JSON state not found")
+	}
+	if state.ExpandedDepth != 1 {
+		t.Errorf("ExpandedDepth = %d, want 1", state.ExpandedDepth)
+	}
+}
+
+func TestJSON_MarshalError(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	builder.JSON(map[string]float64{"ratio": math.NaN()})
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Fatalf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	jsonProto := messages[0].GetRenderWidget().GetWidget().GetJson()
+	if jsonProto == nil {
+		t.Fatal("Widget type = nil, want Json")
+	}
+	if jsonProto.Error == "" {
+		t.Error("Error is empty, want the encoding error")
+	}
+	if jsonProto.Data != nil {
+		t.Errorf("Data = %s, want nil", jsonProto.Data)
+	}
+	if builder.cursor.index != 1 {
+		t.Errorf("cursor index = %d, want 1", builder.cursor.index)
+	}
+}
@@ -0,0 +1,72 @@
+package sourcetool
+
+import (
+	"github.com/gofrs/uuid/v5"
+
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+)
+
+func (b *uiBuilder) Link(label, target string) {
+	sess := b.session
+	if sess == nil {
+		return
+	}
+	page := b.page
+	if page == nil {
+		return
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return
+	}
+	path := cursor.getPath()
+
+	widgetID := b.generatePageID(state.WidgetTypeLink, path)
+	linkState := sess.State.GetLink(widgetID)
+	if linkState == nil {
+		linkState = &state.LinkState{
+			ID: widgetID,
+		}
+	}
+	linkState.Label = label
+	linkState.URL = target
+	sess.State.Set(widgetID, linkState)
+
+	linkProto := convertStateToLinkProto(linkState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_Link{
+				Link: linkProto,
+			},
+		},
+	})
+
+	cursor.next()
+}
+
+func convertStateToLinkProto(state *state.LinkState) *widgetv1.Link {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.Link{
+		Label: state.Label,
+		Url:   state.URL,
+	}
+}
+
+func convertLinkProtoToState(id uuid.UUID, data *widgetv1.Link) *state.LinkState {
+	if data == nil {
+		return nil
+	}
+	return &state.LinkState{
+		ID:    id,
+		Label: data.Label,
+		URL:   data.Url,
+	}
+}
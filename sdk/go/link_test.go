@@ -0,0 +1,119 @@
+package sourcetool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+)
+
+func TestConvertStateToLinkProto(t *testing.T) {
+	linkState := &state.LinkState{
+		ID:    uuid.Must(uuid.NewV4()),
+		Label: "Docs",
+		URL:   "https://docs.example.com",
+	}
+
+	data := convertStateToLinkProto(linkState)
+
+	if data == nil {
+		t.Fatal("convertStateToLinkProto returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", data.Label, linkState.Label},
+		{"Url", data.Url, linkState.URL},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertLinkProtoToState(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	data := &widgetv1.Link{
+		Label: "Docs",
+		Url:   "https://docs.example.com",
+	}
+
+	state := convertLinkProtoToState(id, data)
+
+	if state == nil {
+		t.Fatal("convertLinkProtoToState returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"ID", state.ID, id},
+		{"Label", state.Label, data.Label},
+		{"URL", state.URL, data.Url},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLink(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	builder.Link("Runbook", "https://wiki.example.com/runbook")
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Errorf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	msg := messages[0]
+	if v := msg.GetRenderWidget(); v == nil {
+		t.Fatal("WebSocket message type = nil, want RenderWidget")
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeLink, []int{0})
+	state := sess.State.GetLink(widgetID)
+	if state == nil {
+		t.Fatal("Link state not found")
+	}
+	if state.Label != "Runbook" {
+		t.Errorf("Label = %q, want %q", state.Label, "Runbook")
+	}
+	if state.URL != "https://wiki.example.com/runbook" {
+		t.Errorf("URL = %q, want %q", state.URL, "https://wiki.example.com/runbook")
+	}
+}
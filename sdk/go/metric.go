@@ -0,0 +1,179 @@
+package sourcetool
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/metric"
+)
+
+func (b *uiBuilder) Metric(label string, value any, opts ...metric.Option) {
+	metricOpts := &options.MetricOptions{
+		Label:      label,
+		Value:      value,
+		Delta:      nil,
+		DeltaColor: metric.DeltaColorNormal.String(),
+	}
+
+	for _, o := range opts {
+		o.Apply(metricOpts)
+	}
+
+	sess := b.session
+	if sess == nil {
+		return
+	}
+	page := b.page
+	if page == nil {
+		return
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return
+	}
+	path := cursor.getPath()
+
+	widgetID := b.generatePageID(state.WidgetTypeMetric, path)
+	metricState := sess.State.GetMetric(widgetID)
+	if metricState == nil {
+		metricState = &state.MetricState{
+			ID: widgetID,
+		}
+	}
+	metricState.Label = metricOpts.Label
+	metricState.Value = formatMetricValue(metricOpts.Value)
+	metricState.Delta = nil
+	if derefMetricValue(metricOpts.Delta) != nil {
+		delta := formatMetricValue(metricOpts.Delta)
+		metricState.Delta = &delta
+	}
+	metricState.DeltaDirection = metricDeltaDirection(metricOpts.Delta).String()
+	metricState.DeltaColor = metricOpts.DeltaColor
+	sess.State.Set(widgetID, metricState)
+
+	metric := convertStateToMetricProto(metricState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_Metric{
+				Metric: metric,
+			},
+		},
+	})
+
+	cursor.next()
+}
+
+// formatMetricValue formats a metric value or delta for display.
+// Strings are shown as is, Stringers such as time.Duration use their
+// String method and floats are printed without trailing zeros.
+func formatMetricValue(v any) string {
+	switch v := derefMetricValue(v).(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case fmt.Stringer:
+		return v.String()
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// derefMetricValue follows pointers such as *int or *float64 to the value
+// they point to, and returns nil for nil pointers. Pointers that implement
+// fmt.Stringer are kept so their String method is used.
+func derefMetricValue(v any) any {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil
+		}
+		if _, ok := rv.Interface().(fmt.Stringer); ok {
+			return rv.Interface()
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return nil
+	}
+	return rv.Interface()
+}
+
+// metricDeltaDirection derives the direction of a delta from its sign.
+// Strings point down when they start with a minus sign and up otherwise.
+func metricDeltaDirection(delta any) metric.DeltaDirection {
+	delta = derefMetricValue(delta)
+	var sign int
+	rv := reflect.ValueOf(delta)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		sign = cmp.Compare(rv.Int(), 0)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		sign = cmp.Compare(rv.Uint(), 0)
+	case reflect.Float32, reflect.Float64:
+		sign = cmp.Compare(rv.Float(), 0)
+	default:
+		s := strings.TrimSpace(formatMetricValue(delta))
+		switch {
+		case s == "":
+			sign = 0
+		case strings.HasPrefix(s, "-"):
+			sign = -1
+		default:
+			sign = 1
+		}
+	}
+
+	switch {
+	case sign > 0:
+		return metric.DeltaDirectionUp
+	case sign < 0:
+		return metric.DeltaDirectionDown
+	default:
+		return metric.DeltaDirectionNone
+	}
+}
+
+func convertStateToMetricProto(state *state.MetricState) *widgetv1.Metric {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.Metric{
+		Label:          state.Label,
+		Value:          state.Value,
+		Delta:          state.Delta,
+		DeltaDirection: state.DeltaDirection,
+		DeltaColor:     state.DeltaColor,
+	}
+}
+
+func convertMetricProtoToState(id uuid.UUID, data *widgetv1.Metric) *state.MetricState {
+	if data == nil {
+		return nil
+	}
+	return &state.MetricState{
+		ID:             id,
+		Label:          data.Label,
+		Value:          data.Value,
+		Delta:          data.Delta,
+		DeltaDirection: data.DeltaDirection,
+		DeltaColor:     data.DeltaColor,
+	}
+}
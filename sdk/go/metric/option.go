@@ -0,0 +1,31 @@
+package metric
+
+import "github.com/trysourcetool/sourcetool-go/internal/options"
+
+type Option interface {
+	Apply(*options.MetricOptions)
+}
+
+type deltaOption struct {
+	delta any
+}
+
+func (d deltaOption) Apply(opts *options.MetricOptions) {
+	opts.Delta = d.delta
+}
+
+// WithDelta sets the change shown next to the value. It accepts the same
+// types as the value; the sign decides whether the delta points up or down.
+func WithDelta(delta any) Option {
+	return deltaOption{delta: delta}
+}
+
+type deltaColorOption DeltaColor
+
+func (d deltaColorOption) Apply(opts *options.MetricOptions) {
+	opts.DeltaColor = DeltaColor(d).String()
+}
+
+func WithDeltaColor(color DeltaColor) Option {
+	return deltaColorOption(color)
+}
@@ -0,0 +1,28 @@
+package metric
+
+type DeltaColor string
+
+const (
+	// DeltaColorNormal shows increases in green and decreases in red.
+	DeltaColorNormal DeltaColor = "normal"
+	// DeltaColorInverse shows increases in red and decreases in green.
+	DeltaColorInverse DeltaColor = "inverse"
+	// DeltaColorOff shows the delta without colouring.
+	DeltaColorOff DeltaColor = "off"
+)
+
+func (c DeltaColor) String() string {
+	return string(c)
+}
+
+type DeltaDirection string
+
+const (
+	DeltaDirectionUp   DeltaDirection = "up"
+	DeltaDirectionDown DeltaDirection = "down"
+	DeltaDirectionNone DeltaDirection = "none"
+)
+
+func (d DeltaDirection) String() string {
+	return string(d)
+}
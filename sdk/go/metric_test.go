@@ -0,0 +1,257 @@
+package sourcetool
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/ptrconv"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+	"github.com/trysourcetool/sourcetool-go/metric"
+)
+
+func TestConvertStateToMetricProto(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	metricState := &state.MetricState{
+		ID:             id,
+		Label:          "Revenue",
+		Value:          "1200",
+		Delta:          ptrconv.StringPtr("-5%"),
+		DeltaDirection: metric.DeltaDirectionDown.String(),
+		DeltaColor:     metric.DeltaColorInverse.String(),
+	}
+
+	data := convertStateToMetricProto(metricState)
+
+	if data == nil {
+		t.Fatal("convertStateToMetricProto returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", data.Label, metricState.Label},
+		{"Value", data.Value, metricState.Value},
+		{"Delta", *data.Delta, *metricState.Delta},
+		{"DeltaDirection", data.DeltaDirection, metricState.DeltaDirection},
+		{"DeltaColor", data.DeltaColor, metricState.DeltaColor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertMetricProtoToState(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	data := &widgetv1.Metric{
+		Label:          "Revenue",
+		Value:          "1200",
+		Delta:          ptrconv.StringPtr("+5%"),
+		DeltaDirection: metric.DeltaDirectionUp.String(),
+		DeltaColor:     metric.DeltaColorNormal.String(),
+	}
+
+	state := convertMetricProtoToState(id, data)
+
+	if state == nil {
+		t.Fatal("convertMetricProtoToState returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"ID", state.ID, id},
+		{"Label", state.Label, data.Label},
+		{"Value", state.Value, data.Value},
+		{"Delta", *state.Delta, *data.Delta},
+		{"DeltaDirection", state.DeltaDirection, data.DeltaDirection},
+		{"DeltaColor", state.DeltaColor, data.DeltaColor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMetric(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	builder.Metric("Latency", 250*time.Millisecond,
+		metric.WithDelta(-30*time.Millisecond),
+		metric.WithDeltaColor(metric.DeltaColorInverse),
+	)
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Errorf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	msg := messages[0]
+	if v := msg.GetRenderWidget(); v == nil {
+		t.Fatal("WebSocket message type = nil, want RenderWidget")
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeMetric, []int{0})
+	state := sess.State.GetMetric(widgetID)
+	if state == nil {
+		t.Fatal("Metric state not found")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", state.Label, "Latency"},
+		{"Value", state.Value, "250ms"},
+		{"Delta", ptrconv.StringValue(state.Delta), "-30ms"},
+		{"DeltaDirection", state.DeltaDirection, metric.DeltaDirectionDown.String()},
+		{"DeltaColor", state.DeltaColor, metric.DeltaColorInverse.String()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMetric_DefaultValues(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mock.NewClient(),
+		},
+	}
+
+	builder.Metric("Users", 42)
+
+	widgetID := builder.generatePageID(state.WidgetTypeMetric, []int{0})
+	state := sess.State.GetMetric(widgetID)
+	if state == nil {
+		t.Fatal("Metric state not found")
+	}
+
+	if state.Value != "42" {
+		t.Errorf("Value = %v, want 42", state.Value)
+	}
+	if state.Delta != nil {
+		t.Errorf("Delta = %v, want nil", *state.Delta)
+	}
+	if state.DeltaDirection != metric.DeltaDirectionNone.String() {
+		t.Errorf("DeltaDirection = %v, want %v", state.DeltaDirection, metric.DeltaDirectionNone)
+	}
+	if state.DeltaColor != metric.DeltaColorNormal.String() {
+		t.Errorf("DeltaColor = %v, want %v", state.DeltaColor, metric.DeltaColorNormal)
+	}
+}
+
+func TestFormatMetricValue(t *testing.T) {
+	n := 42
+	f := -1.5
+	var nilInt *int
+
+	tests := []struct {
+		name  string
+		value any
+		want  string
+	}{
+		{"nil", nil, ""},
+		{"string", "$1.2k", "$1.2k"},
+		{"int", 42, "42"},
+		{"float", 3.50, "3.5"},
+		{"float32", float32(0.25), "0.25"},
+		{"duration", 90 * time.Second, "1m30s"},
+		{"pointer to int", &n, "42"},
+		{"pointer to float", &f, "-1.5"},
+		{"nil pointer", nilInt, ""},
+		{"pointer to Stringer", big.NewInt(7), "7"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatMetricValue(tt.value); got != tt.want {
+				t.Errorf("formatMetricValue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMetricDeltaDirection(t *testing.T) {
+	up := 3
+	down := -0.5
+	var nilFloat *float64
+
+	tests := []struct {
+		name  string
+		delta any
+		want  metric.DeltaDirection
+	}{
+		{"nil", nil, metric.DeltaDirectionNone},
+		{"positive int", 3, metric.DeltaDirectionUp},
+		{"negative int", -3, metric.DeltaDirectionDown},
+		{"zero", 0, metric.DeltaDirectionNone},
+		{"unsigned", uint(2), metric.DeltaDirectionUp},
+		{"negative float", -0.5, metric.DeltaDirectionDown},
+		{"duration", time.Second, metric.DeltaDirectionUp},
+		{"positive string", "+12%", metric.DeltaDirectionUp},
+		{"negative string", "-12%", metric.DeltaDirectionDown},
+		{"empty string", "", metric.DeltaDirectionNone},
+		{"pointer to int", &up, metric.DeltaDirectionUp},
+		{"pointer to float", &down, metric.DeltaDirectionDown},
+		{"nil pointer", nilFloat, metric.DeltaDirectionNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := metricDeltaDirection(tt.delta); got != tt.want {
+				t.Errorf("metricDeltaDirection() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -52,3 +52,17 @@ func (s *pageManager) getPage(id uuid.UUID) *page {
 	defer s.mu.RUnlock()
 	return s.pages[id]
 }
+
+func (s *pageManager) getPageByRoute(route string) *page {
+	if s == nil {
+		return nil
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, p := range s.pages {
+		if p.route == route {
+			return p
+		}
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package sourcetool
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/gofrs/uuid/v5"
+
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+)
+
+func (b *uiBuilder) PageLink(label, route string, params map[string]string) {
+	sess := b.session
+	if sess == nil {
+		return
+	}
+	page := b.page
+	if page == nil {
+		return
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return
+	}
+	path := cursor.getPath()
+
+	// A link to an unknown route is rendered disabled rather than skipped,
+	// so later widgets keep their place.
+	target := b.runtime.pageManager.getPageByRoute(normalizeRoute(route))
+
+	query := url.Values{}
+	for k, v := range params {
+		query.Set(k, v)
+	}
+
+	widgetID := b.generatePageID(state.WidgetTypePageLink, path)
+	pageLinkState := sess.State.GetPageLink(widgetID)
+	if pageLinkState == nil {
+		pageLinkState = &state.PageLinkState{
+			ID: widgetID,
+		}
+	}
+	pageLinkState.Label = label
+	pageLinkState.Query = query.Encode()
+	if target != nil {
+		pageLinkState.PageID = target.id
+		pageLinkState.Route = target.route
+		pageLinkState.Disabled = false
+	} else {
+		pageLinkState.PageID = uuid.Nil
+		pageLinkState.Route = normalizeRoute(route)
+		pageLinkState.Disabled = true
+	}
+	sess.State.Set(widgetID, pageLinkState)
+
+	pageLinkProto := convertStateToPageLinkProto(pageLinkState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_PageLink{
+				PageLink: pageLinkProto,
+			},
+		},
+	})
+
+	cursor.next()
+}
+
+// normalizeRoute brings a route into the form router.Page stores it in:
+// a leading slash and no trailing slash.
+func normalizeRoute(route string) string {
+	if !strings.HasPrefix(route, "/") {
+		route = "/" + route
+	}
+	if route == "/" {
+		return route
+	}
+	return strings.TrimSuffix(route, "/")
+}
+
+func convertStateToPageLinkProto(state *state.PageLinkState) *widgetv1.PageLink {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.PageLink{
+		Label:    state.Label,
+		PageId:   state.PageID.String(),
+		Route:    state.Route,
+		Query:    state.Query,
+		Disabled: state.Disabled,
+	}
+}
+
+func convertPageLinkProtoToState(id uuid.UUID, data *widgetv1.PageLink) (*state.PageLinkState, error) {
+	if data == nil {
+		return nil, nil
+	}
+	pageID, err := uuid.FromString(data.PageId)
+	if err != nil {
+		return nil, err
+	}
+	return &state.PageLinkState{
+		ID:       id,
+		Label:    data.Label,
+		PageID:   pageID,
+		Route:    data.Route,
+		Query:    data.Query,
+		Disabled: data.Disabled,
+	}, nil
+}
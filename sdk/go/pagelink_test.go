@@ -0,0 +1,218 @@
+package sourcetool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+)
+
+func TestConvertStateToPageLinkProto(t *testing.T) {
+	pageLinkState := &state.PageLinkState{
+		ID:       uuid.Must(uuid.NewV4()),
+		Label:    "Open",
+		PageID:   uuid.Must(uuid.NewV4()),
+		Route:    "/users/detail",
+		Query:    "id=42",
+		Disabled: true,
+	}
+
+	data := convertStateToPageLinkProto(pageLinkState)
+
+	if data == nil {
+		t.Fatal("convertStateToPageLinkProto returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", data.Label, pageLinkState.Label},
+		{"PageId", data.PageId, pageLinkState.PageID.String()},
+		{"Route", data.Route, pageLinkState.Route},
+		{"Query", data.Query, pageLinkState.Query},
+		{"Disabled", data.Disabled, pageLinkState.Disabled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertPageLinkProtoToState(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	data := &widgetv1.PageLink{
+		Label:    "Open",
+		PageId:   pageID.String(),
+		Route:    "/users/detail",
+		Query:    "id=42",
+		Disabled: true,
+	}
+
+	state, err := convertPageLinkProtoToState(id, data)
+	if err != nil {
+		t.Fatalf("convertPageLinkProtoToState returned error: %v", err)
+	}
+	if state == nil {
+		t.Fatal("convertPageLinkProtoToState returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"ID", state.ID, id},
+		{"Label", state.Label, data.Label},
+		{"PageID", state.PageID, pageID},
+		{"Route", state.Route, data.Route},
+		{"Query", state.Query, data.Query},
+		{"Disabled", state.Disabled, data.Disabled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+
+	if _, err := convertPageLinkProtoToState(id, &widgetv1.PageLink{PageId: "invalid"}); err == nil {
+		t.Error("convertPageLinkProtoToState with invalid page id returned nil error")
+	}
+}
+
+func TestPageLink(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	detailPageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+			pageManager: newPageManager(map[uuid.UUID]*page{
+				pageID:       {id: pageID, route: "/users"},
+				detailPageID: {id: detailPageID, route: "/users/detail"},
+			}),
+		},
+	}
+
+	builder.PageLink("Alice", "users/detail/", map[string]string{"id": "42", "tab": "orders"})
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Errorf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	msg := messages[0]
+	if v := msg.GetRenderWidget(); v == nil {
+		t.Fatal("WebSocket message type = nil, want RenderWidget")
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypePageLink, []int{0})
+	state := sess.State.GetPageLink(widgetID)
+	if state == nil {
+		t.Fatal("PageLink state not found")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", state.Label, "Alice"},
+		{"PageID", state.PageID, detailPageID},
+		{"Route", state.Route, "/users/detail"},
+		{"Query", state.Query, "id=42&tab=orders"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPageLink_UnknownRoute(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient:    mockWS,
+			pageManager: newPageManager(map[uuid.UUID]*page{pageID: {id: pageID, route: "/users"}}),
+		},
+	}
+
+	builder.PageLink("Missing", "does-not-exist", nil)
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Fatalf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	pageLinkProto := messages[0].GetRenderWidget().GetWidget().GetPageLink()
+	if pageLinkProto == nil {
+		t.Fatal("RenderWidget page link = nil")
+	}
+	if !pageLinkProto.Disabled {
+		t.Error("Disabled = false, want true")
+	}
+	if pageLinkProto.Route != "/does-not-exist" {
+		t.Errorf("Route = %q, want %q", pageLinkProto.Route, "/does-not-exist")
+	}
+	if builder.cursor.index != 1 {
+		t.Errorf("cursor index = %d, want 1", builder.cursor.index)
+	}
+}
+
+func TestNormalizeRoute(t *testing.T) {
+	tests := []struct {
+		route string
+		want  string
+	}{
+		{"/users", "/users"},
+		{"users", "/users"},
+		{"/users/", "/users"},
+		{"/", "/"},
+		{"", "/"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.route, func(t *testing.T) {
+			if got := normalizeRoute(tt.route); got != tt.want {
+				t.Errorf("normalizeRoute(%q) = %q, want %q", tt.route, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package sourcetool
+
+import (
+	"sync"
+
+	"github.com/gofrs/uuid/v5"
+
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+)
+
+// Progress is a progress bar that can be updated while the page handler is
+// still running. Each call to Set re-renders the bar immediately.
+type Progress struct {
+	builder *uiBuilder
+	id      uuid.UUID
+	path    path
+	mu      sync.Mutex
+}
+
+func (b *uiBuilder) Progress(label string) *Progress {
+	sess := b.session
+	if sess == nil {
+		return nil
+	}
+	page := b.page
+	if page == nil {
+		return nil
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return nil
+	}
+	path := cursor.getPath()
+
+	widgetID := b.generatePageID(state.WidgetTypeProgress, path)
+	progressState := &state.ProgressState{
+		ID:    widgetID,
+		Label: label,
+		Value: 0,
+		Text:  "",
+	}
+
+	p := &Progress{
+		builder: b,
+		id:      widgetID,
+		path:    path,
+	}
+	p.render(progressState)
+
+	cursor.next()
+
+	return p
+}
+
+// Set updates the progress bar to fraction, clamped to the range [0, 1],
+// and shows text below it.
+func (p *Progress) Set(fraction float64, text string) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	progressState := p.builder.session.State.GetProgress(p.id)
+	if progressState == nil {
+		return
+	}
+	progressState.Value = min(max(fraction, 0), 1)
+	progressState.Text = text
+	p.render(progressState)
+}
+
+func (p *Progress) render(progressState *state.ProgressState) {
+	b := p.builder
+	b.session.State.Set(p.id, progressState)
+
+	progress := convertStateToProgressProto(progressState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: b.session.ID.String(),
+		PageId:    b.page.id.String(),
+		Path:      convertPathToInt32Slice(p.path),
+		Widget: &widgetv1.Widget{
+			Id: p.id.String(),
+			Type: &widgetv1.Widget_Progress{
+				Progress: progress,
+			},
+		},
+	})
+}
+
+func convertStateToProgressProto(state *state.ProgressState) *widgetv1.Progress {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.Progress{
+		Label: state.Label,
+		Value: state.Value,
+		Text:  state.Text,
+	}
+}
+
+func convertProgressProtoToState(id uuid.UUID, data *widgetv1.Progress) *state.ProgressState {
+	if data == nil {
+		return nil
+	}
+	return &state.ProgressState{
+		ID:    id,
+		Label: data.Label,
+		Value: data.Value,
+		Text:  data.Text,
+	}
+}
@@ -0,0 +1,165 @@
+package sourcetool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+)
+
+func TestConvertStateToProgressProto(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	progressState := &state.ProgressState{
+		ID:    id,
+		Label: "Migrating",
+		Value: 0.5,
+		Text:  "50 of 100",
+	}
+
+	data := convertStateToProgressProto(progressState)
+
+	if data == nil {
+		t.Fatal("convertStateToProgressProto returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", data.Label, progressState.Label},
+		{"Value", data.Value, progressState.Value},
+		{"Text", data.Text, progressState.Text},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertProgressProtoToState(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	data := &widgetv1.Progress{
+		Label: "Migrating",
+		Value: 0.5,
+		Text:  "50 of 100",
+	}
+
+	state := convertProgressProtoToState(id, data)
+
+	if state == nil {
+		t.Fatal("convertProgressProtoToState returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"ID", state.ID, id},
+		{"Label", state.Label, data.Label},
+		{"Value", state.Value, data.Value},
+		{"Text", state.Text, data.Text},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProgress(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	progress := builder.Progress("Migrating")
+	if progress == nil {
+		t.Fatal("Progress returned nil")
+	}
+	builder.Markdown("after progress")
+
+	progress.Set(0.5, "50 of 100")
+	progress.Set(1.5, "done")
+
+	messages := mockWS.Messages()
+	if len(messages) != 4 {
+		t.Fatalf("WebSocket messages count = %d, want 4", len(messages))
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeProgress, []int{0})
+	wantValues := []float64{0, 0.5, 1}
+	var progressMessages int
+	for _, msg := range messages {
+		renderWidget := msg.GetRenderWidget()
+		if renderWidget == nil {
+			t.Fatal("WebSocket message type = nil, want RenderWidget")
+		}
+		p := renderWidget.Widget.GetProgress()
+		if p == nil {
+			continue
+		}
+		if renderWidget.Widget.Id != widgetID.String() {
+			t.Errorf("Progress widget ID = %v, want %v", renderWidget.Widget.Id, widgetID)
+		}
+		if p.Value != wantValues[progressMessages] {
+			t.Errorf("Progress value[%d] = %v, want %v", progressMessages, p.Value, wantValues[progressMessages])
+		}
+		progressMessages++
+	}
+	if progressMessages != len(wantValues) {
+		t.Errorf("Progress messages count = %d, want %d", progressMessages, len(wantValues))
+	}
+
+	state := sess.State.GetProgress(widgetID)
+	if state == nil {
+		t.Fatal("Progress state not found")
+	}
+	if state.Value != 1 {
+		t.Errorf("Value = %v, want 1", state.Value)
+	}
+	if state.Text != "done" {
+		t.Errorf("Text = %v, want done", state.Text)
+	}
+}
+
+func TestProgress_NilSession(t *testing.T) {
+	builder := &uiBuilder{
+		context: context.Background(),
+		cursor:  newCursor(),
+	}
+
+	progress := builder.Progress("Migrating")
+	if progress != nil {
+		t.Fatal("Progress returned non-nil without a session")
+	}
+
+	// Set on a nil progress is a no-op
+	progress.Set(0.5, "half")
+}
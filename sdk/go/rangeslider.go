@@ -0,0 +1,129 @@
+package sourcetool
+
+import (
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/rangeslider"
+)
+
+func (b *uiBuilder) RangeSlider(label string, opts ...rangeslider.Option) (float64, float64) {
+	rangeSliderOpts := &options.RangeSliderOptions{
+		Label:       label,
+		DefaultLow:  nil,
+		DefaultHigh: nil,
+		MinValue:    0,
+		MaxValue:    100,
+		Step:        1,
+		Disabled:    false,
+	}
+
+	for _, o := range opts {
+		o.Apply(rangeSliderOpts)
+	}
+
+	minValue, maxValue, step := normalizeSliderBounds(rangeSliderOpts.MinValue, rangeSliderOpts.MaxValue, rangeSliderOpts.Step)
+	defaultLow, defaultHigh := minValue, maxValue
+	if rangeSliderOpts.DefaultLow != nil && rangeSliderOpts.DefaultHigh != nil {
+		defaultLow, defaultHigh = normalizeRangeSliderValue(*rangeSliderOpts.DefaultLow, *rangeSliderOpts.DefaultHigh, minValue, maxValue)
+	}
+
+	sess := b.session
+	if sess == nil {
+		return defaultLow, defaultHigh
+	}
+	page := b.page
+	if page == nil {
+		return defaultLow, defaultHigh
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return defaultLow, defaultHigh
+	}
+	path := cursor.getPath()
+
+	widgetID := b.generatePageID(state.WidgetTypeRangeSlider, path)
+	rangeSliderState := sess.State.GetRangeSlider(widgetID)
+	if rangeSliderState == nil {
+		rangeSliderState = &state.RangeSliderState{
+			ID:   widgetID,
+			Low:  defaultLow,
+			High: defaultHigh,
+		}
+	}
+	rangeSliderState.Low, rangeSliderState.High = normalizeRangeSliderValue(rangeSliderState.Low, rangeSliderState.High, minValue, maxValue)
+	rangeSliderState.Label = rangeSliderOpts.Label
+	rangeSliderState.DefaultLow = defaultLow
+	rangeSliderState.DefaultHigh = defaultHigh
+	rangeSliderState.MinValue = minValue
+	rangeSliderState.MaxValue = maxValue
+	rangeSliderState.Step = step
+	rangeSliderState.Disabled = rangeSliderOpts.Disabled
+	sess.State.Set(widgetID, rangeSliderState)
+
+	rangeSlider := convertStateToRangeSliderProto(rangeSliderState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_RangeSlider{
+				RangeSlider: rangeSlider,
+			},
+		},
+	})
+
+	cursor.next()
+
+	return rangeSliderState.Low, rangeSliderState.High
+}
+
+// normalizeRangeSliderValue clamps both ends of a range into the slider
+// bounds and orders them so that low <= high.
+func normalizeRangeSliderValue(low, high, minValue, maxValue float64) (float64, float64) {
+	low = clampSliderValue(low, minValue, maxValue)
+	high = clampSliderValue(high, minValue, maxValue)
+	if high < low {
+		low, high = high, low
+	}
+	return low, high
+}
+
+func convertStateToRangeSliderProto(state *state.RangeSliderState) *widgetv1.RangeSlider {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.RangeSlider{
+		Low:         state.Low,
+		High:        state.High,
+		Label:       state.Label,
+		DefaultLow:  state.DefaultLow,
+		DefaultHigh: state.DefaultHigh,
+		MinValue:    state.MinValue,
+		MaxValue:    state.MaxValue,
+		Step:        state.Step,
+		Disabled:    state.Disabled,
+	}
+}
+
+func convertRangeSliderProtoToState(id uuid.UUID, data *widgetv1.RangeSlider) *state.RangeSliderState {
+	if data == nil {
+		return nil
+	}
+	return &state.RangeSliderState{
+		ID:          id,
+		Low:         data.Low,
+		High:        data.High,
+		Label:       data.Label,
+		DefaultLow:  data.DefaultLow,
+		DefaultHigh: data.DefaultHigh,
+		MinValue:    data.MinValue,
+		MaxValue:    data.MaxValue,
+		Step:        data.Step,
+		Disabled:    data.Disabled,
+	}
+}
@@ -0,0 +1,58 @@
+package rangeslider
+
+import "github.com/trysourcetool/sourcetool-go/internal/options"
+
+type Option interface {
+	Apply(*options.RangeSliderOptions)
+}
+
+type defaultValueOption [2]float64
+
+func (d defaultValueOption) Apply(opts *options.RangeSliderOptions) {
+	opts.DefaultLow = &d[0]
+	opts.DefaultHigh = &d[1]
+}
+
+func WithDefaultValue(low, high float64) Option {
+	return defaultValueOption{low, high}
+}
+
+type minValueOption float64
+
+func (m minValueOption) Apply(opts *options.RangeSliderOptions) {
+	opts.MinValue = float64(m)
+}
+
+func WithMinValue(value float64) Option {
+	return minValueOption(value)
+}
+
+type maxValueOption float64
+
+func (m maxValueOption) Apply(opts *options.RangeSliderOptions) {
+	opts.MaxValue = float64(m)
+}
+
+func WithMaxValue(value float64) Option {
+	return maxValueOption(value)
+}
+
+type stepOption float64
+
+func (s stepOption) Apply(opts *options.RangeSliderOptions) {
+	opts.Step = float64(s)
+}
+
+func WithStep(step float64) Option {
+	return stepOption(step)
+}
+
+type disabledOption bool
+
+func (d disabledOption) Apply(opts *options.RangeSliderOptions) {
+	opts.Disabled = bool(d)
+}
+
+func WithDisabled(disabled bool) Option {
+	return disabledOption(disabled)
+}
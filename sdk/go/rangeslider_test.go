@@ -0,0 +1,214 @@
+package sourcetool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+	"github.com/trysourcetool/sourcetool-go/rangeslider"
+)
+
+func TestConvertStateToRangeSliderProto(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	rangeSliderState := &state.RangeSliderState{
+		ID:          id,
+		Low:         20,
+		High:        80,
+		Label:       "Price",
+		DefaultLow:  10,
+		DefaultHigh: 90,
+		MinValue:    0,
+		MaxValue:    100,
+		Step:        10,
+		Disabled:    true,
+	}
+
+	data := convertStateToRangeSliderProto(rangeSliderState)
+
+	if data == nil {
+		t.Fatal("convertStateToRangeSliderProto returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Low", data.Low, rangeSliderState.Low},
+		{"High", data.High, rangeSliderState.High},
+		{"Label", data.Label, rangeSliderState.Label},
+		{"DefaultLow", data.DefaultLow, rangeSliderState.DefaultLow},
+		{"DefaultHigh", data.DefaultHigh, rangeSliderState.DefaultHigh},
+		{"MinValue", data.MinValue, rangeSliderState.MinValue},
+		{"MaxValue", data.MaxValue, rangeSliderState.MaxValue},
+		{"Step", data.Step, rangeSliderState.Step},
+		{"Disabled", data.Disabled, rangeSliderState.Disabled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertRangeSliderProtoToState(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	data := &widgetv1.RangeSlider{
+		Low:         20,
+		High:        80,
+		Label:       "Price",
+		DefaultLow:  10,
+		DefaultHigh: 90,
+		MinValue:    0,
+		MaxValue:    100,
+		Step:        10,
+		Disabled:    true,
+	}
+
+	state := convertRangeSliderProtoToState(id, data)
+
+	if state == nil {
+		t.Fatal("convertRangeSliderProtoToState returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"ID", state.ID, id},
+		{"Low", state.Low, data.Low},
+		{"High", state.High, data.High},
+		{"Label", state.Label, data.Label},
+		{"DefaultLow", state.DefaultLow, data.DefaultLow},
+		{"DefaultHigh", state.DefaultHigh, data.DefaultHigh},
+		{"MinValue", state.MinValue, data.MinValue},
+		{"MaxValue", state.MaxValue, data.MaxValue},
+		{"Step", state.Step, data.Step},
+		{"Disabled", state.Disabled, data.Disabled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRangeSlider(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	label := "Price"
+	low, high := builder.RangeSlider(label,
+		rangeslider.WithMinValue(0),
+		rangeslider.WithMaxValue(1000),
+		rangeslider.WithStep(50),
+		rangeslider.WithDefaultValue(800, 200),
+	)
+
+	if low != 200 || high != 800 {
+		t.Errorf("RangeSlider value = (%v, %v), want (200, 800)", low, high)
+	}
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Errorf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	msg := messages[0]
+	if v := msg.GetRenderWidget(); v == nil {
+		t.Fatal("WebSocket message type = nil, want RenderWidget")
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeRangeSlider, []int{0})
+	state := sess.State.GetRangeSlider(widgetID)
+	if state == nil {
+		t.Fatal("RangeSlider state not found")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", state.Label, label},
+		{"Low", state.Low, float64(200)},
+		{"High", state.High, float64(800)},
+		{"DefaultLow", state.DefaultLow, float64(200)},
+		{"DefaultHigh", state.DefaultHigh, float64(800)},
+		{"MinValue", state.MinValue, float64(0)},
+		{"MaxValue", state.MaxValue, float64(1000)},
+		{"Step", state.Step, float64(50)},
+		{"Disabled", state.Disabled, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRangeSlider_DefaultValues(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mock.NewClient(),
+		},
+	}
+
+	low, high := builder.RangeSlider("Percent")
+	if low != 0 || high != 100 {
+		t.Errorf("RangeSlider value = (%v, %v), want (0, 100)", low, high)
+	}
+
+	// Values from the client outside the bounds are clamped
+	widgetID := builder.generatePageID(state.WidgetTypeRangeSlider, []int{0})
+	sess.State.Set(widgetID, &state.RangeSliderState{
+		ID:   widgetID,
+		Low:  -10,
+		High: 120,
+	})
+	builder.cursor = newCursor()
+
+	low, high = builder.RangeSlider("Percent")
+	if low != 0 || high != 100 {
+		t.Errorf("RangeSlider clamped value = (%v, %v), want (0, 100)", low, high)
+	}
+}
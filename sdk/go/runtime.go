@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
 	"time"
 
 	"github.com/gofrs/uuid/v5"
@@ -17,6 +18,7 @@ import (
 	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
 	"github.com/trysourcetool/sourcetool-go/internal/ptrconv"
 	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
 	"github.com/trysourcetool/sourcetool-go/internal/websocket"
 )
 
@@ -68,6 +70,16 @@ func startRuntime(apiKey, endpoint string, pages map[uuid.UUID]*page) (*runtime,
 				r.sendException(msg.Id, t.CloseSession.SessionId, err)
 			}
 			return nil
+		case *websocketv1.Message_UploadFileChunk:
+			if err := r.handleUploadFileChunk(t.UploadFileChunk); err != nil {
+				r.sendException(msg.Id, t.UploadFileChunk.SessionId, err)
+			}
+			return nil
+		case *websocketv1.Message_DownloadFile:
+			if err := r.handleDownloadFile(t.DownloadFile); err != nil {
+				r.sendException(msg.Id, t.DownloadFile.SessionId, err)
+			}
+			return nil
 		default:
 			return fmt.Errorf("unknown message type: %T", t)
 		}
@@ -122,7 +134,13 @@ func (r *runtime) handleInitializeClient(msg *websocketv1.InitializeClient) erro
 		return errdefs.ErrInvalidParameter(err)
 	}
 
+	query, err := url.ParseQuery(msg.Query)
+	if err != nil {
+		return errdefs.ErrInvalidParameter(err)
+	}
+
 	session := session.New(sessionID, pageID)
+	session.SetQuery(query)
 	r.sessionManager.SetSession(session)
 
 	page := r.pageManager.getPage(pageID)
@@ -174,6 +192,12 @@ func (r *runtime) handleRerunPage(msg *websocketv1.RerunPage) error {
 		return errdefs.ErrPageNotFound(fmt.Errorf("page not found: %s", pageID))
 	}
 
+	query, err := url.ParseQuery(msg.Query)
+	if err != nil {
+		return errdefs.ErrInvalidParameter(err)
+	}
+	sess.SetQuery(query)
+
 	if sess.PageID != pageID {
 		sess.State.ResetStates()
 	}
@@ -231,6 +255,72 @@ func (r *runtime) handleRerunPage(msg *websocketv1.RerunPage) error {
 			newWidgetStates[id] = convertRadioProtoToState(id, t.Radio)
 		case *widgetv1.Widget_TextArea:
 			newWidgetStates[id] = convertTextAreaProtoToState(id, t.TextArea)
+		case *widgetv1.Widget_FileInput:
+			state, err := convertFileInputProtoToState(id, t.FileInput)
+			if err != nil {
+				return errdefs.ErrInvalidParameter(err)
+			}
+			newWidgetStates[id] = state
+		case *widgetv1.Widget_DownloadButton:
+			newWidgetStates[id] = convertDownloadButtonProtoToState(id, t.DownloadButton)
+		case *widgetv1.Widget_Chart:
+			newWidgetStates[id] = convertChartProtoToState(id, t.Chart)
+		case *widgetv1.Widget_Tabs:
+			newWidgetStates[id] = convertTabsProtoToState(id, t.Tabs)
+		case *widgetv1.Widget_TabItem:
+			newWidgetStates[id] = convertTabItemProtoToState(id, t.TabItem)
+		case *widgetv1.Widget_Expander:
+			newWidgetStates[id] = convertExpanderProtoToState(id, t.Expander)
+		case *widgetv1.Widget_Dialog:
+			newWidgetStates[id] = convertDialogProtoToState(id, t.Dialog)
+		case *widgetv1.Widget_Alert:
+			newWidgetStates[id] = convertAlertProtoToState(id, t.Alert)
+		case *widgetv1.Widget_Metric:
+			newWidgetStates[id] = convertMetricProtoToState(id, t.Metric)
+		case *widgetv1.Widget_Progress:
+			newWidgetStates[id] = convertProgressProtoToState(id, t.Progress)
+		case *widgetv1.Widget_Spinner:
+			newWidgetStates[id] = convertSpinnerProtoToState(id, t.Spinner)
+		case *widgetv1.Widget_Slider:
+			newWidgetStates[id] = convertSliderProtoToState(id, t.Slider)
+		case *widgetv1.Widget_RangeSlider:
+			newWidgetStates[id] = convertRangeSliderProtoToState(id, t.RangeSlider)
+		case *widgetv1.Widget_Toggle:
+			newWidgetStates[id] = convertToggleProtoToState(id, t.Toggle)
+		case *widgetv1.Widget_DateRangeInput:
+			state, err := convertDateRangeInputProtoToState(id, t.DateRangeInput, time.Local)
+			if err != nil {
+				return errdefs.ErrInvalidParameter(err)
+			}
+			newWidgetStates[id] = state
+		case *widgetv1.Widget_Json:
+			newWidgetStates[id] = convertJSONProtoToState(id, t.Json)
+		case *widgetv1.Widget_CodeEditor:
+			newWidgetStates[id] = convertCodeEditorProtoToState(id, t.CodeEditor)
+		case *widgetv1.Widget_Image:
+			newWidgetStates[id] = convertImageProtoToState(id, t.Image)
+		case *widgetv1.Widget_Link:
+			newWidgetStates[id] = convertLinkProtoToState(id, t.Link)
+		case *widgetv1.Widget_PageLink:
+			state, err := convertPageLinkProtoToState(id, t.PageLink)
+			if err != nil {
+				return errdefs.ErrInvalidParameter(err)
+			}
+			newWidgetStates[id] = state
+		case *widgetv1.Widget_Header:
+			newWidgetStates[id] = convertHeaderProtoToState(id, t.Header)
+		case *widgetv1.Widget_Subheader:
+			newWidgetStates[id] = convertSubheaderProtoToState(id, t.Subheader)
+		case *widgetv1.Widget_Caption:
+			newWidgetStates[id] = convertCaptionProtoToState(id, t.Caption)
+		case *widgetv1.Widget_Divider:
+			newWidgetStates[id] = convertDividerProtoToState(id, t.Divider)
+		case *widgetv1.Widget_Spacer:
+			newWidgetStates[id] = convertSpacerProtoToState(id, t.Spacer)
+		case *widgetv1.Widget_TagInput:
+			newWidgetStates[id] = convertTagInputProtoToState(id, t.TagInput)
+		case *widgetv1.Widget_ColorInput:
+			newWidgetStates[id] = convertColorInputProtoToState(id, t.ColorInput)
 		default:
 			return errdefs.ErrInvalidParameter(fmt.Errorf("unknown widget type: %T", t))
 		}
@@ -276,6 +366,106 @@ func (r *runtime) handleCloseSession(msg *websocketv1.CloseSession) error {
 	return nil
 }
 
+func (r *runtime) handleUploadFileChunk(msg *websocketv1.UploadFileChunk) error {
+	sessionID, err := uuid.FromString(msg.SessionId)
+	if err != nil {
+		return errdefs.ErrInvalidParameter(err)
+	}
+	sess := r.sessionManager.GetSession(sessionID)
+	if sess == nil {
+		return errdefs.ErrSessionNotFound(fmt.Errorf("session not found: %s", sessionID))
+	}
+
+	widgetID, err := uuid.FromString(msg.WidgetId)
+	if err != nil {
+		return errdefs.ErrInvalidParameter(err)
+	}
+	fileID, err := uuid.FromString(msg.FileId)
+	if err != nil {
+		return errdefs.ErrInvalidParameter(err)
+	}
+
+	fileInputState := sess.State.GetFileInput(widgetID)
+	if fileInputState == nil {
+		return errdefs.ErrInvalidParameter(fmt.Errorf("file input not found: %s", widgetID))
+	}
+	if fileInputState.Disabled {
+		return errdefs.ErrInvalidParameter(fmt.Errorf("file input is disabled: %s", widgetID))
+	}
+	if msg.Size < 0 || msg.Offset < 0 {
+		return errdefs.ErrInvalidParameter(fmt.Errorf("invalid size %d or offset %d for file %q", msg.Size, msg.Offset, msg.Name))
+	}
+	maxFileSize := defaultMaxFileSize
+	if fileInputState.MaxFileSize != nil {
+		maxFileSize = *fileInputState.MaxFileSize
+	}
+	if msg.Size > maxFileSize {
+		return errdefs.ErrInvalidParameter(fmt.Errorf("file %q exceeds the maximum size of %d bytes", msg.Name, maxFileSize))
+	}
+	if !acceptsFile(fileInputState.Accept, msg.Name, msg.MimeType) {
+		return errdefs.ErrInvalidParameter(fmt.Errorf("file type of %q is not accepted", msg.Name))
+	}
+
+	if err := sess.Uploads.AppendChunk(fileID, widgetID, msg.Name, msg.MimeType, msg.Size, msg.Offset, msg.Data); err != nil {
+		return errdefs.ErrInvalidParameter(err)
+	}
+
+	return nil
+}
+
+func (r *runtime) handleDownloadFile(msg *websocketv1.DownloadFile) error {
+	sessionID, err := uuid.FromString(msg.SessionId)
+	if err != nil {
+		return errdefs.ErrInvalidParameter(err)
+	}
+	sess := r.sessionManager.GetSession(sessionID)
+	if sess == nil {
+		return errdefs.ErrSessionNotFound(fmt.Errorf("session not found: %s", sessionID))
+	}
+
+	widgetID, err := uuid.FromString(msg.WidgetId)
+	if err != nil {
+		return errdefs.ErrInvalidParameter(err)
+	}
+
+	// Download buttons and images backed by bytes both send their data
+	// lazily through DownloadFileChunk messages.
+	var data []byte
+	var fileName, mimeType string
+	switch widgetState := sess.State.Get(widgetID).(type) {
+	case *state.DownloadButtonState:
+		if widgetState.Disabled {
+			return errdefs.ErrInvalidParameter(fmt.Errorf("download button is disabled: %s", widgetID))
+		}
+		data, fileName, mimeType = widgetState.Data, widgetState.FileName, widgetState.MimeType
+	case *state.ImageState:
+		if widgetState.URL != "" {
+			return errdefs.ErrInvalidParameter(fmt.Errorf("image has no data: %s", widgetID))
+		}
+		data, mimeType = widgetState.Data, widgetState.MimeType
+	default:
+		return errdefs.ErrInvalidParameter(fmt.Errorf("no downloadable widget found: %s", widgetID))
+	}
+
+	size := int64(len(data))
+	var offset int64
+	for _, chunk := range splitDownloadChunks(data) {
+		r.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.DownloadFileChunk{
+			SessionId: sessionID.String(),
+			PageId:    msg.PageId,
+			WidgetId:  widgetID.String(),
+			FileName:  fileName,
+			MimeType:  mimeType,
+			Size:      size,
+			Offset:    offset,
+			Data:      chunk,
+		})
+		offset += int64(len(chunk))
+	}
+
+	return nil
+}
+
 func (r *runtime) sendException(id, sessionID string, err error) {
 	e, ok := err.(*errdefs.Error)
 	if !ok {
@@ -1,13 +1,17 @@
 package sourcetool
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/gofrs/uuid/v5"
 
+	"github.com/trysourcetool/sourcetool-go/codeeditor"
 	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
 	"github.com/trysourcetool/sourcetool-go/internal/ptrconv"
 	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
 	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
 )
 
@@ -125,6 +129,111 @@ func TestRuntime_HandleRerunPage(t *testing.T) {
 	}
 }
 
+func TestRuntime_HandleRerunPage_CodeEditorState(t *testing.T) {
+	pages := make(map[uuid.UUID]*page)
+	pageID := uuid.Must(uuid.NewV4())
+	sessionID := uuid.Must(uuid.NewV4())
+
+	// Test page handler
+	var got string
+	testPage := &page{
+		id:   pageID,
+		name: "Test Page",
+		handler: func(ui UIBuilder) error {
+			got = ui.CodeEditor("Query", codeeditor.WithLanguage(codeeditor.LanguageSQL))
+			return nil
+		},
+	}
+	pages[pageID] = testPage
+
+	mockClient := mock.NewClient()
+	r := &runtime{
+		wsClient:       mockClient,
+		sessionManager: session.NewSessionManager(),
+		pageManager:    newPageManager(pages),
+	}
+
+	// Initialize session
+	sess := session.New(sessionID, pageID)
+	r.sessionManager.SetSession(sess)
+
+	// Create test message carrying the edited value
+	widgetID := (&uiBuilder{page: testPage}).generatePageID(state.WidgetTypeCodeEditor, []int{0})
+	rerunPage := &websocketv1.RerunPage{
+		SessionId: sessionID.String(),
+		PageId:    pageID.String(),
+		States: []*widgetv1.Widget{
+			{
+				Id: widgetID.String(),
+				Type: &widgetv1.Widget_CodeEditor{
+					CodeEditor: &widgetv1.CodeEditor{
+						Value:    ptrconv.StringPtr("SELECT 42"),
+						Language: codeeditor.LanguageSQL.String(),
+					},
+				},
+			},
+		},
+	}
+
+	if err := r.handleRerunPage(rerunPage); err != nil {
+		t.Fatalf("handleRerunPage() error = %v", err)
+	}
+
+	if got != "SELECT 42" {
+		t.Errorf("CodeEditor value = %q, want %q", got, "SELECT 42")
+	}
+}
+
+func TestRuntime_HandleRerunPage_Query(t *testing.T) {
+	pages := make(map[uuid.UUID]*page)
+	pageID := uuid.Must(uuid.NewV4())
+	sessionID := uuid.Must(uuid.NewV4())
+
+	// Test page handler
+	var got string
+	testPage := &page{
+		id:   pageID,
+		name: "Test Page",
+		handler: func(ui UIBuilder) error {
+			got = ui.QueryParams().Get("id")
+			return nil
+		},
+	}
+	pages[pageID] = testPage
+
+	mockClient := mock.NewClient()
+	r := &runtime{
+		wsClient:       mockClient,
+		sessionManager: session.NewSessionManager(),
+		pageManager:    newPageManager(pages),
+	}
+
+	// Initialize session
+	sess := session.New(sessionID, pageID)
+	r.sessionManager.SetSession(sess)
+
+	if err := r.handleRerunPage(&websocketv1.RerunPage{
+		SessionId: sessionID.String(),
+		PageId:    pageID.String(),
+		Query:     "id=42",
+	}); err != nil {
+		t.Fatalf("handleRerunPage() error = %v", err)
+	}
+
+	if got != "42" {
+		t.Errorf("QueryParams().Get(\"id\") = %q, want %q", got, "42")
+	}
+
+	// Malformed queries are rejected
+	if err := r.handleRerunPage(&websocketv1.RerunPage{
+		SessionId: sessionID.String(),
+		PageId:    pageID.String(),
+		Query:     "id=%zz",
+	}); err == nil {
+		t.Error("handleRerunPage with malformed query returned nil error")
+	}
+}
+
 func TestRuntime_HandleCloseSession(t *testing.T) {
 	sessionID := uuid.Must(uuid.NewV4())
 	pageID := uuid.Must(uuid.NewV4())
@@ -162,3 +271,181 @@ func TestRuntime_HandleCloseSession(t *testing.T) {
 		t.Error("session was not deleted")
 	}
 }
+
+func TestRuntime_HandleDownloadFile(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	widgetID := uuid.Must(uuid.NewV4())
+
+	mockClient := mock.NewClient()
+	r := &runtime{
+		wsClient:       mockClient,
+		sessionManager: session.NewSessionManager(),
+		pageManager:    newPageManager(make(map[uuid.UUID]*page)),
+	}
+
+	// Initialize session with a download button
+	sess := session.New(sessionID, pageID)
+	data := bytes.Repeat([]byte{'x'}, downloadChunkSize+10)
+	sess.State.Set(widgetID, &state.DownloadButtonState{
+		ID:       widgetID,
+		FileName: "data.bin",
+		MimeType: "application/octet-stream",
+		Data:     data,
+	})
+	r.sessionManager.SetSession(sess)
+
+	err := r.handleDownloadFile(&websocketv1.DownloadFile{
+		SessionId: sessionID.String(),
+		PageId:    pageID.String(),
+		WidgetId:  widgetID.String(),
+	})
+	if err != nil {
+		t.Fatalf("handleDownloadFile returned error: %v", err)
+	}
+
+	// Verify that the data was sent in order as chunks
+	messages := mockClient.Messages()
+	if len(messages) != 2 {
+		t.Fatalf("WebSocket messages count = %d, want 2", len(messages))
+	}
+	var received []byte
+	for _, msg := range messages {
+		chunk := msg.GetDownloadFileChunk()
+		if chunk == nil {
+			t.Fatal("WebSocket message type = nil, want DownloadFileChunk")
+		}
+		if chunk.Offset != int64(len(received)) {
+			t.Errorf("chunk offset = %d, want %d", chunk.Offset, len(received))
+		}
+		if chunk.Size != int64(len(data)) {
+			t.Errorf("chunk size = %d, want %d", chunk.Size, len(data))
+		}
+		received = append(received, chunk.Data...)
+	}
+	if !bytes.Equal(received, data) {
+		t.Error("received data does not match")
+	}
+
+	// Unknown widgets are rejected
+	err = r.handleDownloadFile(&websocketv1.DownloadFile{
+		SessionId: sessionID.String(),
+		PageId:    pageID.String(),
+		WidgetId:  uuid.Must(uuid.NewV4()).String(),
+	})
+	if err == nil {
+		t.Error("handleDownloadFile with unknown widget returned nil error")
+	}
+}
+
+func TestRuntime_HandleDownloadFile_Image(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	imageID := uuid.Must(uuid.NewV4())
+	urlImageID := uuid.Must(uuid.NewV4())
+
+	mockClient := mock.NewClient()
+	r := &runtime{
+		wsClient:       mockClient,
+		sessionManager: session.NewSessionManager(),
+		pageManager:    newPageManager(make(map[uuid.UUID]*page)),
+	}
+
+	// Initialize session with an image backed by bytes and one backed by a URL
+	sess := session.New(sessionID, pageID)
+	data := []byte("\x89PNG\r\n\x1a\n")
+	sess.State.Set(imageID, &state.ImageState{
+		ID:       imageID,
+		Data:     data,
+		MimeType: "image/png",
+	})
+	sess.State.Set(urlImageID, &state.ImageState{
+		ID:  urlImageID,
+		URL: "https://example.com/logo.png",
+	})
+	r.sessionManager.SetSession(sess)
+
+	err := r.handleDownloadFile(&websocketv1.DownloadFile{
+		SessionId: sessionID.String(),
+		PageId:    pageID.String(),
+		WidgetId:  imageID.String(),
+	})
+	if err != nil {
+		t.Fatalf("handleDownloadFile returned error: %v", err)
+	}
+
+	messages := mockClient.Messages()
+	if len(messages) != 1 {
+		t.Fatalf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	chunk := messages[0].GetDownloadFileChunk()
+	if chunk == nil {
+		t.Fatal("WebSocket message type = nil, want DownloadFileChunk")
+	}
+	if chunk.MimeType != "image/png" {
+		t.Errorf("chunk mime type = %q, want %q", chunk.MimeType, "image/png")
+	}
+	if !bytes.Equal(chunk.Data, data) {
+		t.Error("received data does not match")
+	}
+
+	// Images shown from a URL have nothing to send
+	err = r.handleDownloadFile(&websocketv1.DownloadFile{
+		SessionId: sessionID.String(),
+		PageId:    pageID.String(),
+		WidgetId:  urlImageID.String(),
+	})
+	if err == nil {
+		t.Error("handleDownloadFile with URL image returned nil error")
+	}
+}
+
+func TestRuntime_HandleUploadFileChunk_InvalidSize(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	widgetID := uuid.Must(uuid.NewV4())
+
+	r := &runtime{
+		wsClient:       mock.NewClient(),
+		sessionManager: session.NewSessionManager(),
+		pageManager:    newPageManager(make(map[uuid.UUID]*page)),
+	}
+
+	// File input without WithMaxFileSize
+	sess := session.New(sessionID, pageID)
+	sess.State.Set(widgetID, &state.FileInputState{
+		ID: widgetID,
+	})
+	r.sessionManager.SetSession(sess)
+
+	tests := []struct {
+		name   string
+		size   int64
+		offset int64
+	}{
+		{"Negative size", -1, 0},
+		{"Negative offset", 3, -1},
+		{"Over default maximum", defaultMaxFileSize + 1, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fileID := uuid.Must(uuid.NewV4())
+			err := r.handleUploadFileChunk(&websocketv1.UploadFileChunk{
+				SessionId: sessionID.String(),
+				WidgetId:  widgetID.String(),
+				FileId:    fileID.String(),
+				Name:      "data.bin",
+				Size:      tt.size,
+				Offset:    tt.offset,
+				Data:      []byte("abc"),
+			})
+			if err == nil {
+				t.Error("handleUploadFileChunk returned nil error")
+			}
+			if got := sess.Uploads.Get(fileID); got != nil {
+				t.Errorf("upload = %v, want nil", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package slider
+
+import "github.com/trysourcetool/sourcetool-go/internal/options"
+
+type Option interface {
+	Apply(*options.SliderOptions)
+}
+
+type defaultValueOption float64
+
+func (d defaultValueOption) Apply(opts *options.SliderOptions) {
+	opts.DefaultValue = (*float64)(&d)
+}
+
+func WithDefaultValue(value float64) Option {
+	return defaultValueOption(value)
+}
+
+type minValueOption float64
+
+func (m minValueOption) Apply(opts *options.SliderOptions) {
+	opts.MinValue = float64(m)
+}
+
+func WithMinValue(value float64) Option {
+	return minValueOption(value)
+}
+
+type maxValueOption float64
+
+func (m maxValueOption) Apply(opts *options.SliderOptions) {
+	opts.MaxValue = float64(m)
+}
+
+func WithMaxValue(value float64) Option {
+	return maxValueOption(value)
+}
+
+type stepOption float64
+
+func (s stepOption) Apply(opts *options.SliderOptions) {
+	opts.Step = float64(s)
+}
+
+func WithStep(step float64) Option {
+	return stepOption(step)
+}
+
+type disabledOption bool
+
+func (d disabledOption) Apply(opts *options.SliderOptions) {
+	opts.Disabled = bool(d)
+}
+
+func WithDisabled(disabled bool) Option {
+	return disabledOption(disabled)
+}
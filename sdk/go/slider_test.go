@@ -0,0 +1,232 @@
+package sourcetool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+	"github.com/trysourcetool/sourcetool-go/slider"
+)
+
+func TestConvertStateToSliderProto(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	sliderState := &state.SliderState{
+		ID:           id,
+		Value:        30,
+		Label:        "Threshold",
+		DefaultValue: 50,
+		MinValue:     10,
+		MaxValue:     90,
+		Step:         5,
+		Disabled:     true,
+	}
+
+	data := convertStateToSliderProto(sliderState)
+
+	if data == nil {
+		t.Fatal("convertStateToSliderProto returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Value", data.Value, sliderState.Value},
+		{"Label", data.Label, sliderState.Label},
+		{"DefaultValue", data.DefaultValue, sliderState.DefaultValue},
+		{"MinValue", data.MinValue, sliderState.MinValue},
+		{"MaxValue", data.MaxValue, sliderState.MaxValue},
+		{"Step", data.Step, sliderState.Step},
+		{"Disabled", data.Disabled, sliderState.Disabled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertSliderProtoToState(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	data := &widgetv1.Slider{
+		Value:        30,
+		Label:        "Threshold",
+		DefaultValue: 50,
+		MinValue:     10,
+		MaxValue:     90,
+		Step:         5,
+		Disabled:     true,
+	}
+
+	state := convertSliderProtoToState(id, data)
+
+	if state == nil {
+		t.Fatal("convertSliderProtoToState returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"ID", state.ID, id},
+		{"Value", state.Value, data.Value},
+		{"Label", state.Label, data.Label},
+		{"DefaultValue", state.DefaultValue, data.DefaultValue},
+		{"MinValue", state.MinValue, data.MinValue},
+		{"MaxValue", state.MaxValue, data.MaxValue},
+		{"Step", state.Step, data.Step},
+		{"Disabled", state.Disabled, data.Disabled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSlider(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	label := "Threshold"
+	value := builder.Slider(label,
+		slider.WithMinValue(10),
+		slider.WithMaxValue(90),
+		slider.WithStep(5),
+		slider.WithDefaultValue(50),
+		slider.WithDisabled(true),
+	)
+
+	if value != 50 {
+		t.Errorf("Slider value = %v, want 50", value)
+	}
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Errorf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	msg := messages[0]
+	if v := msg.GetRenderWidget(); v == nil {
+		t.Fatal("WebSocket message type = nil, want RenderWidget")
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeSlider, []int{0})
+	state := sess.State.GetSlider(widgetID)
+	if state == nil {
+		t.Fatal("Slider state not found")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", state.Label, label},
+		{"Value", state.Value, float64(50)},
+		{"DefaultValue", state.DefaultValue, float64(50)},
+		{"MinValue", state.MinValue, float64(10)},
+		{"MaxValue", state.MaxValue, float64(90)},
+		{"Step", state.Step, float64(5)},
+		{"Disabled", state.Disabled, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSlider_DefaultValues(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mock.NewClient(),
+		},
+	}
+
+	value := builder.Slider("Percent")
+	if value != 0 {
+		t.Errorf("Slider value = %v, want 0", value)
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeSlider, []int{0})
+	state := sess.State.GetSlider(widgetID)
+	if state == nil {
+		t.Fatal("Slider state not found")
+	}
+	if state.MinValue != 0 || state.MaxValue != 100 || state.Step != 1 {
+		t.Errorf("bounds = (%v, %v, %v), want (0, 100, 1)", state.MinValue, state.MaxValue, state.Step)
+	}
+}
+
+func TestSlider_ClampsValue(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mock.NewClient(),
+		},
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeSlider, []int{0})
+	sess.State.Set(widgetID, &state.SliderState{
+		ID:    widgetID,
+		Value: 150,
+	})
+
+	value := builder.Slider("Percent", slider.WithMaxValue(100), slider.WithStep(-1))
+	if value != 100 {
+		t.Errorf("Slider value = %v, want 100", value)
+	}
+	if step := sess.State.GetSlider(widgetID).Step; step != 1 {
+		t.Errorf("Step = %v, want 1", step)
+	}
+}
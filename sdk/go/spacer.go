@@ -0,0 +1,65 @@
+package sourcetool
+
+import (
+	"github.com/gofrs/uuid/v5"
+
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+)
+
+func (b *uiBuilder) Spacer() {
+	sess := b.session
+	if sess == nil {
+		return
+	}
+	page := b.page
+	if page == nil {
+		return
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return
+	}
+	path := cursor.getPath()
+
+	widgetID := b.generatePageID(state.WidgetTypeSpacer, path)
+	spacerState := sess.State.GetSpacer(widgetID)
+	if spacerState == nil {
+		spacerState = &state.SpacerState{
+			ID: widgetID,
+		}
+	}
+	sess.State.Set(widgetID, spacerState)
+
+	spacerProto := convertStateToSpacerProto(spacerState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_Spacer{
+				Spacer: spacerProto,
+			},
+		},
+	})
+
+	cursor.next()
+}
+
+func convertStateToSpacerProto(state *state.SpacerState) *widgetv1.Spacer {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.Spacer{}
+}
+
+func convertSpacerProtoToState(id uuid.UUID, data *widgetv1.Spacer) *state.SpacerState {
+	if data == nil {
+		return nil
+	}
+	return &state.SpacerState{
+		ID: id,
+	}
+}
@@ -0,0 +1,125 @@
+package sourcetool
+
+import (
+	"sync"
+
+	"github.com/gofrs/uuid/v5"
+
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+)
+
+// Spinner is a loading indicator shown while the page handler is running.
+// The client hides it when the page run finishes; call Stop to hide it earlier.
+type Spinner struct {
+	builder *uiBuilder
+	id      uuid.UUID
+	path    path
+	mu      sync.Mutex
+}
+
+func (b *uiBuilder) Spinner(text string) *Spinner {
+	sess := b.session
+	if sess == nil {
+		return nil
+	}
+	page := b.page
+	if page == nil {
+		return nil
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return nil
+	}
+	path := cursor.getPath()
+
+	widgetID := b.generatePageID(state.WidgetTypeSpinner, path)
+	spinnerState := &state.SpinnerState{
+		ID:     widgetID,
+		Text:   text,
+		Active: true,
+	}
+
+	s := &Spinner{
+		builder: b,
+		id:      widgetID,
+		path:    path,
+	}
+	s.render(spinnerState)
+
+	cursor.next()
+
+	return s
+}
+
+// SetText changes the text shown next to the spinner.
+func (s *Spinner) SetText(text string) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	spinnerState := s.builder.session.State.GetSpinner(s.id)
+	if spinnerState == nil {
+		return
+	}
+	spinnerState.Text = text
+	s.render(spinnerState)
+}
+
+// Stop hides the spinner.
+func (s *Spinner) Stop() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	spinnerState := s.builder.session.State.GetSpinner(s.id)
+	if spinnerState == nil || !spinnerState.Active {
+		return
+	}
+	spinnerState.Active = false
+	s.render(spinnerState)
+}
+
+func (s *Spinner) render(spinnerState *state.SpinnerState) {
+	b := s.builder
+	b.session.State.Set(s.id, spinnerState)
+
+	spinner := convertStateToSpinnerProto(spinnerState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: b.session.ID.String(),
+		PageId:    b.page.id.String(),
+		Path:      convertPathToInt32Slice(s.path),
+		Widget: &widgetv1.Widget{
+			Id: s.id.String(),
+			Type: &widgetv1.Widget_Spinner{
+				Spinner: spinner,
+			},
+		},
+	})
+}
+
+func convertStateToSpinnerProto(state *state.SpinnerState) *widgetv1.Spinner {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.Spinner{
+		Text:   state.Text,
+		Active: state.Active,
+	}
+}
+
+func convertSpinnerProtoToState(id uuid.UUID, data *widgetv1.Spinner) *state.SpinnerState {
+	if data == nil {
+		return nil
+	}
+	return &state.SpinnerState{
+		ID:     id,
+		Text:   data.Text,
+		Active: data.Active,
+	}
+}
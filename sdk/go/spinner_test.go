@@ -0,0 +1,112 @@
+package sourcetool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+)
+
+func TestConvertStateToSpinnerProto(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	spinnerState := &state.SpinnerState{
+		ID:     id,
+		Text:   "Loading",
+		Active: true,
+	}
+
+	data := convertStateToSpinnerProto(spinnerState)
+
+	if data == nil {
+		t.Fatal("convertStateToSpinnerProto returned nil")
+	}
+
+	if data.Text != spinnerState.Text {
+		t.Errorf("Text = %v, want %v", data.Text, spinnerState.Text)
+	}
+	if data.Active != spinnerState.Active {
+		t.Errorf("Active = %v, want %v", data.Active, spinnerState.Active)
+	}
+}
+
+func TestConvertSpinnerProtoToState(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	data := &widgetv1.Spinner{
+		Text:   "Loading",
+		Active: true,
+	}
+
+	state := convertSpinnerProtoToState(id, data)
+
+	if state == nil {
+		t.Fatal("convertSpinnerProtoToState returned nil")
+	}
+
+	if state.ID != id {
+		t.Errorf("ID = %v, want %v", state.ID, id)
+	}
+	if state.Text != data.Text {
+		t.Errorf("Text = %v, want %v", state.Text, data.Text)
+	}
+	if state.Active != data.Active {
+		t.Errorf("Active = %v, want %v", state.Active, data.Active)
+	}
+}
+
+func TestSpinner(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	spinner := builder.Spinner("Loading")
+	if spinner == nil {
+		t.Fatal("Spinner returned nil")
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeSpinner, []int{0})
+	spinnerState := sess.State.GetSpinner(widgetID)
+	if spinnerState == nil {
+		t.Fatal("Spinner state not found")
+	}
+	if !spinnerState.Active {
+		t.Error("Active = false, want true")
+	}
+
+	spinner.SetText("Almost done")
+	spinner.Stop()
+	spinner.Stop() // stopping twice does not re-render
+
+	messages := mockWS.Messages()
+	if len(messages) != 3 {
+		t.Fatalf("WebSocket messages count = %d, want 3", len(messages))
+	}
+	last := messages[2].GetRenderWidget().Widget.GetSpinner()
+	if last == nil {
+		t.Fatal("last message is not a Spinner")
+	}
+	if last.Active {
+		t.Error("Active after Stop = true, want false")
+	}
+	if last.Text != "Almost done" {
+		t.Errorf("Text = %v, want Almost done", last.Text)
+	}
+}
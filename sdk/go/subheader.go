@@ -0,0 +1,69 @@
+package sourcetool
+
+import (
+	"github.com/gofrs/uuid/v5"
+
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+)
+
+func (b *uiBuilder) Subheader(text string) {
+	sess := b.session
+	if sess == nil {
+		return
+	}
+	page := b.page
+	if page == nil {
+		return
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return
+	}
+	path := cursor.getPath()
+
+	widgetID := b.generatePageID(state.WidgetTypeSubheader, path)
+	subheaderState := sess.State.GetSubheader(widgetID)
+	if subheaderState == nil {
+		subheaderState = &state.SubheaderState{
+			ID: widgetID,
+		}
+	}
+	subheaderState.Text = text
+	sess.State.Set(widgetID, subheaderState)
+
+	subheaderProto := convertStateToSubheaderProto(subheaderState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_Subheader{
+				Subheader: subheaderProto,
+			},
+		},
+	})
+
+	cursor.next()
+}
+
+func convertStateToSubheaderProto(state *state.SubheaderState) *widgetv1.Subheader {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.Subheader{
+		Text: state.Text,
+	}
+}
+
+func convertSubheaderProtoToState(id uuid.UUID, data *widgetv1.Subheader) *state.SubheaderState {
+	if data == nil {
+		return nil
+	}
+	return &state.SubheaderState{
+		ID:   id,
+		Text: data.Text,
+	}
+}
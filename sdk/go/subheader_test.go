@@ -0,0 +1,96 @@
+package sourcetool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+)
+
+func TestConvertStateToSubheaderProto(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	subheaderState := &state.SubheaderState{
+		ID:   id,
+		Text: "Revenue",
+	}
+
+	data := convertStateToSubheaderProto(subheaderState)
+
+	if data == nil {
+		t.Fatal("convertStateToSubheaderProto returned nil")
+	}
+
+	if data.Text != subheaderState.Text {
+		t.Errorf("Text = %v, want %v", data.Text, subheaderState.Text)
+	}
+}
+
+func TestConvertSubheaderProtoToState(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	data := &widgetv1.Subheader{
+		Text: "Revenue",
+	}
+
+	state := convertSubheaderProtoToState(id, data)
+
+	if state == nil {
+		t.Fatal("convertSubheaderProtoToState returned nil")
+	}
+
+	if state.ID != id {
+		t.Errorf("ID = %v, want %v", state.ID, id)
+	}
+	if state.Text != data.Text {
+		t.Errorf("Text = %v, want %v", state.Text, data.Text)
+	}
+}
+
+func TestSubheader(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	text := "Revenue"
+	builder.Subheader(text)
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Fatalf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	renderWidget := messages[0].GetRenderWidget()
+	if renderWidget == nil {
+		t.Fatal("WebSocket message type = nil, want RenderWidget")
+	}
+	if renderWidget.GetWidget().GetSubheader() == nil {
+		t.Fatal("Widget type = nil, want Subheader")
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeSubheader, []int{0})
+	state := sess.State.GetSubheader(widgetID)
+	if state == nil {
+		t.Fatal("Subheader state not found")
+	}
+
+	if state.Text != text {
+		t.Errorf("Text = %v, want %v", state.Text, text)
+	}
+}
@@ -1,7 +1,12 @@
 package sourcetool
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"maps"
+	"reflect"
+	"slices"
 
 	"github.com/gofrs/uuid/v5"
 
@@ -16,23 +21,47 @@ func (b *uiBuilder) Table(data any, opts ...table.Option) table.Value {
 	tableOpts := &options.TableOptions{
 		OnSelect:     table.OnSelectIgnore.String(),
 		RowSelection: table.RowSelectionSingle.String(),
+		PageSize:     nil,
 	}
 
 	for _, o := range opts {
 		o.Apply(tableOpts)
 	}
 
+	value, _ := b.renderTable(tableOpts, data, nil)
+	return value
+}
+
+func (b *uiBuilder) PaginatedTable(fetch table.FetchFunc, opts ...table.Option) (table.Value, error) {
+	defaultPageSize := int32(50)
+	tableOpts := &options.TableOptions{
+		OnSelect:     table.OnSelectIgnore.String(),
+		RowSelection: table.RowSelectionSingle.String(),
+		PageSize:     &defaultPageSize,
+	}
+
+	for _, o := range opts {
+		o.Apply(tableOpts)
+	}
+
+	return b.renderTable(tableOpts, nil, fetch)
+}
+
+// renderTable renders a table widget. When fetch is non-nil the table is
+// paginated on the server and data is ignored; only the rows returned by
+// fetch for the current page are sent to the browser.
+func (b *uiBuilder) renderTable(tableOpts *options.TableOptions, data any, fetch table.FetchFunc) (table.Value, error) {
 	sess := b.session
 	if sess == nil {
-		return table.Value{}
+		return table.Value{}, nil
 	}
 	page := b.page
 	if page == nil {
-		return table.Value{}
+		return table.Value{}, nil
 	}
 	cursor := b.cursor
 	if cursor == nil {
-		return table.Value{}
+		return table.Value{}, nil
 	}
 	path := cursor.getPath()
 
@@ -44,18 +73,61 @@ func (b *uiBuilder) Table(data any, opts ...table.Option) table.Value {
 			Value: state.TableStateValue{},
 		}
 	}
+
+	tableState.Value.Pagination = resolveTablePagination(tableState.Value.Pagination, tableOpts.PageSize)
+	tableState.Value.Sort = normalizeTableSort(tableState.Value.Sort)
+	tableState.Value.Filters = normalizeTableFilters(tableState.Value.Filters)
+	tableState.Paginated = fetch != nil
+	tableState.TotalRows = 0
+	if fetch != nil {
+		ctx := b.context
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		rows, total, err := fetchTablePage(ctx, fetch, &tableState.Value)
+		if err != nil {
+			cursor.next()
+			return table.Value{}, err
+		}
+		data = rows
+		tableState.TotalRows = int64(total)
+	}
+	rowCount := tableRowCount(data)
+	tableState.Value.Edits = normalizeTableEdits(tableState.Value.Edits, tableOpts.EditableColumns, rowCount)
+	if a := tableState.Value.Action; a != nil && (a.Row < 0 || int(a.Row) >= rowCount || !slices.Contains(tableOpts.RowActions, a.Name)) {
+		tableState.Value.Action = nil
+	}
+
+	// Configuration mistakes are shown on the table rather than failing the
+	// page; the rest of the configuration still applies.
+	fields, fieldsErr := parseTableFields(data)
+	tableState.Error = ""
+	if err := errors.Join(fieldsErr, validateTableColumnFormats(tableOpts.ColumnFormats)); err != nil {
+		tableState.Error = err.Error()
+	}
+
+	columns, data, err := resolveTableColumns(data, fields, tableOpts.ColumnFormats)
+	if err != nil {
+		cursor.next()
+		return table.Value{}, err
+	}
+
 	tableState.Data = data
+	tableState.Columns = columns
 	tableState.Header = tableOpts.Header
 	tableState.Description = tableOpts.Description
 	tableState.Height = tableOpts.Height
 	tableState.ColumnOrder = tableOpts.ColumnOrder
 	tableState.OnSelect = tableOpts.OnSelect
 	tableState.RowSelection = tableOpts.RowSelection
+	tableState.EditableColumns = tableOpts.EditableColumns
+	tableState.RowActions = tableOpts.RowActions
 	sess.State.Set(widgetID, tableState)
 
 	tableProto, err := convertStateToTableProto(tableState)
 	if err != nil {
-		return table.Value{}
+		cursor.next()
+		return table.Value{}, err
 	}
 	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
 		SessionId: sess.ID.String(),
@@ -82,8 +154,172 @@ func (b *uiBuilder) Table(data any, opts ...table.Option) table.Value {
 			Rows: rows,
 		}
 	}
+	if tableState.Value.Pagination != nil {
+		value.Pagination = &table.Pagination{
+			Page:      int(tableState.Value.Pagination.Page),
+			PageSize:  int(tableState.Value.Pagination.PageSize),
+			TotalRows: int(tableState.TotalRows),
+		}
+	}
+	value.Sort = convertTableStateSort(tableState.Value.Sort)
+	value.Filters = maps.Clone(tableState.Value.Filters)
+	if len(tableState.Value.Edits) > 0 {
+		value.Edits = make([]table.Edit, len(tableState.Value.Edits))
+		for i, e := range tableState.Value.Edits {
+			value.Edits[i] = table.Edit{
+				Row:      int(e.Row),
+				Column:   e.Column,
+				OldValue: e.OldValue,
+				NewValue: e.NewValue,
+			}
+		}
+	}
+	if tableState.Value.Action != nil {
+		value.Action = &table.Action{
+			Row:  int(tableState.Value.Action.Row),
+			Name: tableState.Value.Action.Name,
+		}
+	}
 
-	return value
+	return value, nil
+}
+
+// maxTablePageSize caps the page size the browser may ask for, so a single
+// page stays well below the WebSocket message limit. A larger page size set
+// with WithPageSize is still allowed.
+const maxTablePageSize int32 = 500
+
+// resolveTablePagination returns the page the browser asked for, or the
+// first page when it has not asked for one yet. It returns nil when the
+// table is not paginated.
+func resolveTablePagination(current *state.TableStateValuePagination, pageSize *int32) *state.TableStateValuePagination {
+	if pageSize == nil || *pageSize <= 0 {
+		return nil
+	}
+	maxPageSize := max(*pageSize, maxTablePageSize)
+	pagination := &state.TableStateValuePagination{
+		Page:     0,
+		PageSize: *pageSize,
+	}
+	if current != nil {
+		if current.Page > 0 {
+			pagination.Page = current.Page
+		}
+		if current.PageSize > 0 {
+			pagination.PageSize = min(current.PageSize, maxPageSize)
+		}
+	}
+	return pagination
+}
+
+// normalizeTableSort drops a sort without a column and treats any
+// direction other than descending as ascending.
+func normalizeTableSort(sort *state.TableStateValueSort) *state.TableStateValueSort {
+	if sort == nil || sort.Column == "" {
+		return nil
+	}
+	direction := table.SortDirectionAsc
+	if sort.Direction == table.SortDirectionDesc.String() {
+		direction = table.SortDirectionDesc
+	}
+	return &state.TableStateValueSort{
+		Column:    sort.Column,
+		Direction: direction.String(),
+	}
+}
+
+// normalizeTableFilters drops columns whose filter is empty. It returns nil
+// when no filter is left.
+func normalizeTableFilters(filters map[string]string) map[string]string {
+	var normalized map[string]string
+	for column, value := range filters {
+		if column == "" || value == "" {
+			continue
+		}
+		if normalized == nil {
+			normalized = make(map[string]string, len(filters))
+		}
+		normalized[column] = value
+	}
+	return normalized
+}
+
+// normalizeTableEdits drops edits to columns that are not editable, edits
+// that do not change the value and edits to rows outside [0, rowCount). It
+// returns nil when no edit is left.
+func normalizeTableEdits(edits []state.TableStateValueEdit, editableColumns []string, rowCount int) []state.TableStateValueEdit {
+	var normalized []state.TableStateValueEdit
+	for _, e := range edits {
+		if e.Row < 0 || int(e.Row) >= rowCount || e.OldValue == e.NewValue || !slices.Contains(editableColumns, e.Column) {
+			continue
+		}
+		normalized = append(normalized, e)
+	}
+	return normalized
+}
+
+// tableRowCount returns the number of rows in data as rendered: the length
+// of a slice or array, or of a JSON array given as bytes. Other values have
+// no rows.
+func tableRowCount(data any) int {
+	switch d := data.(type) {
+	case json.RawMessage:
+		return tableRowCount([]byte(d))
+	case []byte:
+		var rows []json.RawMessage
+		if err := json.Unmarshal(d, &rows); err != nil {
+			return 0
+		}
+		return len(rows)
+	}
+	rv := reflect.ValueOf(data)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return 0
+		}
+		rv = rv.Elem()
+	}
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		return rv.Len()
+	default:
+		return 0
+	}
+}
+
+func convertTableStateSort(sort *state.TableStateValueSort) *table.Sort {
+	if sort == nil {
+		return nil
+	}
+	return &table.Sort{
+		Column:    sort.Column,
+		Direction: table.SortDirection(sort.Direction),
+	}
+}
+
+// fetchTablePage calls fetch for the current page, sort and filters. If the
+// page is past the end, for example because rows were deleted since the
+// last run, the last page is fetched instead and value is updated to match.
+func fetchTablePage(ctx context.Context, fetch table.FetchFunc, value *state.TableStateValue) (any, int, error) {
+	req := table.PageRequest{
+		Page:     int(value.Pagination.Page),
+		PageSize: int(value.Pagination.PageSize),
+		Sort:     convertTableStateSort(value.Sort),
+		Filters:  maps.Clone(value.Filters),
+	}
+	rows, total, err := fetch(ctx, req)
+	if err != nil {
+		return nil, 0, err
+	}
+	if total > 0 && req.Offset() >= total {
+		req.Page = (total - 1) / req.PageSize
+		value.Pagination.Page = int32(req.Page)
+		rows, total, err = fetch(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	return rows, total, nil
 }
 
 func convertStateToTableProto(state *state.TableState) (*widgetv1.Table, error) {
@@ -95,14 +331,34 @@ func convertStateToTableProto(state *state.TableState) (*widgetv1.Table, error)
 		return nil, err
 	}
 	data := &widgetv1.Table{
-		Data:         dataBytes,
-		Header:       state.Header,
-		Description:  state.Description,
-		Height:       state.Height,
-		ColumnOrder:  state.ColumnOrder,
-		OnSelect:     state.OnSelect,
-		RowSelection: state.RowSelection,
-		Value:        &widgetv1.TableValue{},
+		Data:            dataBytes,
+		Header:          state.Header,
+		Description:     state.Description,
+		Height:          state.Height,
+		ColumnOrder:     state.ColumnOrder,
+		OnSelect:        state.OnSelect,
+		RowSelection:    state.RowSelection,
+		Paginated:       state.Paginated,
+		TotalRows:       state.TotalRows,
+		EditableColumns: state.EditableColumns,
+		RowActions:      state.RowActions,
+		Error:           state.Error,
+		Value:           &widgetv1.TableValue{},
+	}
+	for _, c := range state.Columns {
+		column := &widgetv1.TableColumn{
+			Name:  c.Name,
+			Label: c.Label,
+		}
+		if c.Format != nil {
+			column.Format = &widgetv1.TableColumnFormat{
+				Type:       c.Format.Type,
+				Decimals:   c.Format.Decimals,
+				Currency:   c.Format.Currency,
+				DateFormat: c.Format.DateFormat,
+			}
+		}
+		data.Columns = append(data.Columns, column)
 	}
 	if state.Value.Selection != nil {
 		data.Value.Selection = &widgetv1.TableValueSelection{
@@ -110,6 +366,33 @@ func convertStateToTableProto(state *state.TableState) (*widgetv1.Table, error)
 			Rows: state.Value.Selection.Rows,
 		}
 	}
+	if state.Value.Pagination != nil {
+		data.Value.Pagination = &widgetv1.TableValuePagination{
+			Page:     state.Value.Pagination.Page,
+			PageSize: state.Value.Pagination.PageSize,
+		}
+	}
+	if state.Value.Sort != nil {
+		data.Value.Sort = &widgetv1.TableValueSort{
+			Column:    state.Value.Sort.Column,
+			Direction: state.Value.Sort.Direction,
+		}
+	}
+	data.Value.Filters = state.Value.Filters
+	if state.Value.Action != nil {
+		data.Value.Action = &widgetv1.TableValueAction{
+			Row:  state.Value.Action.Row,
+			Name: state.Value.Action.Name,
+		}
+	}
+	for _, e := range state.Value.Edits {
+		data.Value.Edits = append(data.Value.Edits, &widgetv1.TableValueEdit{
+			Row:      e.Row,
+			Column:   e.Column,
+			OldValue: e.OldValue,
+			NewValue: e.NewValue,
+		})
+	}
 	return data, nil
 }
 
@@ -118,21 +401,68 @@ func convertTableProtoToState(id uuid.UUID, data *widgetv1.Table) *state.TableSt
 		return nil
 	}
 	tableState := &state.TableState{
-		ID:           id,
-		Data:         data.Data,
-		Header:       data.Header,
-		Description:  data.Description,
-		Height:       data.Height,
-		ColumnOrder:  data.ColumnOrder,
-		OnSelect:     data.OnSelect,
-		RowSelection: data.RowSelection,
-		Value:        state.TableStateValue{},
-	}
-	if data.Value.Selection != nil {
+		ID:              id,
+		Data:            data.Data,
+		Header:          data.Header,
+		Description:     data.Description,
+		Height:          data.Height,
+		ColumnOrder:     data.ColumnOrder,
+		OnSelect:        data.OnSelect,
+		RowSelection:    data.RowSelection,
+		Paginated:       data.Paginated,
+		TotalRows:       data.TotalRows,
+		EditableColumns: data.EditableColumns,
+		RowActions:      data.RowActions,
+		Error:           data.Error,
+		Value:           state.TableStateValue{},
+	}
+	for _, c := range data.Columns {
+		column := state.TableStateColumn{
+			Name:  c.Name,
+			Label: c.Label,
+		}
+		if c.Format != nil {
+			column.Format = &state.TableStateColumnFormat{
+				Type:       c.Format.Type,
+				Decimals:   c.Format.Decimals,
+				Currency:   c.Format.Currency,
+				DateFormat: c.Format.DateFormat,
+			}
+		}
+		tableState.Columns = append(tableState.Columns, column)
+	}
+	if data.Value.GetSelection() != nil {
 		tableState.Value.Selection = &state.TableStateValueSelection{
 			Row:  data.Value.Selection.Row,
 			Rows: data.Value.Selection.Rows,
 		}
 	}
+	if data.Value.GetPagination() != nil {
+		tableState.Value.Pagination = &state.TableStateValuePagination{
+			Page:     data.Value.Pagination.Page,
+			PageSize: data.Value.Pagination.PageSize,
+		}
+	}
+	if data.Value.GetSort() != nil {
+		tableState.Value.Sort = &state.TableStateValueSort{
+			Column:    data.Value.Sort.Column,
+			Direction: data.Value.Sort.Direction,
+		}
+	}
+	tableState.Value.Filters = data.Value.GetFilters()
+	if data.Value.GetAction() != nil {
+		tableState.Value.Action = &state.TableStateValueAction{
+			Row:  data.Value.Action.Row,
+			Name: data.Value.Action.Name,
+		}
+	}
+	for _, e := range data.Value.GetEdits() {
+		tableState.Value.Edits = append(tableState.Value.Edits, state.TableStateValueEdit{
+			Row:      e.Row,
+			Column:   e.Column,
+			OldValue: e.OldValue,
+			NewValue: e.NewValue,
+		})
+	}
 	return tableState
 }
@@ -0,0 +1,59 @@
+package table
+
+type FormatType string
+
+const (
+	FormatTypeNumber   FormatType = "number"
+	FormatTypeDate     FormatType = "date"
+	FormatTypeCurrency FormatType = "currency"
+	FormatTypePercent  FormatType = "percent"
+	FormatTypeBadge    FormatType = "badge"
+	FormatTypeLink     FormatType = "link"
+)
+
+func (f FormatType) String() string {
+	return string(f)
+}
+
+// Format controls how the browser displays the values of a column. The
+// values sent to the browser are unchanged, so sorting and editing still
+// work on the raw values.
+type Format struct {
+	Type FormatType
+	// Decimals is the number of decimal places for number, currency and
+	// percent columns. nil shows as many as the value has.
+	Decimals *int32
+	// Currency is an ISO 4217 code such as "USD" for currency columns.
+	Currency string
+	// DateFormat uses Moment.js-style tokens such as "YYYY/MM/DD" for date
+	// columns.
+	DateFormat string
+}
+
+func FormatNumber(decimals int32) Format {
+	return Format{Type: FormatTypeNumber, Decimals: &decimals}
+}
+
+func FormatDate(format string) Format {
+	return Format{Type: FormatTypeDate, DateFormat: format}
+}
+
+func FormatCurrency(currency string) Format {
+	return Format{Type: FormatTypeCurrency, Currency: currency}
+}
+
+// FormatPercent shows fractions as percentages, so 0.25 is shown as 25%.
+func FormatPercent(decimals int32) Format {
+	return Format{Type: FormatTypePercent, Decimals: &decimals}
+}
+
+// FormatBadge shows each value as a coloured badge. Equal values get the
+// same colour.
+func FormatBadge() Format {
+	return Format{Type: FormatTypeBadge}
+}
+
+// FormatLink shows values as links. Values must be URLs.
+func FormatLink() Format {
+	return Format{Type: FormatTypeLink}
+}
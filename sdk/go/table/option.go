@@ -65,3 +65,60 @@ func (r rowSelectionOption) Apply(opts *options.TableOptions) {
 func WithRowSelection(mode RowSelection) Option {
 	return rowSelectionOption(mode)
 }
+
+type pageSizeOption int32
+
+func (p pageSizeOption) Apply(opts *options.TableOptions) {
+	opts.PageSize = (*int32)(&p)
+}
+
+// WithPageSize splits the table into pages of the given number of rows.
+// Table pages in the browser; PaginatedTable fetches each page from Go.
+func WithPageSize(size int32) Option {
+	return pageSizeOption(size)
+}
+
+type editableOption []string
+
+func (e editableOption) Apply(opts *options.TableOptions) {
+	opts.EditableColumns = []string(e)
+}
+
+// WithEditable lets users edit cells in the given columns. Changes are
+// reported in Value.Edits on the run triggered by saving them.
+func WithEditable(columns ...string) Option {
+	return editableOption(columns)
+}
+
+type rowActionsOption []string
+
+func (r rowActionsOption) Apply(opts *options.TableOptions) {
+	opts.RowActions = []string(r)
+}
+
+// WithRowActions adds a button for each action to every row. Clicking one
+// reruns the page with Value.Action set.
+func WithRowActions(actions ...string) Option {
+	return rowActionsOption(actions)
+}
+
+type columnFormatOption struct {
+	column string
+	format Format
+}
+
+func (c columnFormatOption) Apply(opts *options.TableOptions) {
+	opts.ColumnFormats = append(opts.ColumnFormats, options.TableColumnFormat{
+		Column:     c.column,
+		Type:       c.format.Type.String(),
+		Decimals:   c.format.Decimals,
+		Currency:   c.format.Currency,
+		DateFormat: c.format.DateFormat,
+	})
+}
+
+// WithColumnFormat sets how the values of column are displayed. It takes
+// precedence over a format set in the sourcetool struct tag.
+func WithColumnFormat(column string, format Format) Option {
+	return columnFormatOption{column: column, format: format}
+}
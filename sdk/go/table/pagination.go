@@ -0,0 +1,24 @@
+package table
+
+import "context"
+
+// PageRequest describes the page of rows the browser is showing. Page is
+// zero-based. Sort and Filters are the same as in Value, so they can be
+// applied in the query that loads the page.
+type PageRequest struct {
+	Page     int
+	PageSize int
+	Sort     *Sort
+	Filters  map[string]string
+}
+
+// Offset returns the index of the first row on the page, for use in
+// LIMIT/OFFSET queries.
+func (r PageRequest) Offset() int {
+	return r.Page * r.PageSize
+}
+
+// FetchFunc loads one page of rows for PaginatedTable. rows is encoded like
+// the data passed to Table, and total is the number of rows across all
+// pages.
+type FetchFunc func(ctx context.Context, req PageRequest) (rows any, total int, err error)
@@ -1,7 +1,12 @@
 package table
 
 type Value struct {
-	Selection *Selection
+	Selection  *Selection
+	Pagination *Pagination
+	Sort       *Sort
+	Filters    map[string]string // column to the filter text entered for it
+	Edits      []Edit
+	Action     *Action
 }
 
 type Selection struct {
@@ -9,6 +14,45 @@ type Selection struct {
 	Rows []int
 }
 
+type Pagination struct {
+	Page      int
+	PageSize  int
+	TotalRows int
+}
+
+// Edit is a cell the user changed. Row is the index of the row in the data
+// passed to the table, and the values are the cell text before and after
+// the change.
+type Edit struct {
+	Row      int
+	Column   string
+	OldValue string
+	NewValue string
+}
+
+// Action is a row action button the user clicked. Row is the index of the
+// row in the data passed to the table, and Name is the action's label.
+type Action struct {
+	Row  int
+	Name string
+}
+
+type Sort struct {
+	Column    string
+	Direction SortDirection
+}
+
+type SortDirection string
+
+const (
+	SortDirectionAsc  SortDirection = "asc"
+	SortDirectionDesc SortDirection = "desc"
+)
+
+func (s SortDirection) String() string {
+	return string(s)
+}
+
 type OnSelect string
 
 const (
@@ -3,6 +3,7 @@ package sourcetool
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"reflect"
 	"testing"
 
@@ -38,8 +39,32 @@ func TestConvertStateToTableProto(t *testing.T) {
 		Description:  "Test Description",
 		OnSelect:     table.OnSelectRerun.String(),
 		RowSelection: table.RowSelectionSingle.String(),
+		Paginated:    true,
+		TotalRows:    120,
 		Value: state.TableStateValue{
 			Selection: selection,
+			Pagination: &state.TableStateValuePagination{
+				Page:     2,
+				PageSize: 50,
+			},
+			Sort: &state.TableStateValueSort{
+				Column:    "name",
+				Direction: table.SortDirectionDesc.String(),
+			},
+			Filters: map[string]string{"name": "Test"},
+			Edits: []state.TableStateValueEdit{
+				{Row: 1, Column: "name", OldValue: "Test 2", NewValue: "Test 3"},
+			},
+			Action: &state.TableStateValueAction{
+				Row:  1,
+				Name: "Approve",
+			},
+		},
+		EditableColumns: []string{"name"},
+		RowActions:      []string{"Approve", "Reject"},
+		Columns: []state.TableStateColumn{
+			{Name: "id"},
+			{Name: "name", Label: "Name", Format: &state.TableStateColumnFormat{Type: "badge"}},
 		},
 	}
 
@@ -63,6 +88,25 @@ func TestConvertStateToTableProto(t *testing.T) {
 		{"RowSelection", tableData.RowSelection, tableState.RowSelection},
 		{"Selection.Row", tableData.Value.Selection.Row, tableState.Value.Selection.Row},
 		{"Selection.Rows", tableData.Value.Selection.Rows, tableState.Value.Selection.Rows},
+		{"Paginated", tableData.Paginated, tableState.Paginated},
+		{"TotalRows", tableData.TotalRows, tableState.TotalRows},
+		{"Pagination.Page", tableData.Value.Pagination.Page, tableState.Value.Pagination.Page},
+		{"Pagination.PageSize", tableData.Value.Pagination.PageSize, tableState.Value.Pagination.PageSize},
+		{"Sort.Column", tableData.Value.Sort.Column, tableState.Value.Sort.Column},
+		{"Sort.Direction", tableData.Value.Sort.Direction, tableState.Value.Sort.Direction},
+		{"Filters", tableData.Value.Filters, tableState.Value.Filters},
+		{"EditableColumns", tableData.EditableColumns, tableState.EditableColumns},
+		{"Edits.Row", tableData.Value.Edits[0].Row, tableState.Value.Edits[0].Row},
+		{"Edits.Column", tableData.Value.Edits[0].Column, tableState.Value.Edits[0].Column},
+		{"Edits.OldValue", tableData.Value.Edits[0].OldValue, tableState.Value.Edits[0].OldValue},
+		{"Edits.NewValue", tableData.Value.Edits[0].NewValue, tableState.Value.Edits[0].NewValue},
+		{"RowActions", tableData.RowActions, tableState.RowActions},
+		{"Action.Row", tableData.Value.Action.Row, tableState.Value.Action.Row},
+		{"Action.Name", tableData.Value.Action.Name, tableState.Value.Action.Name},
+		{"Columns.Name", tableData.Columns[1].Name, tableState.Columns[1].Name},
+		{"Columns.Label", tableData.Columns[1].Label, tableState.Columns[1].Label},
+		{"Columns.Format", tableData.Columns[1].Format.Type, tableState.Columns[1].Format.Type},
+		{"Columns.NoFormat", tableData.Columns[0].Format == nil, true},
 	}
 
 	for _, tt := range tests {
@@ -104,8 +148,32 @@ func TestConvertTableProtoToState(t *testing.T) {
 		Description:  "Test Description",
 		OnSelect:     table.OnSelectRerun.String(),
 		RowSelection: table.RowSelectionSingle.String(),
+		Paginated:    true,
+		TotalRows:    120,
 		Value: &widgetv1.TableValue{
 			Selection: selection,
+			Pagination: &widgetv1.TableValuePagination{
+				Page:     2,
+				PageSize: 50,
+			},
+			Sort: &widgetv1.TableValueSort{
+				Column:    "name",
+				Direction: table.SortDirectionDesc.String(),
+			},
+			Filters: map[string]string{"name": "Test"},
+			Edits: []*widgetv1.TableValueEdit{
+				{Row: 1, Column: "name", OldValue: "Test 2", NewValue: "Test 3"},
+			},
+			Action: &widgetv1.TableValueAction{
+				Row:  1,
+				Name: "Approve",
+			},
+		},
+		EditableColumns: []string{"name"},
+		RowActions:      []string{"Approve", "Reject"},
+		Columns: []*widgetv1.TableColumn{
+			{Name: "id"},
+			{Name: "name", Label: "Name", Format: &widgetv1.TableColumnFormat{Type: "badge"}},
 		},
 	}
 
@@ -127,6 +195,25 @@ func TestConvertTableProtoToState(t *testing.T) {
 		{"RowSelection", state.RowSelection, tableData.RowSelection},
 		{"Selection.Row", state.Value.Selection.Row, tableData.Value.Selection.Row},
 		{"Selection.Rows", state.Value.Selection.Rows, tableData.Value.Selection.Rows},
+		{"Paginated", state.Paginated, tableData.Paginated},
+		{"TotalRows", state.TotalRows, tableData.TotalRows},
+		{"Pagination.Page", state.Value.Pagination.Page, tableData.Value.Pagination.Page},
+		{"Pagination.PageSize", state.Value.Pagination.PageSize, tableData.Value.Pagination.PageSize},
+		{"Sort.Column", state.Value.Sort.Column, tableData.Value.Sort.Column},
+		{"Sort.Direction", state.Value.Sort.Direction, tableData.Value.Sort.Direction},
+		{"Filters", state.Value.Filters, tableData.Value.Filters},
+		{"EditableColumns", state.EditableColumns, tableData.EditableColumns},
+		{"Edits.Row", state.Value.Edits[0].Row, tableData.Value.Edits[0].Row},
+		{"Edits.Column", state.Value.Edits[0].Column, tableData.Value.Edits[0].Column},
+		{"Edits.OldValue", state.Value.Edits[0].OldValue, tableData.Value.Edits[0].OldValue},
+		{"Edits.NewValue", state.Value.Edits[0].NewValue, tableData.Value.Edits[0].NewValue},
+		{"RowActions", state.RowActions, tableData.RowActions},
+		{"Action.Row", state.Value.Action.Row, tableData.Value.Action.Row},
+		{"Action.Name", state.Value.Action.Name, tableData.Value.Action.Name},
+		{"Columns.Name", state.Columns[1].Name, tableData.Columns[1].Name},
+		{"Columns.Label", state.Columns[1].Label, tableData.Columns[1].Label},
+		{"Columns.Format", state.Columns[1].Format.Type, tableData.Columns[1].Format.Type},
+		{"Columns.NoFormat", state.Columns[0].Format == nil, true},
 	}
 
 	for _, tt := range tests {
@@ -262,4 +349,572 @@ func TestTable_DefaultValues(t *testing.T) {
 	if state.Description != "" {
 		t.Errorf("Default Description = %v, want empty string", state.Description)
 	}
+	if state.Paginated {
+		t.Error("Default Paginated = true, want false")
+	}
+	if state.Value.Pagination != nil {
+		t.Errorf("Default Pagination = %v, want nil", state.Value.Pagination)
+	}
+}
+
+func TestTable_SortAndFilters(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mock.NewClient(),
+		},
+	}
+
+	// Sort and filters as sent back by the client
+	widgetID := builder.generatePageID(state.WidgetTypeTable, []int{0})
+	sess.State.Set(widgetID, &state.TableState{
+		ID: widgetID,
+		Value: state.TableStateValue{
+			Sort: &state.TableStateValueSort{
+				Column:    "name",
+				Direction: "desc",
+			},
+			Filters: map[string]string{"name": "Test", "id": ""},
+		},
+	})
+
+	value := builder.Table([]testData{})
+
+	if want := (&table.Sort{Column: "name", Direction: table.SortDirectionDesc}); !reflect.DeepEqual(value.Sort, want) {
+		t.Errorf("Sort = %v, want %v", value.Sort, want)
+	}
+	if want := map[string]string{"name": "Test"}; !reflect.DeepEqual(value.Filters, want) {
+		t.Errorf("Filters = %v, want %v", value.Filters, want)
+	}
+}
+
+func TestTable_Edits(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mock.NewClient(),
+		},
+	}
+
+	// Edits as sent back by the client, including ones the SDK ignores
+	widgetID := builder.generatePageID(state.WidgetTypeTable, []int{0})
+	sess.State.Set(widgetID, &state.TableState{
+		ID: widgetID,
+		Value: state.TableStateValue{
+			Edits: []state.TableStateValueEdit{
+				{Row: 0, Column: "name", OldValue: "Test 1", NewValue: "Renamed"},
+				{Row: 1, Column: "id", OldValue: "2", NewValue: "20"},
+				{Row: 1, Column: "name", OldValue: "Test 2", NewValue: "Test 2"},
+				{Row: 2, Column: "name", OldValue: "", NewValue: "Past the end"},
+				{Row: -1, Column: "name", OldValue: "", NewValue: "Negative"},
+			},
+		},
+	})
+
+	data := []testData{
+		{ID: 1, Name: "Test 1"},
+		{ID: 2, Name: "Test 2"},
+	}
+	value := builder.Table(data, table.WithEditable("name"))
+
+	want := []table.Edit{
+		{Row: 0, Column: "name", OldValue: "Test 1", NewValue: "Renamed"},
+	}
+	if !reflect.DeepEqual(value.Edits, want) {
+		t.Errorf("Edits = %v, want %v", value.Edits, want)
+	}
+
+	state := sess.State.GetTable(widgetID)
+	if want := []string{"name"}; !reflect.DeepEqual(state.EditableColumns, want) {
+		t.Errorf("EditableColumns = %v, want %v", state.EditableColumns, want)
+	}
+}
+
+func TestTable_RowActions(t *testing.T) {
+	tests := []struct {
+		name   string
+		action *state.TableStateValueAction
+		want   *table.Action
+	}{
+		{"No action", nil, nil},
+		{"Clicked", &state.TableStateValueAction{Row: 1, Name: "Reject"}, &table.Action{Row: 1, Name: "Reject"}},
+		{"Unknown action", &state.TableStateValueAction{Row: 1, Name: "Delete"}, nil},
+		{"Negative row", &state.TableStateValueAction{Row: -1, Name: "Approve"}, nil},
+		{"Row past the end", &state.TableStateValueAction{Row: 2, Name: "Approve"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessionID := uuid.Must(uuid.NewV4())
+			pageID := uuid.Must(uuid.NewV4())
+			sess := session.New(sessionID, pageID)
+
+			builder := &uiBuilder{
+				context: context.Background(),
+				session: sess,
+				cursor:  newCursor(),
+				page: &page{
+					id: pageID,
+				},
+				runtime: &runtime{
+					wsClient: mock.NewClient(),
+				},
+			}
+
+			widgetID := builder.generatePageID(state.WidgetTypeTable, []int{0})
+			sess.State.Set(widgetID, &state.TableState{
+				ID: widgetID,
+				Value: state.TableStateValue{
+					Action: tt.action,
+				},
+			})
+
+			value := builder.Table([]testData{{ID: 1}, {ID: 2}}, table.WithRowActions("Approve", "Reject"))
+
+			if !reflect.DeepEqual(value.Action, tt.want) {
+				t.Errorf("Action = %v, want %v", value.Action, tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_ColumnFormat(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	builder.Table([]taggedTestData{{ID: 1, Name: "Acme"}},
+		table.WithColumnFormat("created", table.FormatDate("MM/DD/YYYY")),
+	)
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Fatalf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	tableProto := messages[0].GetRenderWidget().GetWidget().GetTable()
+	if tableProto == nil {
+		t.Fatal("RenderWidget table = nil")
+	}
+
+	var names []string
+	for _, c := range tableProto.Columns {
+		names = append(names, c.Name)
+	}
+	if want := []string{"customer", "balance", "created", "Plan"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("column names = %v, want %v", names, want)
+	}
+	if got := tableProto.Columns[2].GetFormat().GetDateFormat(); got != "MM/DD/YYYY" {
+		t.Errorf("created date format = %q, want %q", got, "MM/DD/YYYY")
+	}
+
+	var rows []map[string]any
+	if err := json.Unmarshal(tableProto.Data, &rows); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+	if _, ok := rows[0]["id"]; ok {
+		t.Error("hidden column id was sent to the browser")
+	}
+	if tableProto.Error != "" {
+		t.Errorf("Error = %q, want empty", tableProto.Error)
+	}
+}
+
+func TestTable_InvalidColumnTag(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	type row struct {
+		Price float64 `json:"price" sourcetool:",label=Price,format=curency"`
+	}
+	builder.Table([]row{{Price: 1}})
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Fatalf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	tableProto := messages[0].GetRenderWidget().GetWidget().GetTable()
+	if want := `field Price: unknown format "curency"`; tableProto.Error != want {
+		t.Errorf("Error = %q, want %q", tableProto.Error, want)
+	}
+	// The rest of the tag still applies
+	if len(tableProto.Columns) != 1 || tableProto.Columns[0].Label != "Price" {
+		t.Errorf("Columns = %v, want the labelled price column", tableProto.Columns)
+	}
+}
+
+func TestPaginatedTable_EditsOutsidePage(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mock.NewClient(),
+		},
+	}
+
+	// Row 3 exists in the data set but not on the rendered page
+	widgetID := builder.generatePageID(state.WidgetTypeTable, []int{0})
+	sess.State.Set(widgetID, &state.TableState{
+		ID: widgetID,
+		Value: state.TableStateValue{
+			Edits: []state.TableStateValueEdit{
+				{Row: 1, Column: "name", OldValue: "b", NewValue: "B"},
+				{Row: 3, Column: "name", OldValue: "d", NewValue: "D"},
+			},
+		},
+	})
+
+	value, err := builder.PaginatedTable(func(ctx context.Context, req table.PageRequest) (any, int, error) {
+		return []testData{{ID: 1, Name: "a"}, {ID: 2, Name: "b"}}, 10, nil
+	}, table.WithPageSize(2), table.WithEditable("name"))
+	if err != nil {
+		t.Fatalf("PaginatedTable returned error: %v", err)
+	}
+
+	want := []table.Edit{
+		{Row: 1, Column: "name", OldValue: "b", NewValue: "B"},
+	}
+	if !reflect.DeepEqual(value.Edits, want) {
+		t.Errorf("Edits = %v, want %v", value.Edits, want)
+	}
+}
+
+func TestTableRowCount(t *testing.T) {
+	rows := []testData{{ID: 1}, {ID: 2}}
+
+	tests := []struct {
+		name string
+		data any
+		want int
+	}{
+		{"Nil", nil, 0},
+		{"Slice", rows, 2},
+		{"Pointer to slice", &rows, 2},
+		{"Array", [3]int{}, 3},
+		{"JSON array", json.RawMessage(`[{"id":1}]`), 1},
+		{"Struct", testData{}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tableRowCount(tt.data); got != tt.want {
+				t.Errorf("tableRowCount() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeTableSort(t *testing.T) {
+	tests := []struct {
+		name string
+		sort *state.TableStateValueSort
+		want *state.TableStateValueSort
+	}{
+		{"Nil", nil, nil},
+		{"No column", &state.TableStateValueSort{Direction: "desc"}, nil},
+		{"Descending", &state.TableStateValueSort{Column: "id", Direction: "desc"}, &state.TableStateValueSort{Column: "id", Direction: "desc"}},
+		{"Unknown direction", &state.TableStateValueSort{Column: "id", Direction: "up"}, &state.TableStateValueSort{Column: "id", Direction: "asc"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizeTableSort(tt.sort); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("normalizeTableSort() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPaginatedTable(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	var requests []table.PageRequest
+	fetch := func(ctx context.Context, req table.PageRequest) (any, int, error) {
+		requests = append(requests, req)
+		rows := make([]testData, 0, req.PageSize)
+		for i := req.Offset(); i < req.Offset()+req.PageSize && i < 25; i++ {
+			rows = append(rows, testData{ID: i})
+		}
+		return rows, 25, nil
+	}
+
+	value, err := builder.PaginatedTable(fetch, table.WithPageSize(10))
+	if err != nil {
+		t.Fatalf("PaginatedTable returned error: %v", err)
+	}
+
+	if want := []table.PageRequest{{Page: 0, PageSize: 10}}; !reflect.DeepEqual(requests, want) {
+		t.Errorf("fetch requests = %v, want %v", requests, want)
+	}
+	if want := (&table.Pagination{Page: 0, PageSize: 10, TotalRows: 25}); !reflect.DeepEqual(value.Pagination, want) {
+		t.Errorf("Pagination = %v, want %v", value.Pagination, want)
+	}
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Fatalf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	tableProto := messages[0].GetRenderWidget().GetWidget().GetTable()
+	if tableProto == nil {
+		t.Fatal("RenderWidget table = nil")
+	}
+	if !tableProto.Paginated || tableProto.TotalRows != 25 {
+		t.Errorf("Paginated = %v, TotalRows = %d, want true, 25", tableProto.Paginated, tableProto.TotalRows)
+	}
+	var rows []testData
+	if err := json.Unmarshal(tableProto.Data, &rows); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+	if len(rows) != 10 {
+		t.Errorf("rows sent = %d, want 10", len(rows))
+	}
+}
+
+func TestPaginatedTable_PageFromClient(t *testing.T) {
+	tests := []struct {
+		name     string
+		page     int32
+		pageSize int32
+		want     []table.PageRequest
+	}{
+		{"Page change", 1, 10, []table.PageRequest{{Page: 1, PageSize: 10}}},
+		{"Page size change", 0, 20, []table.PageRequest{{Page: 0, PageSize: 20}}},
+		{"Past the end", 5, 10, []table.PageRequest{{Page: 5, PageSize: 10}, {Page: 2, PageSize: 10}}},
+		{"Page size over maximum", 0, 100000, []table.PageRequest{{Page: 0, PageSize: int(maxTablePageSize)}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessionID := uuid.Must(uuid.NewV4())
+			pageID := uuid.Must(uuid.NewV4())
+			sess := session.New(sessionID, pageID)
+
+			builder := &uiBuilder{
+				context: context.Background(),
+				session: sess,
+				cursor:  newCursor(),
+				page: &page{
+					id: pageID,
+				},
+				runtime: &runtime{
+					wsClient: mock.NewClient(),
+				},
+			}
+
+			widgetID := builder.generatePageID(state.WidgetTypeTable, []int{0})
+			sess.State.Set(widgetID, &state.TableState{
+				ID: widgetID,
+				Value: state.TableStateValue{
+					Pagination: &state.TableStateValuePagination{
+						Page:     tt.page,
+						PageSize: tt.pageSize,
+					},
+				},
+			})
+
+			var requests []table.PageRequest
+			fetch := func(ctx context.Context, req table.PageRequest) (any, int, error) {
+				requests = append(requests, req)
+				return []testData{}, 25, nil
+			}
+
+			value, err := builder.PaginatedTable(fetch, table.WithPageSize(10))
+			if err != nil {
+				t.Fatalf("PaginatedTable returned error: %v", err)
+			}
+			if !reflect.DeepEqual(requests, tt.want) {
+				t.Errorf("fetch requests = %v, want %v", requests, tt.want)
+			}
+			last := tt.want[len(tt.want)-1]
+			if value.Pagination.Page != last.Page || value.Pagination.PageSize != last.PageSize {
+				t.Errorf("Pagination = %v, want page %d of size %d", value.Pagination, last.Page, last.PageSize)
+			}
+		})
+	}
+}
+
+func TestPaginatedTable_SortAndFilters(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mock.NewClient(),
+		},
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeTable, []int{0})
+	sess.State.Set(widgetID, &state.TableState{
+		ID: widgetID,
+		Value: state.TableStateValue{
+			Sort: &state.TableStateValueSort{
+				Column:    "id",
+				Direction: "desc",
+			},
+			Filters: map[string]string{"name": "Test"},
+		},
+	})
+
+	var got table.PageRequest
+	_, err := builder.PaginatedTable(func(ctx context.Context, req table.PageRequest) (any, int, error) {
+		got = req
+		return []testData{}, 0, nil
+	})
+	if err != nil {
+		t.Fatalf("PaginatedTable returned error: %v", err)
+	}
+
+	want := table.PageRequest{
+		Page:     0,
+		PageSize: 50,
+		Sort:     &table.Sort{Column: "id", Direction: table.SortDirectionDesc},
+		Filters:  map[string]string{"name": "Test"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("fetch request = %+v, want %+v", got, want)
+	}
+}
+
+func TestPaginatedTable_FetchError(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	wantErr := errors.New("database unavailable")
+	_, err := builder.PaginatedTable(func(ctx context.Context, req table.PageRequest) (any, int, error) {
+		return nil, 0, wantErr
+	})
+	if !errors.Is(err, wantErr) {
+		t.Errorf("PaginatedTable error = %v, want %v", err, wantErr)
+	}
+	if len(mockWS.Messages()) != 0 {
+		t.Errorf("WebSocket messages count = %d, want 0", len(mockWS.Messages()))
+	}
+	// Later widgets keep their IDs
+	if builder.cursor.index != 1 {
+		t.Errorf("cursor index = %d, want 1", builder.cursor.index)
+	}
+}
+
+func TestPaginatedTable_InForm(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "page")
+
+	builder := &uiBuilder{
+		context: ctx,
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mock.NewClient(),
+		},
+	}
+
+	formBuilder, _ := builder.Form("Save")
+	_, err := formBuilder.PaginatedTable(func(ctx context.Context, req table.PageRequest) (any, int, error) {
+		if ctx == nil || ctx.Value(ctxKey{}) != "page" {
+			t.Error("fetch did not receive the page context")
+		}
+		return []testData{}, 0, nil
+	})
+	if err != nil {
+		t.Fatalf("PaginatedTable returned error: %v", err)
+	}
 }
@@ -0,0 +1,319 @@
+package sourcetool
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/table"
+)
+
+// tableField is a struct field of the table rows, configured with a tag of
+// the form `sourcetool:"name,label=Full name,format=currency,hidden"`.
+type tableField struct {
+	key        string // key in the JSON encoding of the row
+	name       string
+	label      string
+	format     string
+	decimals   *int32
+	currency   string
+	dateFormat string
+	hidden     bool
+}
+
+// parseTableFields returns the fields of the rows in data when they are
+// structs with at least one sourcetool tag, and nil otherwise. Embedded
+// structs are not inspected. Unknown tag options and format values are
+// reported in the error; the fields are still returned without them.
+func parseTableFields(data any) ([]tableField, error) {
+	t := reflect.TypeOf(data)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || (t.Kind() != reflect.Slice && t.Kind() != reflect.Array) {
+		return nil, nil
+	}
+	t = t.Elem()
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, nil
+	}
+
+	var fields []tableField
+	var errs []error
+	tagged := false
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if !f.IsExported() || f.Anonymous {
+			continue
+		}
+		key := f.Name
+		if tag, ok := f.Tag.Lookup("json"); ok {
+			name, _, _ := strings.Cut(tag, ",")
+			if name == "-" {
+				continue
+			}
+			if name != "" {
+				key = name
+			}
+		}
+		field := tableField{key: key, name: key}
+		if tag, ok := f.Tag.Lookup("sourcetool"); ok {
+			tagged = true
+			if err := parseTableFieldTag(&field, tag); err != nil {
+				errs = append(errs, fmt.Errorf("field %s: %w", f.Name, err))
+			}
+		}
+		fields = append(fields, field)
+	}
+	if !tagged {
+		return nil, nil
+	}
+	return fields, errors.Join(errs...)
+}
+
+// parseTableFieldTag applies a sourcetool struct tag to field.
+func parseTableFieldTag(field *tableField, tag string) error {
+	name, opts, _ := strings.Cut(tag, ",")
+	if name != "" {
+		field.name = name
+	}
+	if opts == "" {
+		return nil
+	}
+
+	var errs []error
+	for _, opt := range strings.Split(opts, ",") {
+		switch k, v, _ := strings.Cut(opt, "="); k {
+		case "label":
+			field.label = v
+		case "format":
+			if newTableColumnFormat(table.FormatType(v), nil, "", "") == nil {
+				errs = append(errs, fmt.Errorf("unknown format %q", v))
+				continue
+			}
+			field.format = v
+		case "decimals":
+			n, err := strconv.ParseInt(v, 10, 32)
+			if err != nil || n < 0 {
+				errs = append(errs, fmt.Errorf("invalid decimals %q", v))
+				continue
+			}
+			decimals := int32(n)
+			field.decimals = &decimals
+		case "currency":
+			field.currency = v
+		case "dateformat":
+			field.dateFormat = v
+		case "hidden":
+			field.hidden = true
+		default:
+			errs = append(errs, fmt.Errorf("unknown sourcetool tag option %q", opt))
+		}
+	}
+	if field.format == "" && (field.decimals != nil || field.currency != "" || field.dateFormat != "") {
+		errs = append(errs, errors.New("decimals, currency and dateformat need a format"))
+	}
+	return errors.Join(errs...)
+}
+
+// validateTableColumnFormats reports formats set with WithColumnFormat that
+// are not known.
+func validateTableColumnFormats(formats []options.TableColumnFormat) error {
+	var errs []error
+	for _, f := range formats {
+		if newTableColumnFormat(table.FormatType(f.Type), nil, "", "") == nil {
+			errs = append(errs, fmt.Errorf("column %s: unknown format %q", f.Column, f.Type))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// resolveTableColumns returns the column configuration for data, whose
+// fields are given by parseTableFields, and the data to send to the browser.
+// Columns renamed in struct tags are renamed in the data, and hidden columns
+// are left out of it.
+func resolveTableColumns(data any, fields []tableField, formats []options.TableColumnFormat) ([]state.TableStateColumn, any, error) {
+
+	var columns []state.TableStateColumn
+	rewrite := false
+	for _, f := range fields {
+		if f.name != f.key || f.hidden {
+			rewrite = true
+		}
+		if f.hidden {
+			continue
+		}
+		column := state.TableStateColumn{
+			Name:  f.name,
+			Label: f.label,
+		}
+		if f.format != "" {
+			column.Format = newTableColumnFormat(table.FormatType(f.format), f.decimals, f.currency, f.dateFormat)
+		}
+		columns = append(columns, column)
+	}
+
+	for _, f := range formats {
+		format := newTableColumnFormat(table.FormatType(f.Type), f.Decimals, f.Currency, f.DateFormat)
+		if format == nil {
+			continue
+		}
+		found := false
+		for i := range columns {
+			if columns[i].Name == f.Column {
+				columns[i].Format = format
+				found = true
+			}
+		}
+		if !found {
+			columns = append(columns, state.TableStateColumn{
+				Name:   f.Column,
+				Format: format,
+			})
+		}
+	}
+
+	if !rewrite {
+		return columns, data, nil
+	}
+	rows, err := renameTableDataKeys(data, fields)
+	if err != nil {
+		return nil, nil, err
+	}
+	return columns, rows, nil
+}
+
+// newTableColumnFormat returns the format with defaults filled in, or nil if
+// typ is not a known format.
+func newTableColumnFormat(typ table.FormatType, decimals *int32, currency, dateFormat string) *state.TableStateColumnFormat {
+	switch typ {
+	case table.FormatTypeNumber, table.FormatTypePercent, table.FormatTypeBadge, table.FormatTypeLink:
+	case table.FormatTypeCurrency:
+		if currency == "" {
+			currency = "USD"
+		}
+	case table.FormatTypeDate:
+		if dateFormat == "" {
+			dateFormat = "YYYY/MM/DD"
+		}
+	default:
+		return nil
+	}
+	return &state.TableStateColumnFormat{
+		Type:       typ.String(),
+		Decimals:   decimals,
+		Currency:   currency,
+		DateFormat: dateFormat,
+	}
+}
+
+// tableRow is a row of table data that keeps the order of its keys when
+// encoded, so that columns follow the order of the struct fields.
+type tableRow []tableCell
+
+type tableCell struct {
+	key   string
+	value json.RawMessage
+}
+
+func (r tableRow) MarshalJSON() ([]byte, error) {
+	if r == nil {
+		return []byte("null"), nil
+	}
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, c := range r {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(c.key)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(c.value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// decodeTableRow decodes a JSON object into a tableRow, keeping the order
+// of its keys. A JSON null decodes to a nil row.
+func decodeTableRow(b json.RawMessage) (tableRow, error) {
+	if string(bytes.TrimSpace(b)) == "null" {
+		return nil, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	if tok, err := dec.Token(); err != nil {
+		return nil, err
+	} else if tok != json.Delim('{') {
+		return nil, fmt.Errorf("table row is not an object: %s", b)
+	}
+	row := tableRow{}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, err
+		}
+		row = append(row, tableCell{key: tok.(string), value: value})
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
+// renameTableDataKeys encodes data and rewrites each row so that keys use
+// the column names from struct tags and hidden fields are removed. Keys keep
+// the order of the struct fields.
+func renameTableDataKeys(data any, fields []tableField) ([]tableRow, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var raw []json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, err
+	}
+
+	fieldsByKey := make(map[string]tableField, len(fields))
+	for _, f := range fields {
+		fieldsByKey[f.key] = f
+	}
+	rows := make([]tableRow, len(raw))
+	for i, r := range raw {
+		row, err := decodeTableRow(r)
+		if err != nil {
+			return nil, err
+		}
+		if row == nil {
+			continue
+		}
+		renamed := make(tableRow, 0, len(row))
+		for _, c := range row {
+			f, ok := fieldsByKey[c.key]
+			switch {
+			case !ok:
+				renamed = append(renamed, c)
+			case !f.hidden:
+				renamed = append(renamed, tableCell{key: f.name, value: c.value})
+			}
+		}
+		rows[i] = renamed
+	}
+	return rows, nil
+}
@@ -0,0 +1,226 @@
+package sourcetool
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/table"
+)
+
+type taggedTestData struct {
+	ID        int       `json:"id" sourcetool:",hidden"`
+	Name      string    `json:"name" sourcetool:"customer,label=Customer name"`
+	Balance   float64   `json:"balance" sourcetool:",label=Balance,format=currency"`
+	CreatedAt time.Time `json:"created_at" sourcetool:"created,format=date"`
+	Note      string    `json:"-" sourcetool:"note"`
+	Plan      string
+	secret    string
+}
+
+func TestParseTableFields(t *testing.T) {
+	tests := []struct {
+		name string
+		data any
+		want []tableField
+	}{
+		{"Nil", nil, nil},
+		{"Maps", []map[string]any{{"id": 1}}, nil},
+		{"Untagged structs", []testData{}, nil},
+		{"Tagged structs", []taggedTestData{}, []tableField{
+			{key: "id", name: "id", hidden: true},
+			{key: "name", name: "customer", label: "Customer name"},
+			{key: "balance", name: "balance", label: "Balance", format: "currency"},
+			{key: "created_at", name: "created", format: "date"},
+			{key: "Plan", name: "Plan"},
+		}},
+		{"Pointer elements", []*taggedTestData{}, []tableField{
+			{key: "id", name: "id", hidden: true},
+			{key: "name", name: "customer", label: "Customer name"},
+			{key: "balance", name: "balance", label: "Balance", format: "currency"},
+			{key: "created_at", name: "created", format: "date"},
+			{key: "Plan", name: "Plan"},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseTableFields(tt.data)
+			if err != nil {
+				t.Fatalf("parseTableFields returned error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseTableFields() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTableFields_TagOptions(t *testing.T) {
+	type row struct {
+		Price   float64   `sourcetool:",format=currency,currency=EUR,decimals=0"`
+		Created time.Time `sourcetool:",format=date,dateformat=MM/DD/YYYY"`
+	}
+
+	fields, err := parseTableFields([]row{})
+	if err != nil {
+		t.Fatalf("parseTableFields returned error: %v", err)
+	}
+
+	decimals := int32(0)
+	want := []tableField{
+		{key: "Price", name: "Price", format: "currency", currency: "EUR", decimals: &decimals},
+		{key: "Created", name: "Created", format: "date", dateFormat: "MM/DD/YYYY"},
+	}
+	if !reflect.DeepEqual(fields, want) {
+		t.Errorf("parseTableFields() = %+v, want %+v", fields, want)
+	}
+
+	columns, _, err := resolveTableColumns([]row{}, fields, nil)
+	if err != nil {
+		t.Fatalf("resolveTableColumns returned error: %v", err)
+	}
+	if got := columns[0].Format; got.Currency != "EUR" || *got.Decimals != 0 {
+		t.Errorf("Price format = %+v, want EUR with 0 decimals", got)
+	}
+	if got := columns[1].Format.DateFormat; got != "MM/DD/YYYY" {
+		t.Errorf("Created date format = %q, want %q", got, "MM/DD/YYYY")
+	}
+}
+
+func TestParseTableFields_InvalidTags(t *testing.T) {
+	tests := []struct {
+		name string
+		data any
+		want string
+	}{
+		{"Misspelled format", []struct {
+			Price float64 `sourcetool:",format=curency"`
+		}{}, `field Price: unknown format "curency"`},
+		{"Unknown option", []struct {
+			Price float64 `sourcetool:",lable=Price"`
+		}{}, `field Price: unknown sourcetool tag option "lable=Price"`},
+		{"Invalid decimals", []struct {
+			Price float64 `sourcetool:",format=number,decimals=two"`
+		}{}, `field Price: invalid decimals "two"`},
+		{"Currency without format", []struct {
+			Price float64 `sourcetool:",currency=EUR"`
+		}{}, "field Price: decimals, currency and dateformat need a format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields, err := parseTableFields(tt.data)
+			if err == nil || err.Error() != tt.want {
+				t.Errorf("parseTableFields() error = %v, want %q", err, tt.want)
+			}
+			// The field is still returned, without the invalid option
+			if len(fields) != 1 {
+				t.Errorf("parseTableFields() = %+v, want one field", fields)
+			}
+		})
+	}
+}
+
+func TestValidateTableColumnFormats(t *testing.T) {
+	if err := validateTableColumnFormats([]options.TableColumnFormat{
+		{Column: "balance", Type: table.FormatTypeCurrency.String()},
+	}); err != nil {
+		t.Errorf("validateTableColumnFormats() error = %v, want nil", err)
+	}
+
+	err := validateTableColumnFormats([]options.TableColumnFormat{
+		{Column: "name", Type: "bold"},
+	})
+	if want := `column name: unknown format "bold"`; err == nil || err.Error() != want {
+		t.Errorf("validateTableColumnFormats() error = %v, want %q", err, want)
+	}
+}
+
+func TestResolveTableColumns(t *testing.T) {
+	data := []taggedTestData{
+		{ID: 1, Name: "Acme", Balance: 12.5, Plan: "Pro", secret: "x"},
+	}
+	decimals := int32(0)
+	fields, err := parseTableFields(data)
+	if err != nil {
+		t.Fatalf("parseTableFields returned error: %v", err)
+	}
+
+	columns, got, err := resolveTableColumns(data, fields, []options.TableColumnFormat{
+		{Column: "balance", Type: table.FormatTypeCurrency.String(), Decimals: &decimals, Currency: "EUR"},
+		{Column: "Plan", Type: table.FormatTypeBadge.String()},
+		{Column: "score", Type: table.FormatTypePercent.String()},
+		{Column: "ignored", Type: "unknown"},
+	})
+	if err != nil {
+		t.Fatalf("resolveTableColumns returned error: %v", err)
+	}
+
+	wantColumns := []state.TableStateColumn{
+		{Name: "customer", Label: "Customer name"},
+		{Name: "balance", Label: "Balance", Format: &state.TableStateColumnFormat{Type: "currency", Decimals: &decimals, Currency: "EUR"}},
+		{Name: "created", Format: &state.TableStateColumnFormat{Type: "date", DateFormat: "YYYY/MM/DD"}},
+		{Name: "Plan", Format: &state.TableStateColumnFormat{Type: "badge"}},
+		{Name: "score", Format: &state.TableStateColumnFormat{Type: "percent"}},
+	}
+	if !reflect.DeepEqual(columns, wantColumns) {
+		t.Errorf("columns = %+v, want %+v", columns, wantColumns)
+	}
+
+	b, err := json.Marshal(got)
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+	var rows []map[string]any
+	if err := json.Unmarshal(b, &rows); err != nil {
+		t.Fatalf("json.Unmarshal returned error: %v", err)
+	}
+	wantRows := []map[string]any{
+		{"customer": "Acme", "balance": 12.5, "created": "0001-01-01T00:00:00Z", "Plan": "Pro"},
+	}
+	if !reflect.DeepEqual(rows, wantRows) {
+		t.Errorf("rows = %v, want %v", rows, wantRows)
+	}
+}
+
+func TestResolveTableColumns_KeyOrder(t *testing.T) {
+	data := []*taggedTestData{{ID: 1, Name: "Acme", Balance: 12.5, Plan: "Pro"}, nil}
+	fields, err := parseTableFields(data)
+	if err != nil {
+		t.Fatalf("parseTableFields returned error: %v", err)
+	}
+
+	_, got, err := resolveTableColumns(data, fields, nil)
+	if err != nil {
+		t.Fatalf("resolveTableColumns returned error: %v", err)
+	}
+	b, err := json.Marshal(got)
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %v", err)
+	}
+
+	// Keys follow the struct fields, not alphabetical order
+	want := `[{"customer":"Acme","balance":12.5,"created":"0001-01-01T00:00:00Z","Plan":"Pro"},null]`
+	if string(b) != want {
+		t.Errorf("data = %s, want %s", b, want)
+	}
+}
+
+func TestResolveTableColumns_Untagged(t *testing.T) {
+	data := []testData{{ID: 1, Name: "Test 1"}}
+
+	columns, got, err := resolveTableColumns(data, nil, nil)
+	if err != nil {
+		t.Fatalf("resolveTableColumns returned error: %v", err)
+	}
+	if columns != nil {
+		t.Errorf("columns = %v, want nil", columns)
+	}
+	if !reflect.DeepEqual(got, data) {
+		t.Errorf("data = %v, want %v", got, data)
+	}
+}
@@ -0,0 +1,124 @@
+package sourcetool
+
+import (
+	"github.com/gofrs/uuid/v5"
+
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+)
+
+func (b *uiBuilder) Tabs(labels ...string) []UIBuilder {
+	if len(labels) < 1 {
+		return nil
+	}
+
+	sess := b.session
+	if sess == nil {
+		return nil
+	}
+	page := b.page
+	if page == nil {
+		return nil
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return nil
+	}
+	path := cursor.getPath()
+
+	widgetID := b.generatePageID(state.WidgetTypeTabs, path)
+	tabsState := sess.State.GetTabs(widgetID)
+	if tabsState == nil {
+		tabsState = &state.TabsState{
+			ID:    widgetID,
+			Value: 0,
+		}
+	}
+	if tabsState.Value < 0 || tabsState.Value >= len(labels) {
+		tabsState.Value = 0
+	}
+	tabsState.Tabs = len(labels)
+	sess.State.Set(widgetID, tabsState)
+
+	tabs := convertStateToTabsProto(tabsState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_Tabs{
+				Tabs: tabs,
+			},
+		},
+	})
+
+	builders := make([]UIBuilder, len(labels))
+	for i, label := range labels {
+		tabPath := append(path[:len(path):len(path)], i)
+
+		tabCursor := newCursor()
+		tabCursor.parentPath = tabPath
+
+		widgetID := b.generatePageID(state.WidgetTypeTabItem, tabPath)
+		tabItemState := &state.TabItemState{
+			ID:    widgetID,
+			Label: label,
+		}
+		sess.State.Set(widgetID, tabItemState)
+
+		tabItem := convertStateToTabItemProto(tabItemState)
+		b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+			SessionId: sess.ID.String(),
+			PageId:    page.id.String(),
+			Path:      convertPathToInt32Slice(tabPath),
+			Widget: &widgetv1.Widget{
+				Id: widgetID.String(),
+				Type: &widgetv1.Widget_TabItem{
+					TabItem: tabItem,
+				},
+			},
+		})
+
+		builders[i] = &uiBuilder{
+			runtime: b.runtime,
+			context: b.context,
+			cursor:  tabCursor,
+			session: sess,
+			page:    page,
+		}
+	}
+
+	cursor.next()
+
+	return builders
+}
+
+func convertStateToTabsProto(state *state.TabsState) *widgetv1.Tabs {
+	return &widgetv1.Tabs{
+		Value: int32(state.Value),
+		Tabs:  int32(state.Tabs),
+	}
+}
+
+func convertTabsProtoToState(id uuid.UUID, data *widgetv1.Tabs) *state.TabsState {
+	return &state.TabsState{
+		ID:    id,
+		Value: int(data.Value),
+		Tabs:  int(data.Tabs),
+	}
+}
+
+func convertStateToTabItemProto(state *state.TabItemState) *widgetv1.TabItem {
+	return &widgetv1.TabItem{
+		Label: state.Label,
+	}
+}
+
+func convertTabItemProtoToState(id uuid.UUID, data *widgetv1.TabItem) *state.TabItemState {
+	return &state.TabItemState{
+		ID:    id,
+		Label: data.Label,
+	}
+}
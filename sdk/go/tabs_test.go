@@ -0,0 +1,223 @@
+package sourcetool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+)
+
+func TestConvertStateToTabsProto(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	tabsState := &state.TabsState{
+		ID:    id,
+		Value: 1,
+		Tabs:  3,
+	}
+
+	data := convertStateToTabsProto(tabsState)
+
+	if data == nil {
+		t.Fatal("convertStateToTabsProto returned nil")
+	}
+
+	if int(data.Value) != tabsState.Value {
+		t.Errorf("Value = %v, want %v", data.Value, tabsState.Value)
+	}
+	if int(data.Tabs) != tabsState.Tabs {
+		t.Errorf("Tabs = %v, want %v", data.Tabs, tabsState.Tabs)
+	}
+}
+
+func TestConvertTabsProtoToState(t *testing.T) {
+	data := &widgetv1.Tabs{
+		Value: 1,
+		Tabs:  3,
+	}
+
+	state := convertTabsProtoToState(uuid.Must(uuid.NewV4()), data)
+
+	if state == nil {
+		t.Fatal("convertTabsProtoToState returned nil")
+	}
+
+	if state.Value != int(data.Value) {
+		t.Errorf("Value = %v, want %v", state.Value, data.Value)
+	}
+	if state.Tabs != int(data.Tabs) {
+		t.Errorf("Tabs = %v, want %v", state.Tabs, data.Tabs)
+	}
+}
+
+func TestConvertStateToTabItemProto(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	tabItemState := &state.TabItemState{
+		ID:    id,
+		Label: "Overview",
+	}
+
+	data := convertStateToTabItemProto(tabItemState)
+
+	if data == nil {
+		t.Fatal("convertStateToTabItemProto returned nil")
+	}
+
+	if data.Label != tabItemState.Label {
+		t.Errorf("Label = %v, want %v", data.Label, tabItemState.Label)
+	}
+}
+
+func TestConvertTabItemProtoToState(t *testing.T) {
+	data := &widgetv1.TabItem{
+		Label: "Overview",
+	}
+
+	state := convertTabItemProtoToState(uuid.Must(uuid.NewV4()), data)
+
+	if state == nil {
+		t.Fatal("convertTabItemProtoToState returned nil")
+	}
+
+	if state.Label != data.Label {
+		t.Errorf("Label = %v, want %v", state.Label, data.Label)
+	}
+}
+
+func TestTabs(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	labels := []string{"Overview", "Settings", "Logs"}
+	builders := builder.Tabs(labels...)
+
+	if builders == nil {
+		t.Fatal("Tabs returned nil")
+	}
+	if len(builders) != len(labels) {
+		t.Errorf("Builders length = %v, want %v", len(builders), len(labels))
+	}
+
+	messages := mockWS.Messages()
+	expectedMessages := len(labels) + 1 // tabs widget + tab items
+	if len(messages) != expectedMessages {
+		t.Errorf("WebSocket messages count = %d, want %d", len(messages), expectedMessages)
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeTabs, []int{0})
+	tabsState := sess.State.GetTabs(widgetID)
+	if tabsState == nil {
+		t.Fatal("Tabs state not found")
+	}
+
+	if tabsState.Value != 0 {
+		t.Errorf("Value = %v, want 0", tabsState.Value)
+	}
+	if tabsState.Tabs != len(labels) {
+		t.Errorf("Tabs = %v, want %v", tabsState.Tabs, len(labels))
+	}
+
+	for i, label := range labels {
+		tabPath := []int{0, i}
+		tabID := builder.generatePageID(state.WidgetTypeTabItem, tabPath)
+		tabState := sess.State.Get(tabID)
+		if tabState == nil {
+			t.Fatalf("Tab item state not found for index %d", i)
+		}
+
+		tabItemState, ok := tabState.(*state.TabItemState)
+		if !ok {
+			t.Fatalf("Tab item state[%d] is not *state.TabItemState", i)
+		}
+		if tabItemState.Label != label {
+			t.Errorf("Tab item label[%d] = %v, want %v", i, tabItemState.Label, label)
+		}
+	}
+
+	// Widgets inside a tab are placed under the tab's path
+	builders[1].Markdown("content")
+	markdownID := builder.generatePageID(state.WidgetTypeMarkdown, []int{0, 1, 0})
+	if sess.State.GetMarkdown(markdownID) == nil {
+		t.Error("Markdown state inside tab not found")
+	}
+}
+
+func TestTabs_KeepsSelectedTab(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mock.NewClient(),
+		},
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeTabs, []int{0})
+	sess.State.Set(widgetID, &state.TabsState{
+		ID:    widgetID,
+		Value: 2,
+		Tabs:  3,
+	})
+
+	builder.Tabs("A", "B", "C")
+
+	if got := sess.State.GetTabs(widgetID).Value; got != 2 {
+		t.Errorf("Value = %v, want 2", got)
+	}
+
+	// An out of range selection falls back to the first tab
+	builder.cursor = newCursor()
+	builder.Tabs("A", "B")
+
+	if got := sess.State.GetTabs(widgetID).Value; got != 0 {
+		t.Errorf("Value after removing tabs = %v, want 0", got)
+	}
+}
+
+func TestTabs_InvalidInput(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mock.NewClient(),
+		},
+	}
+
+	if builders := builder.Tabs(); builders != nil {
+		t.Error("Expected nil builders for no labels")
+	}
+}
@@ -0,0 +1,136 @@
+package sourcetool
+
+import (
+	"strings"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/taginput"
+)
+
+func (b *uiBuilder) TagInput(label string, opts ...taginput.Option) []string {
+	tagInputOpts := &options.TagInputOptions{
+		Label:        label,
+		Placeholder:  "",
+		DefaultValue: nil,
+		Required:     false,
+		Disabled:     false,
+		Suggestions:  nil,
+		MaxTags:      nil,
+	}
+
+	for _, o := range opts {
+		o.Apply(tagInputOpts)
+	}
+
+	sess := b.session
+	if sess == nil {
+		return []string{}
+	}
+	page := b.page
+	if page == nil {
+		return []string{}
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return []string{}
+	}
+	path := cursor.getPath()
+
+	defaultValue := normalizeTags(tagInputOpts.DefaultValue, tagInputOpts.MaxTags)
+
+	widgetID := b.generatePageID(state.WidgetTypeTagInput, path)
+	tagInputState := sess.State.GetTagInput(widgetID)
+	if tagInputState == nil {
+		tagInputState = &state.TagInputState{
+			ID:    widgetID,
+			Value: defaultValue,
+		}
+	}
+	tagInputState.Value = normalizeTags(tagInputState.Value, tagInputOpts.MaxTags)
+	tagInputState.Label = tagInputOpts.Label
+	tagInputState.Placeholder = tagInputOpts.Placeholder
+	tagInputState.DefaultValue = defaultValue
+	tagInputState.Required = tagInputOpts.Required
+	tagInputState.Disabled = tagInputOpts.Disabled
+	tagInputState.Suggestions = tagInputOpts.Suggestions
+	tagInputState.MaxTags = tagInputOpts.MaxTags
+	sess.State.Set(widgetID, tagInputState)
+
+	tagInputProto := convertStateToTagInputProto(tagInputState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_TagInput{
+				TagInput: tagInputProto,
+			},
+		},
+	})
+
+	cursor.next()
+
+	return tagInputState.Value
+}
+
+// normalizeTags trims surrounding whitespace, drops empty and duplicate
+// tags and keeps at most maxTags of them. A maxTags of zero or less means
+// no limit.
+func normalizeTags(tags []string, maxTags *int32) []string {
+	result := make([]string, 0, len(tags))
+	seen := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		if maxTags != nil && *maxTags > 0 && len(result) >= int(*maxTags) {
+			break
+		}
+		seen[tag] = struct{}{}
+		result = append(result, tag)
+	}
+	return result
+}
+
+func convertStateToTagInputProto(state *state.TagInputState) *widgetv1.TagInput {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.TagInput{
+		Value:        state.Value,
+		Label:        state.Label,
+		Placeholder:  state.Placeholder,
+		DefaultValue: state.DefaultValue,
+		Required:     state.Required,
+		Disabled:     state.Disabled,
+		Suggestions:  state.Suggestions,
+		MaxTags:      state.MaxTags,
+	}
+}
+
+func convertTagInputProtoToState(id uuid.UUID, data *widgetv1.TagInput) *state.TagInputState {
+	if data == nil {
+		return nil
+	}
+	return &state.TagInputState{
+		ID:           id,
+		Label:        data.Label,
+		Value:        data.Value,
+		Placeholder:  data.Placeholder,
+		DefaultValue: data.DefaultValue,
+		Required:     data.Required,
+		Disabled:     data.Disabled,
+		Suggestions:  data.Suggestions,
+		MaxTags:      data.MaxTags,
+	}
+}
@@ -0,0 +1,69 @@
+package taginput
+
+import "github.com/trysourcetool/sourcetool-go/internal/options"
+
+type Option interface {
+	Apply(*options.TagInputOptions)
+}
+
+type placeholderOption string
+
+func (p placeholderOption) Apply(opts *options.TagInputOptions) {
+	opts.Placeholder = string(p)
+}
+
+func WithPlaceholder(placeholder string) Option {
+	return placeholderOption(placeholder)
+}
+
+type defaultValueOption []string
+
+func (d defaultValueOption) Apply(opts *options.TagInputOptions) {
+	opts.DefaultValue = []string(d)
+}
+
+func WithDefaultValue(defaultValue ...string) Option {
+	return defaultValueOption(defaultValue)
+}
+
+type requiredOption bool
+
+func (r requiredOption) Apply(opts *options.TagInputOptions) {
+	opts.Required = bool(r)
+}
+
+func WithRequired(required bool) Option {
+	return requiredOption(required)
+}
+
+type disabledOption bool
+
+func (d disabledOption) Apply(opts *options.TagInputOptions) {
+	opts.Disabled = bool(d)
+}
+
+func WithDisabled(disabled bool) Option {
+	return disabledOption(disabled)
+}
+
+type suggestionsOption []string
+
+func (s suggestionsOption) Apply(opts *options.TagInputOptions) {
+	opts.Suggestions = []string(s)
+}
+
+// WithSuggestions offers values to autocomplete while typing. Users can
+// still enter values that are not suggested.
+func WithSuggestions(suggestions ...string) Option {
+	return suggestionsOption(suggestions)
+}
+
+type maxTagsOption int32
+
+func (m maxTagsOption) Apply(opts *options.TagInputOptions) {
+	opts.MaxTags = (*int32)(&m)
+}
+
+func WithMaxTags(maxTags int32) Option {
+	return maxTagsOption(maxTags)
+}
@@ -0,0 +1,235 @@
+package sourcetool
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+	"github.com/trysourcetool/sourcetool-go/taginput"
+)
+
+func TestConvertStateToTagInputProto(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	maxTags := int32(5)
+
+	tagInputState := &state.TagInputState{
+		ID:           id,
+		Label:        "Test TagInput",
+		Value:        []string{"alice@example.com", "bob@example.com"},
+		Placeholder:  "Add email",
+		DefaultValue: []string{"alice@example.com"},
+		Required:     true,
+		Disabled:     false,
+		Suggestions:  []string{"carol@example.com"},
+		MaxTags:      &maxTags,
+	}
+
+	data := convertStateToTagInputProto(tagInputState)
+
+	if data == nil {
+		t.Fatal("convertStateToTagInputProto returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", data.Label, tagInputState.Label},
+		{"Value", data.Value, tagInputState.Value},
+		{"Placeholder", data.Placeholder, tagInputState.Placeholder},
+		{"DefaultValue", data.DefaultValue, tagInputState.DefaultValue},
+		{"Required", data.Required, tagInputState.Required},
+		{"Disabled", data.Disabled, tagInputState.Disabled},
+		{"Suggestions", data.Suggestions, tagInputState.Suggestions},
+		{"MaxTags", *data.MaxTags, *tagInputState.MaxTags},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertTagInputProtoToState(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	maxTags := int32(5)
+
+	data := &widgetv1.TagInput{
+		Label:        "Test TagInput",
+		Value:        []string{"SKU-1", "SKU-2"},
+		Placeholder:  "Add SKU",
+		DefaultValue: []string{"SKU-1"},
+		Required:     true,
+		Disabled:     false,
+		Suggestions:  []string{"SKU-3"},
+		MaxTags:      &maxTags,
+	}
+
+	state := convertTagInputProtoToState(id, data)
+
+	if state == nil {
+		t.Fatal("convertTagInputProtoToState returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"ID", state.ID, id},
+		{"Label", state.Label, data.Label},
+		{"Value", state.Value, data.Value},
+		{"Placeholder", state.Placeholder, data.Placeholder},
+		{"DefaultValue", state.DefaultValue, data.DefaultValue},
+		{"Required", state.Required, data.Required},
+		{"Disabled", state.Disabled, data.Disabled},
+		{"Suggestions", state.Suggestions, data.Suggestions},
+		{"MaxTags", *state.MaxTags, *data.MaxTags},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTagInput(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	label := "Test TagInput"
+	value := builder.TagInput(label,
+		taginput.WithDefaultValue("bug", "ui"),
+		taginput.WithPlaceholder("Add label"),
+		taginput.WithRequired(true),
+		taginput.WithDisabled(true),
+		taginput.WithSuggestions("bug", "ui", "backend"),
+		taginput.WithMaxTags(3),
+	)
+
+	if want := []string{"bug", "ui"}; !reflect.DeepEqual(value, want) {
+		t.Errorf("TagInput value = %v, want %v", value, want)
+	}
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Errorf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	msg := messages[0]
+	if v := msg.GetRenderWidget(); v == nil {
+		t.Fatal("WebSocket message type = nil, want RenderWidget")
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeTagInput, []int{0})
+	state := sess.State.GetTagInput(widgetID)
+	if state == nil {
+		t.Fatal("TagInput state not found")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", state.Label, label},
+		{"Value", state.Value, []string{"bug", "ui"}},
+		{"Placeholder", state.Placeholder, "Add label"},
+		{"DefaultValue", state.DefaultValue, []string{"bug", "ui"}},
+		{"Required", state.Required, true},
+		{"Disabled", state.Disabled, true},
+		{"Suggestions", state.Suggestions, []string{"bug", "ui", "backend"}},
+		{"MaxTags", *state.MaxTags, int32(3)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTagInput_NormalizesValue(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mock.NewClient(),
+		},
+	}
+
+	// Values sent by the client are cleaned up before the page sees them
+	widgetID := builder.generatePageID(state.WidgetTypeTagInput, []int{0})
+	sess.State.Set(widgetID, &state.TagInputState{
+		ID:    widgetID,
+		Value: []string{" a ", "", "b", "a", "c", "d"},
+	})
+
+	value := builder.TagInput("Tags", taginput.WithMaxTags(3))
+
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(value, want) {
+		t.Errorf("TagInput value = %v, want %v", value, want)
+	}
+}
+
+func TestNormalizeTags(t *testing.T) {
+	zero := int32(0)
+	two := int32(2)
+
+	tests := []struct {
+		name    string
+		tags    []string
+		maxTags *int32
+		want    []string
+	}{
+		{"Nil", nil, nil, []string{}},
+		{"Trim and dedupe", []string{" x", "x ", "y"}, nil, []string{"x", "y"}},
+		{"Max tags", []string{"x", "y", "z"}, &two, []string{"x", "y"}},
+		{"Zero means unlimited", []string{"x", "y", "z"}, &zero, []string{"x", "y", "z"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizeTags(tt.tags, tt.maxTags); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("normalizeTags() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package sourcetool
+
+import (
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	"github.com/trysourcetool/sourcetool-go/toast"
+)
+
+// Toast shows a transient notification. Unlike widgets it is not part of the
+// widget tree, so it does not advance the cursor or change the IDs of the
+// widgets rendered after it.
+func (b *uiBuilder) Toast(message string, opts ...toast.Option) {
+	toastOpts := &options.ToastOptions{
+		Message: message,
+		Level:   toast.LevelInfo.String(),
+	}
+
+	for _, o := range opts {
+		o.Apply(toastOpts)
+	}
+
+	sess := b.session
+	if sess == nil {
+		return
+	}
+	page := b.page
+	if page == nil {
+		return
+	}
+
+	msg := &websocketv1.Toast{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Message:   toastOpts.Message,
+		Level:     toastOpts.Level,
+	}
+	if toastOpts.Duration > 0 {
+		duration := int32(toastOpts.Duration.Milliseconds())
+		msg.DurationMs = &duration
+	}
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), msg)
+}
@@ -0,0 +1,32 @@
+package toast
+
+import (
+	"time"
+
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+)
+
+type Option interface {
+	Apply(*options.ToastOptions)
+}
+
+type levelOption Level
+
+func (l levelOption) Apply(opts *options.ToastOptions) {
+	opts.Level = Level(l).String()
+}
+
+func WithLevel(level Level) Option {
+	return levelOption(level)
+}
+
+type durationOption time.Duration
+
+func (d durationOption) Apply(opts *options.ToastOptions) {
+	opts.Duration = time.Duration(d)
+}
+
+// WithDuration sets how long the toast stays visible before it is dismissed automatically.
+func WithDuration(duration time.Duration) Option {
+	return durationOption(duration)
+}
@@ -0,0 +1,14 @@
+package toast
+
+type Level string
+
+const (
+	LevelInfo    Level = "info"
+	LevelSuccess Level = "success"
+	LevelWarning Level = "warning"
+	LevelError   Level = "error"
+)
+
+func (l Level) String() string {
+	return string(l)
+}
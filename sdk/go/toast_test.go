@@ -0,0 +1,105 @@
+package sourcetool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+	"github.com/trysourcetool/sourcetool-go/toast"
+)
+
+func TestToast(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	message := "Saved"
+	builder.Toast(message, toast.WithLevel(toast.LevelSuccess), toast.WithDuration(3*time.Second))
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Fatalf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	msg := messages[0].GetToast()
+	if msg == nil {
+		t.Fatal("WebSocket message type = nil, want Toast")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"SessionId", msg.SessionId, sessionID.String()},
+		{"PageId", msg.PageId, pageID.String()},
+		{"Message", msg.Message, message},
+		{"Level", msg.Level, toast.LevelSuccess.String()},
+		{"DurationMs", msg.GetDurationMs(), int32(3000)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToast_DoesNotAdvanceCursor(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	builder.Toast("Hello")
+	builder.Markdown("after toast")
+
+	msg := mockWS.Messages()[0].GetToast()
+	if msg == nil {
+		t.Fatal("WebSocket message type = nil, want Toast")
+	}
+	if msg.Level != toast.LevelInfo.String() {
+		t.Errorf("Default Level = %v, want %v", msg.Level, toast.LevelInfo)
+	}
+	if msg.DurationMs != nil {
+		t.Errorf("Default DurationMs = %v, want nil", *msg.DurationMs)
+	}
+
+	markdownID := builder.generatePageID(state.WidgetTypeMarkdown, []int{0})
+	if sess.State.GetMarkdown(markdownID) == nil {
+		t.Error("Markdown after toast is not at path [0]")
+	}
+}
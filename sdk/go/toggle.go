@@ -0,0 +1,96 @@
+package sourcetool
+
+import (
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/toggle"
+)
+
+func (b *uiBuilder) Toggle(label string, opts ...toggle.Option) bool {
+	toggleOpts := &options.ToggleOptions{
+		Label:         label,
+		DefaultValue:  false,
+		Disabled:      false,
+		RerunOnChange: false,
+	}
+
+	for _, o := range opts {
+		o.Apply(toggleOpts)
+	}
+
+	sess := b.session
+	if sess == nil {
+		return false
+	}
+	page := b.page
+	if page == nil {
+		return false
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return false
+	}
+	path := cursor.getPath()
+
+	widgetID := b.generatePageID(state.WidgetTypeToggle, path)
+	toggleState := sess.State.GetToggle(widgetID)
+	if toggleState == nil {
+		toggleState = &state.ToggleState{
+			ID:    widgetID,
+			Value: toggleOpts.DefaultValue,
+		}
+	}
+	toggleState.Label = toggleOpts.Label
+	toggleState.DefaultValue = toggleOpts.DefaultValue
+	toggleState.Disabled = toggleOpts.Disabled
+	toggleState.RerunOnChange = toggleOpts.RerunOnChange
+	sess.State.Set(widgetID, toggleState)
+
+	toggleProto := convertStateToToggleProto(toggleState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_Toggle{
+				Toggle: toggleProto,
+			},
+		},
+	})
+
+	cursor.next()
+
+	return toggleState.Value
+}
+
+func convertStateToToggleProto(state *state.ToggleState) *widgetv1.Toggle {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.Toggle{
+		Value:         state.Value,
+		Label:         state.Label,
+		DefaultValue:  state.DefaultValue,
+		Disabled:      state.Disabled,
+		RerunOnChange: state.RerunOnChange,
+	}
+}
+
+func convertToggleProtoToState(id uuid.UUID, data *widgetv1.Toggle) *state.ToggleState {
+	if data == nil {
+		return nil
+	}
+	return &state.ToggleState{
+		ID:            id,
+		Value:         data.Value,
+		Label:         data.Label,
+		DefaultValue:  data.DefaultValue,
+		Disabled:      data.Disabled,
+		RerunOnChange: data.RerunOnChange,
+	}
+}
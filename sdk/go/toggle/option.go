@@ -0,0 +1,40 @@
+package toggle
+
+import "github.com/trysourcetool/sourcetool-go/internal/options"
+
+type Option interface {
+	Apply(*options.ToggleOptions)
+}
+
+type defaultValueOption bool
+
+func (d defaultValueOption) Apply(opts *options.ToggleOptions) {
+	opts.DefaultValue = bool(d)
+}
+
+func WithDefaultValue(defaultValue bool) Option {
+	return defaultValueOption(defaultValue)
+}
+
+type disabledOption bool
+
+func (d disabledOption) Apply(opts *options.ToggleOptions) {
+	opts.Disabled = bool(d)
+}
+
+func WithDisabled(disabled bool) Option {
+	return disabledOption(disabled)
+}
+
+type rerunOnChangeOption bool
+
+func (r rerunOnChangeOption) Apply(opts *options.ToggleOptions) {
+	opts.RerunOnChange = bool(r)
+}
+
+// WithRerunOnChange makes the client rerun the page as soon as the toggle
+// is flipped, instead of waiting for the next rerun triggered by another
+// widget. It has no effect inside a form, where values are sent on submit.
+func WithRerunOnChange(rerunOnChange bool) Option {
+	return rerunOnChangeOption(rerunOnChange)
+}
@@ -0,0 +1,160 @@
+package sourcetool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+	"github.com/trysourcetool/sourcetool-go/toggle"
+)
+
+func TestConvertStateToToggleProto(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+
+	toggleState := &state.ToggleState{
+		ID:            id,
+		Label:         "Test Toggle",
+		Value:         true,
+		DefaultValue:  false,
+		RerunOnChange: true,
+		Disabled:      false,
+	}
+
+	data := convertStateToToggleProto(toggleState)
+
+	if data == nil {
+		t.Fatal("convertStateToToggleProto returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", data.Label, toggleState.Label},
+		{"Value", data.Value, toggleState.Value},
+		{"DefaultValue", data.DefaultValue, toggleState.DefaultValue},
+		{"RerunOnChange", data.RerunOnChange, toggleState.RerunOnChange},
+		{"Disabled", data.Disabled, toggleState.Disabled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertToggleProtoToState(t *testing.T) {
+	data := &widgetv1.Toggle{
+		Label:         "Test Toggle",
+		Value:         true,
+		DefaultValue:  false,
+		RerunOnChange: true,
+		Disabled:      false,
+	}
+
+	state := convertToggleProtoToState(uuid.Must(uuid.NewV4()), data)
+
+	if state == nil {
+		t.Fatal("convertToggleProtoToState returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"ID", state.ID, state.ID},
+		{"Label", state.Label, data.Label},
+		{"Value", state.Value, data.Value},
+		{"DefaultValue", state.DefaultValue, data.DefaultValue},
+		{"RerunOnChange", state.RerunOnChange, data.RerunOnChange},
+		{"Disabled", state.Disabled, data.Disabled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToggle(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	label := "Test Toggle"
+
+	// Create Toggle component with all options
+	value := builder.Toggle(label,
+		toggle.WithDefaultValue(true),
+		toggle.WithRerunOnChange(true),
+		toggle.WithDisabled(true),
+	)
+
+	// Verify return value
+	if !value {
+		t.Error("Toggle value = false, want true")
+	}
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Errorf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	msg := messages[0]
+	if v := msg.GetRenderWidget(); v == nil {
+		t.Fatal("WebSocket message type = nil, want RenderWidget")
+	}
+
+	// Verify state
+	widgetID := builder.generatePageID(state.WidgetTypeToggle, []int{0})
+	state := sess.State.GetToggle(widgetID)
+	if state == nil {
+		t.Fatal("Toggle state not found")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", state.Label, label},
+		{"Value", state.Value, true},
+		{"DefaultValue", state.DefaultValue, true},
+		{"RerunOnChange", state.RerunOnChange, true},
+		{"Disabled", state.Disabled, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
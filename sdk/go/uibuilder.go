@@ -3,49 +3,96 @@ package sourcetool
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gofrs/uuid/v5"
 
+	"github.com/trysourcetool/sourcetool-go/alert"
 	"github.com/trysourcetool/sourcetool-go/button"
+	"github.com/trysourcetool/sourcetool-go/chart"
 	"github.com/trysourcetool/sourcetool-go/checkbox"
 	"github.com/trysourcetool/sourcetool-go/checkboxgroup"
+	"github.com/trysourcetool/sourcetool-go/codeeditor"
+	"github.com/trysourcetool/sourcetool-go/colorinput"
 	"github.com/trysourcetool/sourcetool-go/columns"
 	"github.com/trysourcetool/sourcetool-go/dateinput"
+	"github.com/trysourcetool/sourcetool-go/daterangeinput"
 	"github.com/trysourcetool/sourcetool-go/datetimeinput"
+	"github.com/trysourcetool/sourcetool-go/dialog"
+	"github.com/trysourcetool/sourcetool-go/downloadbutton"
+	"github.com/trysourcetool/sourcetool-go/expander"
+	"github.com/trysourcetool/sourcetool-go/fileinput"
 	"github.com/trysourcetool/sourcetool-go/form"
+	"github.com/trysourcetool/sourcetool-go/image"
 	"github.com/trysourcetool/sourcetool-go/internal/session"
 	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/json"
+	"github.com/trysourcetool/sourcetool-go/metric"
 	"github.com/trysourcetool/sourcetool-go/multiselect"
 	"github.com/trysourcetool/sourcetool-go/numberinput"
 	"github.com/trysourcetool/sourcetool-go/radio"
+	"github.com/trysourcetool/sourcetool-go/rangeslider"
 	"github.com/trysourcetool/sourcetool-go/selectbox"
+	"github.com/trysourcetool/sourcetool-go/slider"
 	"github.com/trysourcetool/sourcetool-go/table"
+	"github.com/trysourcetool/sourcetool-go/taginput"
 	"github.com/trysourcetool/sourcetool-go/textarea"
 	"github.com/trysourcetool/sourcetool-go/textinput"
 	"github.com/trysourcetool/sourcetool-go/timeinput"
+	"github.com/trysourcetool/sourcetool-go/toast"
+	"github.com/trysourcetool/sourcetool-go/toggle"
 )
 
 type UIBuilder interface {
 	Context() context.Context
+	QueryParams() url.Values
 	Markdown(string)
+	Header(string)
+	Subheader(string)
+	Caption(string)
+	Divider()
+	Spacer()
+	Link(string, string)
+	PageLink(string, string, map[string]string)
+	Toast(string, ...toast.Option)
+	Alert(alert.Level, string)
+	Metric(string, any, ...metric.Option)
+	Progress(string) *Progress
+	Spinner(string) *Spinner
 	TextInput(string, ...textinput.Option) string
 	NumberInput(string, ...numberinput.Option) *float64
+	Slider(string, ...slider.Option) float64
+	RangeSlider(string, ...rangeslider.Option) (float64, float64)
 	DateInput(string, ...dateinput.Option) *time.Time
+	DateRangeInput(string, ...daterangeinput.Option) (*time.Time, *time.Time)
 	DateTimeInput(string, ...datetimeinput.Option) *time.Time
 	TimeInput(string, ...timeinput.Option) *time.Time
+	ColorInput(string, ...colorinput.Option) string
 	Selectbox(string, ...selectbox.Option) *selectbox.Value
 	MultiSelect(string, ...multiselect.Option) *multiselect.Value
+	TagInput(string, ...taginput.Option) []string
 	Radio(string, ...radio.Option) *radio.Value
 	Checkbox(string, ...checkbox.Option) bool
+	Toggle(string, ...toggle.Option) bool
 	CheckboxGroup(string, ...checkboxgroup.Option) *checkboxgroup.Value
 	TextArea(string, ...textarea.Option) string
+	CodeEditor(string, ...codeeditor.Option) string
+	FileInput(string, ...fileinput.Option) []fileinput.File
+	DownloadButton(string, []byte, string, string, ...downloadbutton.Option)
 	Table(any, ...table.Option) table.Value
+	PaginatedTable(table.FetchFunc, ...table.Option) (table.Value, error)
+	Chart(any, ...chart.Option)
+	JSON(any, ...json.Option)
+	Image(any, ...image.Option)
 	Button(string, ...button.Option) bool
 	Form(string, ...form.Option) (UIBuilder, bool)
 	Columns(int, ...columns.Option) []UIBuilder
+	Tabs(...string) []UIBuilder
+	Expander(string, ...expander.Option) UIBuilder
+	Dialog(string, ...dialog.Option) (UIBuilder, bool)
 }
 
 type uiBuilder struct {
@@ -60,6 +107,15 @@ func (b *uiBuilder) Context() context.Context {
 	return b.context
 }
 
+// QueryParams returns the query parameters of the page URL, such as the
+// params passed to PageLink by the page that linked here.
+func (b *uiBuilder) QueryParams() url.Values {
+	if b.session == nil {
+		return url.Values{}
+	}
+	return b.session.Query()
+}
+
 func (b *uiBuilder) generatePageID(widgetType state.WidgetType, path []int) uuid.UUID {
 	if b.page == nil {
 		return uuid.Nil